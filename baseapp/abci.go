@@ -1,16 +1,20 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"syscall"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // InitChain implements the ABCI interface. It runs the initialization logic
@@ -137,6 +141,13 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
+
+	for _, listener := range app.abciListeners {
+		if err := listener.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("BeginBlock listener failed", "height", req.Header.Height, "err", err)
+		}
+	}
+
 	return res
 }
 
@@ -150,6 +161,12 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
 
+	for _, listener := range app.abciListeners {
+		if err := listener.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("EndBlock listener failed", "height", req.Height, "err", err)
+		}
+	}
+
 	return
 }
 
@@ -173,13 +190,23 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) (res abci.ResponseCheckTx)
 		panic(fmt.Sprintf("Unknown RequestCheckTx Type: %v", req.Type))
 	}
 
+	// The ResponseCheckTx of Tendermint has no priority field, so that the
+	// priority of an accepted tx is returned as an event instead.
+	events := result.Events
+	if result.IsOK() {
+		events = events.AppendEvent(sdk.NewEvent(
+			sdk.EventTypeCheckTx,
+			sdk.NewAttribute(sdk.AttributeKeyPriority, strconv.FormatInt(result.Priority, 10)),
+		))
+	}
+
 	return abci.ResponseCheckTx{
 		Code:      uint32(result.Code),
 		Data:      result.Data,
 		Log:       result.Log,
 		GasWanted: int64(result.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(result.GasUsed),   // TODO: Should type accept unsigned ints?
-		Events:    result.Events.ToABCIEvents(),
+		Events:    events.ToABCIEvents(),
 	}
 }
 
@@ -194,7 +221,7 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		result = app.runTx(runTxModeDeliver, req.Tx, tx)
 	}
 
-	return abci.ResponseDeliverTx{
+	res = abci.ResponseDeliverTx{
 		Code:      uint32(result.Code),
 		Codespace: string(result.Codespace),
 		Data:      result.Data,
@@ -203,6 +230,14 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		GasUsed:   int64(result.GasUsed),   // TODO: Should type accept unsigned ints?
 		Events:    result.Events.ToABCIEvents(),
 	}
+
+	for _, listener := range app.abciListeners {
+		if err := listener.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("DeliverTx listener failed", "err", err)
+		}
+	}
+
+	return res
 }
 
 // Commit implements the ABCI interface. It will commit all state that exists in
@@ -222,6 +257,16 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Debug("Commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	res = abci.ResponseCommit{
+		Data: commitID.Hash,
+	}
+
+	for _, listener := range app.abciListeners {
+		if err := listener.ListenCommit(app.deliverState.ctx, res); err != nil {
+			app.logger.Error("Commit listener failed", "height", header.Height, "err", err)
+		}
+	}
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -241,6 +286,13 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		halt = true
 	}
 
+	if app.snapshotManager != nil && app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
+		// hold the height until the snapshot is taken, so that the multistore
+		// does not prune it while it is read
+		app.cms.HoldVersion(header.Height)
+		go app.snapshot(uint64(header.Height))
+	}
+
 	if halt {
 		// Halt the binary and allow Tendermint to receive the ResponseCommit
 		// response with the commit ID hash. This will allow the node to successfully
@@ -249,9 +301,7 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		app.halt()
 	}
 
-	return abci.ResponseCommit{
-		Data: commitID.Hash,
-	}
+	return res
 }
 
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
@@ -276,9 +326,101 @@ func (app *BaseApp) halt() {
 	os.Exit(0)
 }
 
+// snapshot takes a state sync snapshot at the given height, which was held by
+// Commit, and prunes old snapshots. It is run asynchronously after Commit, so
+// errors are only logged.
+func (app *BaseApp) snapshot(height uint64) {
+	defer app.cms.ReleaseVersion(int64(height))
+
+	app.logger.Info("creating state snapshot", "height", height)
+
+	snapshot, err := app.snapshotManager.Create(height)
+	if err != nil {
+		app.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		return
+	}
+	app.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+
+	if app.snapshotKeepRecent > 0 {
+		pruned, err := app.snapshotManager.Prune(app.snapshotKeepRecent)
+		if err != nil {
+			app.logger.Error("failed to prune state snapshots", "err", err)
+			return
+		}
+		app.logger.Debug("pruned state snapshots", "pruned", pruned)
+	}
+}
+
+// ListSnapshots returns the state sync snapshots available on this node, most
+// recent first. It mirrors the ABCI state sync ListSnapshots call.
+//
+// NOTE: The Tendermint version in use does not yet expose the state sync ABCI
+// connection, so the snapshot calls are exposed directly on the BaseApp.
+func (app *BaseApp) ListSnapshots() ([]*snapshots.Snapshot, error) {
+	if app.snapshotManager == nil {
+		return []*snapshots.Snapshot{}, nil
+	}
+
+	return app.snapshotManager.List()
+}
+
+// LoadSnapshotChunk loads a chunk of a state sync snapshot, or returns nil if
+// it does not exist. It mirrors the ABCI state sync LoadSnapshotChunk call.
+func (app *BaseApp) LoadSnapshotChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
+	if app.snapshotManager == nil {
+		return nil, nil
+	}
+
+	return app.snapshotManager.LoadChunk(height, format, chunk)
+}
+
+// OfferSnapshot begins restoring the given state sync snapshot, which must
+// result in the given (trusted) app hash. The app hash is verified before any
+// restored state is written. It mirrors the ABCI state sync OfferSnapshot call.
+func (app *BaseApp) OfferSnapshot(snapshot snapshots.Snapshot, appHash []byte) error {
+	if app.snapshotManager == nil {
+		return errors.New("state sync snapshots are disabled")
+	}
+	if len(appHash) == 0 {
+		return errors.New("app hash of the offered snapshot cannot be empty")
+	}
+
+	return app.snapshotManager.Restore(snapshot, appHash)
+}
+
+// ApplySnapshotChunk applies the next chunk of the snapshot being restored. It
+// returns true once the snapshot has been fully restored and verified against
+// the app hash given in OfferSnapshot. It mirrors the ABCI state sync
+// ApplySnapshotChunk call.
+func (app *BaseApp) ApplySnapshotChunk(chunk []byte) (bool, error) {
+	if app.snapshotManager == nil {
+		return false, errors.New("state sync snapshots are disabled")
+	}
+
+	done, err := app.snapshotManager.RestoreChunk(chunk)
+	if err != nil || !done {
+		return done, err
+	}
+
+	commitID := app.cms.LastCommitID()
+	if app.baseKey != nil {
+		app.loadConsensusParams(app.cms.GetKVStore(app.baseKey))
+	}
+	app.setCheckState(abci.Header{Height: commitID.Version})
+
+	app.logger.Info("restored state snapshot", "height", commitID.Version, "hash", fmt.Sprintf("%X", commitID.Hash))
+	return true, nil
+}
+
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
 func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	// handle gRPC routes first rather than calling splitPath because '/' characters
+	// are used as part of gRPC paths
+	if grpcHandler := app.grpcQueryRouter.Route(req.Path); grpcHandler != nil {
+		return app.handleQueryGRPC(grpcHandler, req)
+	}
+
 	path := splitPath(req.Path)
 	if len(path) == 0 {
 		msg := "no query path provided"
@@ -318,6 +460,27 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 				result = app.Simulate(txBytes, tx)
 			}
 
+		case "simulate_tx":
+			// unlike "simulate", which only returns the result of the
+			// transaction, "simulate_tx" returns its message logs and the
+			// state changes it would make
+			var res sdk.SimulationResponse
+
+			txBytes := req.Data
+			tx, err := app.txDecoder(txBytes)
+			if err != nil {
+				res.Result = err.Result()
+			} else {
+				res = app.SimulateTx(txBytes, tx)
+			}
+
+			return abci.ResponseQuery{
+				Code:      uint32(sdk.CodeOK),
+				Codespace: string(sdk.CodespaceRoot),
+				Height:    req.Height,
+				Value:     codec.Cdc.MustMarshalBinaryLengthPrefixed(res),
+			}
+
 		case "version":
 			return abci.ResponseQuery{
 				Code:      uint32(sdk.CodeOK),
@@ -412,25 +575,11 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 		req.Height = app.LastBlockHeight()
 	}
 
-	if req.Height <= 1 && req.Prove {
-		return sdk.ErrInternal("cannot query with proof when height <= 1; please provide a valid height").QueryResult()
-	}
-
-	cacheMS, err := app.cms.CacheMultiStoreWithVersion(req.Height)
+	ctx, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
-		return sdk.ErrInternal(
-			fmt.Sprintf(
-				"failed to load state at height %d; %s (latest height: %d)",
-				req.Height, err, app.LastBlockHeight(),
-			),
-		).QueryResult()
+		return sdk.ErrInternal(err.Error()).QueryResult()
 	}
 
-	// cache wrap the commit-multistore for safety
-	ctx := sdk.NewContext(
-		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
-
 	// Passes the rest of the path as an argument to the querier.
 	//
 	// For example, in the path "custom/gov/proposal/test", the gov querier gets
@@ -452,6 +601,59 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 	}
 }
 
+func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
+	// when a client did not provide a query height, manually inject the latest
+	if req.Height == 0 {
+		req.Height = app.LastBlockHeight()
+	}
+
+	ctx, err := app.createQueryContext(req.Height, req.Prove)
+	if err != nil {
+		return sdk.ErrInternal(err.Error()).QueryResult()
+	}
+
+	res, err := handler(ctx, req)
+	if sdkErr, ok := err.(sdk.Error); ok {
+		res = sdkErr.QueryResult()
+		res.Height = req.Height
+		return res
+	}
+	if err != nil {
+		codespace, code, log := sdkerrors.ABCIInfo(err, false)
+		return abci.ResponseQuery{
+			Code:      code,
+			Codespace: codespace,
+			Height:    req.Height,
+			Log:       log,
+		}
+	}
+
+	return res
+}
+
+// createQueryContext creates a new sdk.Context for a query, branched off the
+// committed state at the given height.
+func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, error) {
+	if height <= 1 && prove {
+		return sdk.Context{}, errors.New("cannot query with proof when height <= 1; please provide a valid height")
+	}
+
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, fmt.Errorf(
+			"failed to load state at height %d; %s (latest height: %d)",
+			height, err, app.LastBlockHeight(),
+		)
+	}
+
+	// cache wrap the commit-multistore for safety
+	ctx := sdk.NewContext(
+		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
+	).WithMinGasPrices(app.minGasPrices).WithMsgMinGasPrices(app.msgMinGasPrices)
+
+	return ctx, nil
+}
+
 // splitPath splits a string path using the delimiter '/'.
 //
 // e.g. "this/is/funny" becomes []string{"this", "is", "funny"}
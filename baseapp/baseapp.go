@@ -8,6 +8,7 @@ import (
 	"os"
 	"reflect"
 	"runtime/debug"
+	"strconv"
 	"strings"
 
 	"github.com/gogo/protobuf/proto"
@@ -17,7 +18,9 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -53,25 +56,27 @@ type (
 // BaseApp reflects the ABCI application implementation.
 type BaseApp struct { // nolint: maligned
 	// initialized on creation
-	logger      log.Logger
-	name        string               // application name from abci.Info
-	db          dbm.DB               // common DB backend
-	cms         sdk.CommitMultiStore // Main (uncached) state
-	storeLoader StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
-	router      sdk.Router           // handle any kind of message
-	queryRouter sdk.QueryRouter      // router for redirecting query calls
-	txDecoder   sdk.TxDecoder        // unmarshal []byte into sdk.Tx
+	logger          log.Logger
+	name            string               // application name from abci.Info
+	db              dbm.DB               // common DB backend
+	cms             sdk.CommitMultiStore // Main (uncached) state
+	storeLoader     StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
+	router          sdk.Router           // handle any kind of message
+	queryRouter     sdk.QueryRouter      // router for redirecting query calls
+	grpcQueryRouter *GRPCQueryRouter     // router for redirecting gRPC query calls
+	txDecoder       sdk.TxDecoder        // unmarshal []byte into sdk.Tx
 
 	// set upon LoadVersion or LoadLatestVersion.
 	baseKey *sdk.KVStoreKey // Main KVStore in cms
 
-	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
-	initChainer    sdk.InitChainer  // initialize state with validators and state blob
-	beginBlocker   sdk.BeginBlocker // logic to run before any txs
-	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
-	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
-	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
-	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+	anteHandler    sdk.AnteHandler    // ante handler for fee and auth
+	txPriority     sdk.TxPriorityFunc // mempool priority policy of txs
+	initChainer    sdk.InitChainer    // initialize state with validators and state blob
+	beginBlocker   sdk.BeginBlocker   // logic to run before any txs
+	endBlocker     sdk.EndBlocker     // logic to run after all txs, and to determine valset changes
+	addrPeerFilter sdk.PeerFilter     // filter peers by address and port
+	idPeerFilter   sdk.PeerFilter     // filter peers by node ID
+	fauxMerkleMode bool               // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
 	// volatile states:
 	//
@@ -83,9 +88,17 @@ type BaseApp struct { // nolint: maligned
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
 
+	// manager for state sync snapshots, nil if snapshots are disabled
+	snapshotManager    *snapshots.Manager
+	snapshotInterval   uint64 // block interval between snapshots, 0 disables snapshots
+	snapshotKeepRecent uint32 // number of recent snapshots to keep, 0 keeps all
+
 	// absent validators from begin block
 	voteInfos []abci.VoteInfo
 
+	// listeners of the ABCI requests and responses, see SetStreamingService
+	abciListeners []ABCIListener
+
 	// consensus params
 	// TODO: Move this in the future to baseapp param store on main store.
 	consensusParams *abci.ConsensusParams
@@ -94,6 +107,11 @@ type BaseApp struct { // nolint: maligned
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// The minimum gas prices a validator is willing to accept for processing a
+	// transaction containing a message of a given type, keyed by the
+	// "<route>/<type>" of the message.
+	msgMinGasPrices map[string]sdk.DecCoins
+
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 
@@ -117,15 +135,16 @@ func NewBaseApp(
 ) *BaseApp {
 
 	app := &BaseApp{
-		logger:         logger,
-		name:           name,
-		db:             db,
-		cms:            store.NewCommitMultiStore(db),
-		storeLoader:    DefaultStoreLoader,
-		router:         NewRouter(),
-		queryRouter:    NewQueryRouter(),
-		txDecoder:      txDecoder,
-		fauxMerkleMode: false,
+		logger:          logger,
+		name:            name,
+		db:              db,
+		cms:             store.NewCommitMultiStore(db),
+		storeLoader:     DefaultStoreLoader,
+		router:          NewRouter(),
+		queryRouter:     NewQueryRouter(),
+		grpcQueryRouter: NewGRPCQueryRouter(),
+		txDecoder:       txDecoder,
+		fauxMerkleMode:  false,
 	}
 	for _, option := range options {
 		option(app)
@@ -318,6 +337,18 @@ func (app *BaseApp) initFromMainStore(baseKey *sdk.KVStoreKey) error {
 	// nil, it will be saved later during InitChain.
 	//
 	// TODO: assert that InitChain hasn't yet been called.
+	app.loadConsensusParams(mainStore)
+
+	// needed for the export command which inits from store but never calls initchain
+	app.setCheckState(abci.Header{})
+	app.Seal()
+
+	return nil
+}
+
+// loadConsensusParams loads and memoizes the consensus params from the main
+// store, if they have been stored.
+func (app *BaseApp) loadConsensusParams(mainStore sdk.KVStore) {
 	consensusParamsBz := mainStore.Get(mainConsensusParamsKey)
 	if consensusParamsBz != nil {
 		var consensusParams = &abci.ConsensusParams{}
@@ -329,18 +360,16 @@ func (app *BaseApp) initFromMainStore(baseKey *sdk.KVStoreKey) error {
 
 		app.setConsensusParams(consensusParams)
 	}
-
-	// needed for the export command which inits from store but never calls initchain
-	app.setCheckState(abci.Header{})
-	app.Seal()
-
-	return nil
 }
 
 func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
 	app.minGasPrices = gasPrices
 }
 
+func (app *BaseApp) setMsgMinGasPrices(gasPrices map[string]sdk.DecCoins) {
+	app.msgMinGasPrices = gasPrices
+}
+
 func (app *BaseApp) setHaltHeight(haltHeight uint64) {
 	app.haltHeight = haltHeight
 }
@@ -353,6 +382,22 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setSnapshotStore(snapshotStore *snapshots.Store) {
+	if snapshotStore == nil {
+		app.snapshotManager = nil
+		return
+	}
+	app.snapshotManager = snapshots.NewManager(snapshotStore, app.cms)
+}
+
+func (app *BaseApp) setSnapshotInterval(snapshotInterval uint64) {
+	app.snapshotInterval = snapshotInterval
+}
+
+func (app *BaseApp) setSnapshotKeepRecent(snapshotKeepRecent uint32) {
+	app.snapshotKeepRecent = snapshotKeepRecent
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() sdk.Router {
 	if app.sealed {
@@ -366,6 +411,9 @@ func (app *BaseApp) Router() sdk.Router {
 // QueryRouter returns the QueryRouter of a BaseApp.
 func (app *BaseApp) QueryRouter() sdk.QueryRouter { return app.queryRouter }
 
+// GRPCQueryRouter returns the GRPCQueryRouter of a BaseApp.
+func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRouter }
+
 // Seal seals a BaseApp. It prohibits any further modifications to a BaseApp.
 func (app *BaseApp) Seal() { app.sealed = true }
 
@@ -379,8 +427,10 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 func (app *BaseApp) setCheckState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.checkState = &state{
-		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices),
+		ms: ms,
+		ctx: sdk.NewContext(ms, header, true, app.logger).
+			WithMinGasPrices(app.minGasPrices).
+			WithMsgMinGasPrices(app.msgMinGasPrices),
 	}
 }
 
@@ -462,6 +512,23 @@ func validateBasicTxMsgs(msgs []sdk.Msg) sdk.Error {
 	return nil
 }
 
+// String returns the name under which transactions executed in the mode are
+// recorded by telemetry.
+func (mode runTxMode) String() string {
+	switch mode {
+	case runTxModeCheck:
+		return "check"
+	case runTxModeReCheck:
+		return "recheck"
+	case runTxModeSimulate:
+		return "simulate"
+	case runTxModeDeliver:
+		return "deliver"
+	default:
+		return "unknown"
+	}
+}
+
 // Returns the applications's deliverState if app is in runTxModeDeliver,
 // otherwise it returns the application's checkstate.
 func (app *BaseApp) getState(mode runTxMode) *state {
@@ -513,12 +580,16 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // further details on transaction execution, reference the BaseApp SDK
 // documentation.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk.Result) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, tx)
+}
+
+// runTxWithContext processes a transaction in the given context, see runTx.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk.Result) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -548,6 +619,11 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 
 		result.GasWanted = gasWanted
 		result.GasUsed = ctx.GasMeter().GasConsumed()
+
+		telemetry.Default().Txs.With(
+			telemetry.LabelMode, mode.String(),
+			telemetry.LabelSuccess, strconv.FormatBool(result.IsOK()),
+		).Add(1)
 	}()
 
 	// If BlockGasMeter() panics it will be caught by the above recover and will
@@ -611,15 +687,25 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		msCache.Write()
 	}
 
+	// The mempool priority of the tx is the one set by the AnteHandler, unless
+	// the application provides its own priority policy.
+	priority := ctx.Priority()
+	if app.txPriority != nil {
+		priority = app.txPriority(ctx, tx)
+	}
+
 	// Create a new Context based off of the existing Context with a cache-wrapped
 	// MultiStore in case message processing fails. At this point, the MultiStore
 	// is doubly cached-wrapped.
 	runMsgCtx, msCache := app.cacheTxContext(ctx, txBytes)
 	result = app.runMsgs(runMsgCtx, msgs, mode)
 	result.GasWanted = gasWanted
+	result.Priority = priority
 
-	// Safety check: don't write the cache state unless we're in DeliverTx.
-	if mode != runTxModeDeliver {
+	// Safety check: don't write the cache state unless we're in DeliverTx or
+	// simulating, in which case the context's multi-store is itself a cache
+	// which is discarded.
+	if mode != runTxModeDeliver && mode != runTxModeSimulate {
 		return result
 	}
 
@@ -656,7 +742,14 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 
 		// skip actual execution for CheckTx and ReCheckTx mode
 		if mode != runTxModeCheck && mode != runTxModeReCheck {
+			startingGas := ctx.GasMeter().GasConsumed()
 			msgResult = handler(ctx, msg)
+
+			if mode == runTxModeDeliver {
+				telemetry.Default().MsgGasUsed.With(
+					telemetry.LabelMsgType, fmt.Sprintf("%s/%s", msgRoute, msg.Type()),
+				).Observe(float64(ctx.GasMeter().GasConsumed() - startingGas))
+			}
 		}
 
 		// Each message result's Data must be length prefixed in order to separate
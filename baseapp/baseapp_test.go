@@ -3,10 +3,13 @@ package baseapp
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,6 +20,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -424,6 +428,26 @@ func TestSetMinGasPrices(t *testing.T) {
 	require.Equal(t, minGasPrices, app.minGasPrices)
 }
 
+func TestSetMsgMinGasPrices(t *testing.T) {
+	app := newBaseApp(t.Name(), SetMsgMinGasPrices("bank/send=0.01stake; gov/deposit=1.0atom,0.5stake"))
+	require.Equal(t, map[string]sdk.DecCoins{
+		"bank/send":   {sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))},
+		"gov/deposit": {sdk.NewInt64DecCoin("atom", 1), sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1))},
+	}, app.msgMinGasPrices)
+
+	app = newBaseApp(t.Name(), SetMsgMinGasPrices(""))
+	require.Empty(t, app.msgMinGasPrices)
+
+	for _, invalid := range []string{
+		"bank/send",
+		"send=0.01stake",
+		"bank/send=0.01",
+		"bank/send=0.01stake;bank/send=0.02stake",
+	} {
+		require.Panics(t, func() { SetMsgMinGasPrices(invalid) }, invalid)
+	}
+}
+
 func TestInitChainer(t *testing.T) {
 	name := t.Name()
 	// keep the db and logger ourselves so
@@ -712,6 +736,65 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+func TestCheckTxPriority(t *testing.T) {
+	// the priority of a tx is ten times its counter
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithPriority(tx.(txTest).Counter * 10), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	}
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	checkTxPriority := func(app *BaseApp, tx *txTest) string {
+		txBytes, err := codec.MarshalBinaryLengthPrefixed(tx)
+		require.NoError(t, err)
+
+		res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, res.IsOK(), res.Log)
+
+		for _, event := range res.Events {
+			if event.Type != sdk.EventTypeCheckTx {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == sdk.AttributeKeyPriority {
+					return string(attr.Value)
+				}
+			}
+		}
+
+		return ""
+	}
+
+	// the priority set by the ante handler is returned by default
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	require.Equal(t, "10", checkTxPriority(app, newTxCounter(1, 1)))
+	require.Equal(t, "70", checkTxPriority(app, newTxCounter(7, 7)))
+
+	// the priority policy of the application overrides it
+	priorityOpt := func(bapp *BaseApp) {
+		bapp.SetTxPriority(func(ctx sdk.Context, tx sdk.Tx) int64 {
+			if tx.GetMsgs()[0].(*msgCounter).Counter == 7 {
+				return math.MaxInt64
+			}
+			return ctx.Priority()
+		})
+	}
+
+	app = setupBaseApp(t, anteOpt, routerOpt, priorityOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	require.Equal(t, "10", checkTxPriority(app, newTxCounter(1, 1)))
+	require.Equal(t, fmt.Sprintf("%d", int64(math.MaxInt64)), checkTxPriority(app, newTxCounter(2, 7)))
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -1404,3 +1487,80 @@ func TestGetMaximumBlockGas(t *testing.T) {
 	app.setConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: -5000000}})
 	require.Panics(t, func() { app.getMaximumBlockGas() })
 }
+
+func TestSnapshotRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "baseapp-snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	snapshotStore, err := snapshots.NewStore(dir)
+	require.NoError(t, err)
+
+	app := setupBaseApp(t,
+		SetSnapshotStore(snapshotStore), SetSnapshotInterval(2), SetSnapshotKeepRecent(1))
+	app.InitChain(abci.RequestInitChain{})
+
+	key := []byte("key")
+	for height := int64(1); height <= 4; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		setIntOnStore(app.deliverState.ctx.KVStore(capKey2), key, height)
+		app.Commit()
+
+		// wait for the asynchronous snapshot to complete
+		if height%2 == 0 {
+			require.Eventually(t, func() bool {
+				list, err := app.ListSnapshots()
+				require.NoError(t, err)
+				return len(list) == 1 && list[0].Height == uint64(height)
+			}, 5*time.Second, 10*time.Millisecond)
+		}
+	}
+
+	list, err := app.ListSnapshots()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	snapshot := list[0]
+
+	// restore the snapshot into a fresh app
+	target := setupBaseApp(t, SetSnapshotStore(snapshotStore))
+	require.Error(t, target.OfferSnapshot(*snapshot, nil))
+	require.NoError(t, target.OfferSnapshot(*snapshot, app.LastCommitID().Hash))
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := app.LoadSnapshotChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+
+		done, err := target.ApplySnapshotChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == snapshot.Chunks-1, done)
+	}
+
+	require.Equal(t, app.LastCommitID(), target.LastCommitID())
+	require.Equal(t, int64(4), getIntFromStore(target.checkState.ctx.KVStore(capKey2), key))
+
+	// a snapshot whose app hash does not match must be rejected before any
+	// state is written, so that it can be restored again
+	other := setupBaseApp(t, SetSnapshotStore(snapshotStore))
+	require.NoError(t, other.OfferSnapshot(*snapshot, []byte("invalid")))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := app.LoadSnapshotChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+
+		if _, err = other.ApplySnapshotChunk(chunk); err != nil {
+			require.True(t, errors.Is(err, snapshots.ErrAppHashMismatch), err.Error())
+			break
+		}
+		require.NotEqual(t, snapshot.Chunks-1, i, "restore did not fail")
+	}
+	require.Equal(t, sdk.CommitID{}, other.LastCommitID())
+
+	require.NoError(t, other.OfferSnapshot(*snapshot, app.LastCommitID().Hash))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := app.LoadSnapshotChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+
+		_, err = other.ApplySnapshotChunk(chunk)
+		require.NoError(t, err)
+	}
+	require.Equal(t, app.LastCommitID(), other.LastCommitID())
+}
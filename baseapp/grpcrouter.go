@@ -0,0 +1,80 @@
+package baseapp
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GRPCQueryHandler defines a function type which handles ABCI queries routed
+// to a gRPC query service method.
+type GRPCQueryHandler = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error)
+
+// GRPCQueryRouter routes ABCI queries to gRPC query services registered by
+// modules. Queries are routed on their fully-qualified method name, e.g.
+// "/cosmos_sdk.x.bank.v1.Query/Balance", and their data must be the protobuf
+// encoded request of that method.
+type GRPCQueryRouter struct {
+	routes   map[string]GRPCQueryHandler
+	services []grpcService
+}
+
+// grpcService holds a registered service description along with its
+// implementation so the service can be registered again with a gRPC server.
+type grpcService struct {
+	desc    *grpc.ServiceDesc
+	handler interface{}
+}
+
+var _ sdk.GRPCServer = NewGRPCQueryRouter()
+
+// NewGRPCQueryRouter returns a reference to a new GRPCQueryRouter.
+func NewGRPCQueryRouter() *GRPCQueryRouter {
+	return &GRPCQueryRouter{
+		routes: map[string]GRPCQueryHandler{},
+	}
+}
+
+// Route returns the GRPCQueryHandler for a given fully-qualified method name
+// or nil if no such route exists.
+func (qrt *GRPCQueryRouter) Route(path string) GRPCQueryHandler {
+	return qrt.routes[path]
+}
+
+// RegisterService implements the sdk.GRPCServer interface. It registers a
+// route for each method of the service. It will panic if a method has already
+// been registered.
+func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	for _, method := range sd.Methods {
+		fqName := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		if qrt.routes[fqName] != nil {
+			panic(fmt.Sprintf("gRPC query route %s has already been registered", fqName))
+		}
+
+		methodHandler := method.Handler
+		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), func(i interface{}) error {
+				return proto.Unmarshal(req.Data, i.(proto.Message))
+			}, nil)
+			if err != nil {
+				return abci.ResponseQuery{}, err
+			}
+
+			resBytes, err := proto.Marshal(res.(proto.Message))
+			if err != nil {
+				return abci.ResponseQuery{}, err
+			}
+
+			return abci.ResponseQuery{
+				Height: req.Height,
+				Value:  resBytes,
+			}, nil
+		}
+	}
+
+	qrt.services = append(qrt.services, grpcService{desc: sd, handler: handler})
+}
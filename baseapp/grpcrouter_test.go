@@ -0,0 +1,114 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// echoServer is a test gRPC query service which echoes the request value
+// along with the height of the context it was invoked with.
+type echoServer struct{}
+
+func (echoServer) Echo(goCtx context.Context, req *gogotypes.StringValue) (*gogotypes.StringValue, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &gogotypes.StringValue{Value: req.Value + "@" + ctx.ChainID()}, nil
+}
+
+var echoServiceDesc = grpc.ServiceDesc{
+	ServiceName: "test.Echo",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Echo",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(gogotypes.StringValue)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(echoServer).Echo(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/test.Echo/Echo"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(echoServer).Echo(ctx, req.(*gogotypes.StringValue))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+	},
+}
+
+func TestGRPCQueryRouter(t *testing.T) {
+	qr := NewGRPCQueryRouter()
+	qr.RegisterService(&echoServiceDesc, echoServer{})
+
+	require.NotNil(t, qr.Route("/test.Echo/Echo"))
+	require.Nil(t, qr.Route("/test.Echo/Unknown"))
+
+	// require panic on duplicate registration
+	require.Panics(t, func() {
+		qr.RegisterService(&echoServiceDesc, echoServer{})
+	})
+}
+
+func TestGRPCQuery(t *testing.T) {
+	app := setupBaseApp(t)
+	app.GRPCQueryRouter().RegisterService(&echoServiceDesc, echoServer{})
+
+	app.InitChain(abci.RequestInitChain{ChainId: "test-chain"})
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{ChainID: "test-chain", Height: 1}})
+	app.Commit()
+
+	bz, err := proto.Marshal(&gogotypes.StringValue{Value: "hello"})
+	require.NoError(t, err)
+
+	res := app.Query(abci.RequestQuery{Path: "/test.Echo/Echo", Data: bz})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(1), res.Height)
+
+	var out gogotypes.StringValue
+	require.NoError(t, proto.Unmarshal(res.Value, &out))
+	require.Equal(t, "hello@test-chain", out.Value)
+
+	// invalid request data
+	res = app.Query(abci.RequestQuery{Path: "/test.Echo/Echo", Data: []byte{0xff}})
+	require.False(t, res.IsOK())
+}
+
+func TestGRPCQueryHeight(t *testing.T) {
+	testCases := []struct {
+		name      string
+		md        metadata.MD
+		expHeight int64
+		expErr    bool
+	}{
+		{"no header", metadata.MD{}, 0, false},
+		{"valid header", metadata.Pairs(GRPCBlockHeightHeader, "10"), 10, false},
+		{"negative height", metadata.Pairs(GRPCBlockHeightHeader, "-1"), 0, true},
+		{"malformed height", metadata.Pairs(GRPCBlockHeightHeader, "ten"), 0, true},
+		{"multiple headers", metadata.Pairs(GRPCBlockHeightHeader, "1", GRPCBlockHeightHeader, "2"), 0, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			height, err := grpcQueryHeight(metadata.NewIncomingContext(context.Background(), tc.md))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expHeight, height)
+		})
+	}
+}
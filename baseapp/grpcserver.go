@@ -0,0 +1,99 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GRPCBlockHeightHeader is the gRPC metadata header used to request a query
+// against the state of a given block height. The height the query was
+// executed at is returned to the client under the same header.
+const GRPCBlockHeightHeader = "x-height"
+
+// RegisterGRPCServer registers all gRPC query services of the application's
+// GRPCQueryRouter with the given server. Each method is invoked with an
+// sdk.Context branched off the committed state at the height requested in the
+// GRPCBlockHeightHeader header, or the latest height if none is provided.
+func (app *BaseApp) RegisterGRPCServer(server sdk.GRPCServer) {
+	for _, svc := range app.grpcQueryRouter.services {
+		desc := *svc.desc
+		desc.Methods = make([]grpc.MethodDesc, len(svc.desc.Methods))
+
+		for i, method := range svc.desc.Methods {
+			methodHandler := method.Handler
+			desc.Methods[i] = grpc.MethodDesc{
+				MethodName: method.MethodName,
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+					return methodHandler(srv, ctx, dec, app.grpcQueryInterceptor)
+				},
+			}
+		}
+
+		server.RegisterService(&desc, svc.handler)
+	}
+}
+
+// grpcQueryInterceptor injects the query sdk.Context into the context of a
+// gRPC query and recovers from any panic raised while handling it.
+func (app *BaseApp) grpcQueryInterceptor(
+	grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (res interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = status.Errorf(codes.Internal, "panic while handling query: %v", r)
+		}
+	}()
+
+	height, err := grpcQueryHeight(grpcCtx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// when a client did not provide a query height, use the latest
+	if height == 0 {
+		height = app.LastBlockHeight()
+	}
+
+	ctx, err := app.createQueryContext(height, false)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := grpc.SetHeader(grpcCtx, metadata.Pairs(GRPCBlockHeightHeader, strconv.FormatInt(height, 10))); err != nil {
+		return nil, err
+	}
+
+	return handler(sdk.WrapSDKContext(ctx.WithContext(grpcCtx)), req)
+}
+
+// grpcQueryHeight returns the height provided in the GRPCBlockHeightHeader
+// header of an incoming gRPC request, or zero if the header is not set.
+func grpcQueryHeight(ctx context.Context) (int64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+
+	values := md.Get(GRPCBlockHeightHeader)
+	if len(values) == 0 {
+		return 0, nil
+	}
+	if len(values) > 1 {
+		return 0, fmt.Errorf("only one %s header may be provided", GRPCBlockHeightHeader)
+	}
+
+	height, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil || height < 0 {
+		return 0, fmt.Errorf("invalid %s header: %s", GRPCBlockHeightHeader, values[0])
+	}
+
+	return height, nil
+}
@@ -3,10 +3,12 @@ package baseapp
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,6 +20,24 @@ func SetPruning(opts sdk.PruningOptions) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.cms.SetPruning(opts) }
 }
 
+// SetSnapshotStore sets the snapshot store used to save and restore state sync
+// snapshots of the multistore.
+func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
+	return func(app *BaseApp) { app.setSnapshotStore(snapshotStore) }
+}
+
+// SetSnapshotInterval sets the block interval at which state sync snapshots are
+// taken. An interval of 0 disables snapshots.
+func SetSnapshotInterval(interval uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.setSnapshotInterval(interval) }
+}
+
+// SetSnapshotKeepRecent sets the number of recent state sync snapshots to keep.
+// A value of 0 keeps all snapshots.
+func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.setSnapshotKeepRecent(keepRecent) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -28,6 +48,51 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setMinGasPrices(gasPrices) }
 }
 
+// SetMsgMinGasPrices returns an option that sets the minimum gas prices of the
+// transactions containing a message of a given type. The prices of each message
+// type are separated by a semicolon and keyed by the "<route>/<type>" of the
+// message, e.g. "bank/send=0.01stake;gov/submit_proposal=0.5stake,0.1atom".
+func SetMsgMinGasPrices(msgGasPricesStr string) func(*BaseApp) {
+	gasPrices, err := parseMsgMinGasPrices(msgGasPricesStr)
+	if err != nil {
+		panic(fmt.Sprintf("invalid message minimum gas prices: %v", err))
+	}
+
+	return func(bap *BaseApp) { bap.setMsgMinGasPrices(gasPrices) }
+}
+
+// parseMsgMinGasPrices parses the minimum gas prices of message types set with
+// SetMsgMinGasPrices.
+func parseMsgMinGasPrices(msgGasPricesStr string) (map[string]sdk.DecCoins, error) {
+	gasPrices := make(map[string]sdk.DecCoins)
+
+	msgGasPricesStr = strings.TrimSpace(msgGasPricesStr)
+	if msgGasPricesStr == "" {
+		return gasPrices, nil
+	}
+
+	for _, entry := range strings.Split(msgGasPricesStr, ";") {
+		kv := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+		if len(kv) != 2 || strings.Count(kv[0], "/") != 1 {
+			return nil, fmt.Errorf("expected <route>/<type>=<gas prices>, got %q", entry)
+		}
+
+		msgType := strings.TrimSpace(kv[0])
+		if _, ok := gasPrices[msgType]; ok {
+			return nil, fmt.Errorf("duplicate gas prices for message type %s", msgType)
+		}
+
+		prices, err := sdk.ParseDecCoins(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid gas prices for message type %s: %w", msgType, err)
+		}
+
+		gasPrices[msgType] = prices
+	}
+
+	return gasPrices, nil
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltHeight(blockHeight) }
@@ -44,6 +109,12 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetStreamingService returns a BaseApp option function that registers a
+// StreamingService with the app.
+func SetStreamingService(s StreamingService) func(*BaseApp) {
+	return func(app *BaseApp) { app.setStreamingService(s) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -101,6 +172,16 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetTxPriority sets the policy determining the mempool priority of the txs
+// from the context returned by the AnteHandler, e.g. to favor some message
+// types. By default the priority set by the AnteHandler is used.
+func (app *BaseApp) SetTxPriority(txPriority sdk.TxPriorityFunc) {
+	if app.sealed {
+		panic("SetTxPriority() on sealed BaseApp")
+	}
+	app.txPriority = txPriority
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -0,0 +1,87 @@
+package baseapp
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SimulateTx simulates a transaction against the check state. It returns the
+// result of the transaction along with the logs of its messages and the state
+// changes it would make if it was delivered, including the changes made by the
+// AnteHandler of a transaction whose messages fail.
+func (app *BaseApp) SimulateTx(txBytes []byte, tx sdk.Tx) sdk.SimulationResponse {
+	recorder := &stateChangeRecorder{}
+
+	// The state of the simulation is written to a cache of the check state
+	// which is discarded, so that the recorder is notified of its changes.
+	msCache := app.checkState.CacheMultiStore()
+	simMsCache := msCache.CacheMultiStoreWithListeners([]sdk.WriteListener{recorder})
+
+	ctx := app.getContextForTx(runTxModeSimulate, txBytes).WithMultiStore(simMsCache)
+	result := app.runTxWithContext(ctx, runTxModeSimulate, txBytes, tx)
+	simMsCache.Write()
+
+	// the log of a failed transaction is not necessarily made of message logs
+	logs, err := sdk.ParseABCILogs(result.Log)
+	if err != nil {
+		logs = nil
+	}
+
+	return sdk.SimulationResponse{
+		Result:       result,
+		Logs:         logs,
+		StateChanges: recorder.stateChanges(app.checkState.ms),
+	}
+}
+
+// storeWrite is a write made to a store of a multi-store.
+type storeWrite struct {
+	storeKey sdk.StoreKey
+	key      []byte
+	value    []byte
+	delete   bool
+}
+
+// stateChangeRecorder is a WriteListener recording the writes made to the
+// stores of a multi-store.
+type stateChangeRecorder struct {
+	writes []storeWrite
+}
+
+var _ sdk.WriteListener = (*stateChangeRecorder)(nil)
+
+// OnWrite implements the WriteListener interface.
+func (r *stateChangeRecorder) OnWrite(storeKey sdk.StoreKey, key []byte, value []byte, delete bool) error {
+	r.writes = append(r.writes, storeWrite{storeKey: storeKey, key: key, value: value, delete: delete})
+	return nil
+}
+
+// stateChanges returns the recorded writes as state changes, sorted by store
+// and key, with the values before the changes read from the given multi-store.
+func (r *stateChangeRecorder) stateChanges(ms sdk.MultiStore) []sdk.StateChange {
+	sort.SliceStable(r.writes, func(i, j int) bool {
+		if r.writes[i].storeKey.Name() != r.writes[j].storeKey.Name() {
+			return r.writes[i].storeKey.Name() < r.writes[j].storeKey.Name()
+		}
+
+		return bytes.Compare(r.writes[i].key, r.writes[j].key) < 0
+	})
+
+	changes := make([]sdk.StateChange, 0, len(r.writes))
+	for _, w := range r.writes {
+		change := sdk.StateChange{
+			StoreKey: w.storeKey.Name(),
+			Key:      w.key,
+			Before:   ms.GetKVStore(w.storeKey).Get(w.key),
+		}
+		if !w.delete {
+			change.After = w.value
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
@@ -0,0 +1,113 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSimulateTxStateChanges(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	counterBytes := func(i int64) []byte {
+		store := app.checkState.ctx.KVStore(capKey2)
+		setIntOnStore(store, []byte("tmp"), i)
+		defer store.Delete([]byte("tmp"))
+		return store.Get([]byte("tmp"))
+	}
+
+	simulate := func(tx *txTest) sdk.SimulationResponse {
+		txBytes, err := cdc.MarshalBinaryLengthPrefixed(tx)
+		require.NoError(t, err)
+
+		decoded, err := app.txDecoder(txBytes)
+		require.NoError(t, err)
+
+		return app.SimulateTx(txBytes, decoded)
+	}
+
+	tx := newTxCounter(0, 0)
+	res := simulate(tx)
+	require.True(t, res.Result.IsOK(), res.Result.Log)
+	require.Len(t, res.Logs, 1)
+	require.True(t, res.Logs[0].Success)
+	require.Equal(t, []sdk.StateChange{
+		{StoreKey: capKey1.Name(), Key: anteKey, After: counterBytes(1)},
+		{StoreKey: capKey1.Name(), Key: deliverKey, After: counterBytes(1)},
+	}, res.StateChanges)
+
+	// the check state is left untouched
+	require.Nil(t, app.checkState.ctx.KVStore(capKey1).Get(anteKey))
+	require.Equal(t, res.StateChanges, simulate(tx).StateChanges)
+
+	// the changes of the ante handler are kept if a message fails
+	tx = newTxCounter(0, 0)
+	tx.setFailOnHandler(true)
+
+	res = simulate(tx)
+	require.False(t, res.Result.IsOK())
+	require.Equal(t, []sdk.StateChange{
+		{StoreKey: capKey1.Name(), Key: anteKey, After: counterBytes(1)},
+	}, res.StateChanges)
+
+	// nothing is changed if the ante handler fails
+	tx = newTxCounter(0, 0)
+	tx.setFailOnAnte(true)
+
+	res = simulate(tx)
+	require.False(t, res.Result.IsOK())
+	require.Empty(t, res.StateChanges)
+	require.Nil(t, res.Logs)
+
+	// the values before the changes are those of the check state
+	checkTx := newTxCounter(0, 0)
+	checkTxBytes, err := cdc.MarshalBinaryLengthPrefixed(checkTx)
+	require.NoError(t, err)
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: checkTxBytes}).IsOK())
+
+	tx = newTxCounter(1, 0)
+	res = simulate(tx)
+	require.True(t, res.Result.IsOK(), res.Result.Log)
+	require.Equal(t, []sdk.StateChange{
+		{StoreKey: capKey1.Name(), Key: anteKey, Before: counterBytes(1), After: counterBytes(2)},
+		{StoreKey: capKey1.Name(), Key: deliverKey, After: counterBytes(1)},
+	}, res.StateChanges)
+
+	// the simulation response is returned by the simulate_tx query
+	txBytes, err := cdc.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+
+	query := app.Query(abci.RequestQuery{Path: "/app/simulate_tx", Data: txBytes})
+	require.True(t, query.IsOK(), query.Log)
+
+	var queryRes sdk.SimulationResponse
+	codec.Cdc.MustUnmarshalBinaryLengthPrefixed(query.Value, &queryRes)
+	require.Equal(t, res.StateChanges, queryRes.StateChanges)
+	require.Equal(t, res.Logs, queryRes.Logs)
+
+	// the simulate query keeps returning the result alone
+	query = app.Query(abci.RequestQuery{Path: "/app/simulate", Data: txBytes})
+	require.True(t, query.IsOK(), query.Log)
+
+	var result sdk.Result
+	codec.Cdc.MustUnmarshalBinaryLengthPrefixed(query.Value, &result)
+	require.True(t, result.IsOK(), result.Log)
+	require.Equal(t, res.Result.Log, result.Log)
+}
@@ -0,0 +1,69 @@
+package baseapp
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener defines an interface for receiving the ABCI requests and
+// responses processed by the BaseApp, e.g. to stream them alongside the state
+// changes they caused.
+type ABCIListener interface {
+	// ListenBeginBlock updates the listener with the BeginBlock request and
+	// response.
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenDeliverTx updates the listener with a DeliverTx request and
+	// response.
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenEndBlock updates the listener with the EndBlock request and
+	// response.
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenCommit updates the listener with the Commit response. The state
+	// changes of the block have been delivered to the store listeners by then.
+	ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error
+}
+
+// StreamingService defines a service streaming the state changes of a BaseApp
+// along with the ABCI requests and responses that caused them.
+type StreamingService interface {
+	ABCIListener
+
+	// Listeners returns the WriteListeners to register with the KVStore of
+	// each StoreKey.
+	Listeners() map[sdk.StoreKey][]sdk.WriteListener
+}
+
+// setStreamingService registers the listeners of a StreamingService with the
+// BaseApp's commit multi-store and ABCI methods. Failures of the WriteListeners
+// are logged, as are those of the ABCI listeners, rather than halting the node.
+func (app *BaseApp) setStreamingService(s StreamingService) {
+	for key, listeners := range s.Listeners() {
+		logged := make([]sdk.WriteListener, len(listeners))
+		for i, listener := range listeners {
+			logged[i] = loggedWriteListener{listener: listener, logger: app.logger}
+		}
+
+		app.cms.AddListeners(key, logged)
+	}
+
+	app.abciListeners = append(app.abciListeners, s)
+}
+
+// loggedWriteListener wraps a WriteListener of a StreamingService to log its
+// failures instead of returning them to the listening KVStore, which would
+// panic.
+type loggedWriteListener struct {
+	listener sdk.WriteListener
+	logger   log.Logger
+}
+
+// OnWrite implements the WriteListener interface.
+func (l loggedWriteListener) OnWrite(storeKey sdk.StoreKey, key []byte, value []byte, delete bool) error {
+	if err := l.listener.OnWrite(storeKey, key, value, delete); err != nil {
+		l.logger.Error("store listener failed", "store", storeKey.Name(), "err", err)
+	}
+
+	return nil
+}
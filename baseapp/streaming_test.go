@@ -0,0 +1,88 @@
+package baseapp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var errListener = errors.New("listener failure")
+
+// failingStreamingService is a StreamingService whose listeners count the
+// calls they receive and fail all of them.
+type failingStreamingService struct {
+	writes    int
+	abciCalls int
+}
+
+var _ StreamingService = (*failingStreamingService)(nil)
+
+func (s *failingStreamingService) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	return map[sdk.StoreKey][]sdk.WriteListener{capKey1: {s}}
+}
+
+func (s *failingStreamingService) OnWrite(sdk.StoreKey, []byte, []byte, bool) error {
+	s.writes++
+	return errListener
+}
+
+func (s *failingStreamingService) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	s.abciCalls++
+	return errListener
+}
+
+func (s *failingStreamingService) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	s.abciCalls++
+	return errListener
+}
+
+func (s *failingStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	s.abciCalls++
+	return errListener
+}
+
+func (s *failingStreamingService) ListenCommit(sdk.Context, abci.ResponseCommit) error {
+	s.abciCalls++
+	return errListener
+}
+
+func TestStreamingServiceFailures(t *testing.T) {
+	service := &failingStreamingService{}
+
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetStreamingService(service))
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	txBytes, err := codec.MarshalBinaryLengthPrefixed(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	// failures of both the write and the ABCI listeners are logged and the
+	// block is processed as usual
+	require.NotPanics(t, func() {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), res.Log)
+
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	})
+
+	require.True(t, service.writes > 0)
+	require.Equal(t, 4, service.abciCalls)
+}
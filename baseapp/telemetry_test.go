@@ -0,0 +1,63 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTxTelemetry(t *testing.T) {
+	sink := telemetry.NewInMemSink()
+	telemetry.SetDefault(sink.Metrics())
+	defer telemetry.SetDefault(nil)
+
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	txBytes, err := codec.MarshalBinaryLengthPrefixed(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, checkRes.IsOK(), checkRes.Log)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+
+	deliverRes := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, deliverRes.IsOK(), deliverRes.Log)
+
+	// a message without a route fails the transaction
+	unknownRouteTx := txTest{[]sdk.Msg{msgNoRoute{}}, 1, false}
+	txBytes, err = codec.MarshalBinaryLengthPrefixed(unknownRouteTx)
+	require.NoError(t, err)
+
+	deliverRes = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, deliverRes.IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	require.Equal(t, 1.0, sink.Counter(telemetry.MetricTxs, telemetry.LabelMode, "check", telemetry.LabelSuccess, "true"))
+	require.Equal(t, 1.0, sink.Counter(telemetry.MetricTxs, telemetry.LabelMode, "deliver", telemetry.LabelSuccess, "true"))
+	require.Equal(t, 1.0, sink.Counter(telemetry.MetricTxs, telemetry.LabelMode, "deliver", telemetry.LabelSuccess, "false"))
+
+	// gas is only recorded for the messages of delivered transactions
+	gasUsed := sink.Observations(telemetry.MetricMsgGasUsed, telemetry.LabelMsgType, routeMsgCounter+"/counter1")
+	require.Len(t, gasUsed, 1)
+	require.True(t, gasUsed[0] > 0)
+}
@@ -0,0 +1,65 @@
+package codec
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+)
+
+// NewAnyWithValue packs the given protobuf message into an Any identified by
+// the message's registered name. The type URL is prefixed with a single "/"
+// rather than a host name to keep the encoding compact, as it is persisted
+// alongside every value.
+func NewAnyWithValue(msg proto.Message) (*types.Any, error) {
+	name := proto.MessageName(msg)
+	if name == "" {
+		return nil, fmt.Errorf("%T is not a registered protobuf message", msg)
+	}
+
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Any{TypeUrl: "/" + name, Value: bz}, nil
+}
+
+// UnpackAny unpacks an Any into a new instance of the concrete type registered
+// for its type URL.
+func UnpackAny(any *types.Any) (proto.Message, error) {
+	msg, err := types.EmptyAny(any)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := proto.Unmarshal(any.Value, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// MarshalAny packs the given protobuf message into an Any and encodes it using
+// the provided Marshaler. It allows values of an interface type (e.g.
+// accounts) to be persisted without an application-level registry of their
+// concrete types.
+func MarshalAny(m Marshaler, msg proto.Message) ([]byte, error) {
+	any, err := NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.MarshalBinaryBare(any)
+}
+
+// UnmarshalAny decodes an Any encoded by MarshalAny and unpacks it into a new
+// instance of the concrete type registered for its type URL.
+func UnmarshalAny(m Marshaler, bz []byte) (proto.Message, error) {
+	any := &types.Any{}
+	if err := m.UnmarshalBinaryBare(bz, any); err != nil {
+		return nil, err
+	}
+
+	return UnpackAny(any)
+}
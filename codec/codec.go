@@ -28,7 +28,7 @@ func RegisterEvidences(cdc *Codec) {
 }
 
 // attempt to make some pretty json
-func MarshalJSONIndent(cdc *Codec, obj interface{}) ([]byte, error) {
+func MarshalJSONIndent(cdc JSONMarshaler, obj interface{}) ([]byte, error) {
 	bz, err := cdc.MarshalJSON(obj)
 	if err != nil {
 		return nil, err
@@ -43,7 +43,7 @@ func MarshalJSONIndent(cdc *Codec, obj interface{}) ([]byte, error) {
 }
 
 // MustMarshalJSONIndent executes MarshalJSONIndent except it panics upon failure.
-func MustMarshalJSONIndent(cdc *Codec, obj interface{}) []byte {
+func MustMarshalJSONIndent(cdc JSONMarshaler, obj interface{}) []byte {
 	bz, err := MarshalJSONIndent(cdc, obj)
 	if err != nil {
 		panic(fmt.Sprintf("failed to marshal JSON: %s", err))
@@ -0,0 +1,78 @@
+package codec
+
+// HybridCodec defines a codec that utilizes protobuf for binary encoding and
+// Amino for JSON encoding. State is stored as protobuf, while genesis files,
+// queries and legacy sign bytes keep their Amino JSON representation.
+type HybridCodec struct {
+	amino *Codec
+}
+
+var _ Marshaler = &HybridCodec{}
+
+// NewHybridCodec returns a new HybridCodec using the given amino codec for
+// JSON encoding.
+func NewHybridCodec(amino *Codec) *HybridCodec {
+	return &HybridCodec{amino: amino}
+}
+
+func (hc *HybridCodec) MarshalBinaryBare(o ProtoMarshaler) ([]byte, error) {
+	return o.Marshal()
+}
+
+func (hc *HybridCodec) MustMarshalBinaryBare(o ProtoMarshaler) []byte {
+	bz, err := hc.MarshalBinaryBare(o)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (hc *HybridCodec) MarshalBinaryLengthPrefixed(o ProtoMarshaler) ([]byte, error) {
+	return MarshalProtoLengthPrefixed(o)
+}
+
+func (hc *HybridCodec) MustMarshalBinaryLengthPrefixed(o ProtoMarshaler) []byte {
+	bz, err := hc.MarshalBinaryLengthPrefixed(o)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (hc *HybridCodec) UnmarshalBinaryBare(bz []byte, ptr ProtoMarshaler) error {
+	return ptr.Unmarshal(bz)
+}
+
+func (hc *HybridCodec) MustUnmarshalBinaryBare(bz []byte, ptr ProtoMarshaler) {
+	if err := hc.UnmarshalBinaryBare(bz, ptr); err != nil {
+		panic(err)
+	}
+}
+
+func (hc *HybridCodec) UnmarshalBinaryLengthPrefixed(bz []byte, ptr ProtoMarshaler) error {
+	return UnmarshalProtoLengthPrefixed(bz, ptr)
+}
+
+func (hc *HybridCodec) MustUnmarshalBinaryLengthPrefixed(bz []byte, ptr ProtoMarshaler) {
+	if err := hc.UnmarshalBinaryLengthPrefixed(bz, ptr); err != nil {
+		panic(err)
+	}
+}
+
+func (hc *HybridCodec) MarshalJSON(o interface{}) ([]byte, error) {
+	return hc.amino.MarshalJSON(o)
+}
+
+func (hc *HybridCodec) MustMarshalJSON(o interface{}) []byte {
+	return hc.amino.MustMarshalJSON(o)
+}
+
+func (hc *HybridCodec) UnmarshalJSON(bz []byte, ptr interface{}) error {
+	return hc.amino.UnmarshalJSON(bz, ptr)
+}
+
+func (hc *HybridCodec) MustUnmarshalJSON(bz []byte, ptr interface{}) {
+	hc.amino.MustUnmarshalJSON(bz, ptr)
+}
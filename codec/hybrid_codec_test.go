@@ -0,0 +1,66 @@
+package codec_test
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func TestHybridCodecBinary(t *testing.T) {
+	cdc := codec.NewHybridCodec(codec.New())
+	msg := &types.UInt64Value{Value: 25}
+
+	bz, err := cdc.MarshalBinaryBare(msg)
+	require.NoError(t, err)
+
+	// binary encoding must match the plain protobuf encoding
+	expected, err := msg.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, expected, bz)
+
+	var res types.UInt64Value
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &res))
+	require.Equal(t, *msg, res)
+
+	bz, err = cdc.MarshalBinaryLengthPrefixed(msg)
+	require.NoError(t, err)
+	require.Equal(t, byte(len(expected)), bz[0])
+
+	res = types.UInt64Value{}
+	require.NoError(t, cdc.UnmarshalBinaryLengthPrefixed(bz, &res))
+	require.Equal(t, *msg, res)
+
+	require.Error(t, cdc.UnmarshalBinaryLengthPrefixed(bz[:len(bz)-1], &res))
+}
+
+func TestHybridCodecJSON(t *testing.T) {
+	cdc := codec.NewHybridCodec(codec.New())
+
+	bz, err := cdc.MarshalJSON(struct {
+		Value uint64 `json:"value"`
+	}{25})
+	require.NoError(t, err)
+	require.Equal(t, `{"value":"25"}`, string(bz))
+}
+
+func TestMarshalAny(t *testing.T) {
+	cdc := codec.NewHybridCodec(codec.New())
+	msg := &types.StringValue{Value: "foo"}
+
+	any, err := codec.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	require.Equal(t, "/google.protobuf.StringValue", any.TypeUrl)
+
+	bz, err := codec.MarshalAny(cdc, msg)
+	require.NoError(t, err)
+
+	res, err := codec.UnmarshalAny(cdc, bz)
+	require.NoError(t, err)
+	require.Equal(t, msg, res)
+
+	_, err = codec.UnmarshalAny(cdc, []byte{0x1})
+	require.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+type (
+	// Marshaler defines the interface modules use to encode and decode their
+	// state. Binary encoding of concrete types is performed through their
+	// protobuf definition, while JSON encoding is left to the implementation.
+	Marshaler interface {
+		MarshalBinaryBare(o ProtoMarshaler) ([]byte, error)
+		MustMarshalBinaryBare(o ProtoMarshaler) []byte
+
+		MarshalBinaryLengthPrefixed(o ProtoMarshaler) ([]byte, error)
+		MustMarshalBinaryLengthPrefixed(o ProtoMarshaler) []byte
+
+		UnmarshalBinaryBare(bz []byte, ptr ProtoMarshaler) error
+		MustUnmarshalBinaryBare(bz []byte, ptr ProtoMarshaler)
+
+		UnmarshalBinaryLengthPrefixed(bz []byte, ptr ProtoMarshaler) error
+		MustUnmarshalBinaryLengthPrefixed(bz []byte, ptr ProtoMarshaler)
+
+		JSONMarshaler
+	}
+
+	// JSONMarshaler defines the JSON encoding used for genesis state, queries
+	// and client responses.
+	JSONMarshaler interface {
+		MarshalJSON(o interface{}) ([]byte, error)
+		MustMarshalJSON(o interface{}) []byte
+
+		UnmarshalJSON(bz []byte, ptr interface{}) error
+		MustUnmarshalJSON(bz []byte, ptr interface{})
+	}
+
+	// ProtoMarshaler defines an interface a type must implement to be
+	// serialized as a protobuf message. It is implemented by the code
+	// generated from the type's .proto definition.
+	ProtoMarshaler interface {
+		proto.Message // for JSON serialization
+
+		Marshal() ([]byte, error)
+		MarshalTo(data []byte) (n int, err error)
+		MarshalToSizedBuffer(dAtA []byte) (int, error)
+		Size() int
+		Unmarshal(data []byte) error
+	}
+)
+
+// MarshalProtoLengthPrefixed encodes a protobuf message prefixed with its
+// length as an unsigned varint.
+func MarshalProtoLengthPrefixed(o ProtoMarshaler) ([]byte, error) {
+	size := o.Size()
+	bz := make([]byte, binary.MaxVarintLen64+size)
+
+	n := binary.PutUvarint(bz, uint64(size))
+	if _, err := o.MarshalToSizedBuffer(bz[n : n+size]); err != nil {
+		return nil, err
+	}
+
+	return bz[:n+size], nil
+}
+
+// UnmarshalProtoLengthPrefixed decodes a protobuf message prefixed with its
+// length as an unsigned varint.
+func UnmarshalProtoLengthPrefixed(bz []byte, ptr ProtoMarshaler) error {
+	size, n := binary.Uvarint(bz)
+	if n <= 0 {
+		return fmt.Errorf("invalid length prefix")
+	}
+
+	if uint64(len(bz)-n) != size {
+		return fmt.Errorf("invalid message length; expected %d, got %d", size, len(bz)-n)
+	}
+
+	return ptr.Unmarshal(bz[n:])
+}
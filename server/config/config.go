@@ -10,6 +10,17 @@ import (
 
 const (
 	defaultMinGasPrices = ""
+
+	// DefaultGRPCAddress is the default address the gRPC server binds to.
+	DefaultGRPCAddress = "0.0.0.0:9090"
+
+	// DefaultTelemetryAddress is the default address the Prometheus metrics
+	// endpoint binds to.
+	DefaultTelemetryAddress = "0.0.0.0:26661"
+
+	// DefaultTelemetryNamespace is the default namespace of the metrics
+	// exposed through Prometheus.
+	DefaultTelemetryNamespace = "cosmos_sdk"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -19,6 +30,12 @@ type BaseConfig struct {
 	// specified in this config (e.g. 0.25token1;0.0001token2).
 	MinGasPrices string `mapstructure:"minimum-gas-prices"`
 
+	// The minimum gas prices a validator is willing to accept for processing a
+	// transaction containing a message of a given type, overriding MinGasPrices.
+	// The prices of each message type are keyed by the route and type of the
+	// message (e.g. bank/send=0.25token1,0.0001token2;gov/deposit=0.5token1).
+	MsgMinGasPrices string `mapstructure:"msg-minimum-gas-prices"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -36,11 +53,44 @@ type BaseConfig struct {
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
 	Pruning string `mapstructure:"pruning"`
+
+	// SnapshotInterval is the block interval at which state sync snapshots are
+	// taken. A value of 0 disables snapshots.
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotKeepRecent is the number of recent state sync snapshots to keep.
+	// A value of 0 keeps all snapshots.
+	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+}
+
+// GRPCConfig defines the configuration of the gRPC query server.
+type GRPCConfig struct {
+	// Enable defines if the gRPC server should be started.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the address the gRPC server binds to.
+	Address string `mapstructure:"address"`
+}
+
+// TelemetryConfig defines the configuration of the application's metrics.
+type TelemetryConfig struct {
+	// Enable defines if metrics should be recorded and exposed through a
+	// Prometheus endpoint.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the address the Prometheus endpoint binds to.
+	Address string `mapstructure:"address"`
+
+	// Namespace defines the namespace the metrics are exposed under.
+	Namespace string `mapstructure:"namespace"`
 }
 
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
+
+	GRPC      GRPCConfig      `mapstructure:"grpc"`
+	Telemetry TelemetryConfig `mapstructure:"telemetry"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -73,10 +123,21 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 // DefaultConfig returns server's default configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		BaseConfig{
-			MinGasPrices:    defaultMinGasPrices,
-			InterBlockCache: true,
-			Pruning:         store.PruningStrategySyncable,
+		BaseConfig: BaseConfig{
+			MinGasPrices:       defaultMinGasPrices,
+			InterBlockCache:    true,
+			Pruning:            store.PruningStrategySyncable,
+			SnapshotInterval:   0,
+			SnapshotKeepRecent: 2,
+		},
+		GRPC: GRPCConfig{
+			Enable:  true,
+			Address: DefaultGRPCAddress,
+		},
+		Telemetry: TelemetryConfig{
+			Enable:    false,
+			Address:   DefaultTelemetryAddress,
+			Namespace: DefaultTelemetryNamespace,
 		},
 	}
 }
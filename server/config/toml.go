@@ -18,6 +18,12 @@ const defaultConfigTemplate = `# This is a TOML config file.
 # specified in this config (e.g. 0.25token1;0.0001token2).
 minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 
+# The minimum gas prices a validator is willing to accept for processing a
+# transaction containing a message of a given type, overriding minimum-gas-prices.
+# The prices of each message type are keyed by the route and type of the message
+# (e.g. bank/send=0.25token1,0.0001token2;gov/deposit=0.5token1).
+msg-minimum-gas-prices = "{{ .BaseConfig.MsgMinGasPrices }}"
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -39,6 +45,40 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: all saved states will be deleted, storing only the current state
 pruning = "{{ .BaseConfig.Pruning }}"
+
+# SnapshotInterval is the block interval at which state sync snapshots are taken
+# and saved to the data/snapshots directory. A value of 0 disables snapshots.
+# The interval should be aligned with the pruning strategy, such that snapshot
+# heights are not pruned before the snapshot has been taken.
+snapshot-interval = {{ .BaseConfig.SnapshotInterval }}
+
+# SnapshotKeepRecent is the number of recent state sync snapshots to keep.
+# A value of 0 keeps all snapshots.
+snapshot-keep-recent = {{ .BaseConfig.SnapshotKeepRecent }}
+
+##### gRPC query server configuration #####
+[grpc]
+
+# Enable defines if the gRPC query server should be started.
+enable = {{ .GRPC.Enable }}
+
+# Address defines the address the gRPC query server binds to. Historical
+# queries can be made by setting the x-height header of a request.
+address = "{{ .GRPC.Address }}"
+
+##### Telemetry configuration #####
+[telemetry]
+
+# Enable defines if the application should record metrics and expose them
+# through a Prometheus endpoint.
+enable = {{ .Telemetry.Enable }}
+
+# Address defines the address the Prometheus endpoint binds to. Metrics are
+# served under the /metrics path.
+address = "{{ .Telemetry.Address }}"
+
+# Namespace defines the namespace the metrics are exposed under.
+namespace = "{{ .Telemetry.Namespace }}"
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"net"
+
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GRPCApplication defines an application which exposes gRPC query services,
+// such as any application embedding a BaseApp.
+type GRPCApplication interface {
+	RegisterGRPCServer(server sdk.GRPCServer)
+}
+
+// startGRPCServer starts a gRPC server serving the query services of the given
+// application if enabled in the configuration. It returns a nil server if the
+// gRPC server is disabled or the application does not expose any services.
+func startGRPCServer(logger log.Logger, app abci.Application) (*grpc.Server, error) {
+	if !viper.GetBool(FlagGRPCEnable) {
+		return nil, nil
+	}
+
+	grpcApp, ok := app.(GRPCApplication)
+	if !ok {
+		logger.Info("application does not expose gRPC query services; not starting gRPC server")
+		return nil, nil
+	}
+
+	address := viper.GetString(FlagGRPCAddress)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcSrv := grpc.NewServer()
+	grpcApp.RegisterGRPCServer(grpcSrv)
+
+	go func() {
+		if err := grpcSrv.Serve(listener); err != nil {
+			logger.Error("failed to serve gRPC", "err", err)
+		}
+	}()
+
+	logger.Info("starting gRPC server", "address", address)
+	return grpcSrv, nil
+}
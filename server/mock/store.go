@@ -55,6 +55,22 @@ func (ms multiStore) SetPruning(opts sdk.PruningOptions) {
 	panic("not implemented")
 }
 
+func (ms multiStore) Snapshot(height uint64, w io.Writer) error {
+	panic("not implemented")
+}
+
+func (ms multiStore) Restore(height uint64, appHash []byte, r io.Reader) error {
+	panic("not implemented")
+}
+
+func (ms multiStore) HoldVersion(ver int64) {
+	panic("not implemented")
+}
+
+func (ms multiStore) ReleaseVersion(ver int64) {
+	panic("not implemented")
+}
+
 func (ms multiStore) GetCommitKVStore(key sdk.StoreKey) sdk.CommitKVStore {
 	panic("not implemented")
 }
@@ -99,6 +115,14 @@ func (ms multiStore) SetInterBlockCache(_ sdk.MultiStorePersistentCache) {
 	panic("not implemented")
 }
 
+func (ms multiStore) AddListeners(_ sdk.StoreKey, _ []sdk.WriteListener) {
+	panic("not implemented")
+}
+
+func (ms multiStore) ListeningEnabled(_ sdk.StoreKey) bool {
+	return false
+}
+
 var _ sdk.KVStore = kvStore{}
 
 type kvStore struct {
@@ -16,6 +16,8 @@ import (
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
 )
 
 // Tendermint full-node start flags
@@ -26,9 +28,20 @@ const (
 	flagPruning         = "pruning"
 	flagCPUProfile      = "cpu-profile"
 	FlagMinGasPrices    = "minimum-gas-prices"
+	FlagMsgMinGasPrices = "msg-minimum-gas-prices"
 	FlagHaltHeight      = "halt-height"
 	FlagHaltTime        = "halt-time"
 	FlagInterBlockCache = "inter-block-cache"
+
+	FlagSnapshotInterval   = "snapshot-interval"
+	FlagSnapshotKeepRecent = "snapshot-keep-recent"
+
+	FlagGRPCEnable  = "grpc.enable"
+	FlagGRPCAddress = "grpc.address"
+
+	FlagTelemetryEnable    = "telemetry.enable"
+	FlagTelemetryAddress   = "telemetry.address"
+	FlagTelemetryNamespace = "telemetry.namespace"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -52,6 +65,19 @@ the halt-height or if the current block time is greater than or equal to the hal
 node will attempt to gracefully shutdown and the block will not be committed. In addition, the node
 will not be able to commit subsequent blocks.
 
+State sync snapshots of the application state can be taken periodically via the
+'--snapshot-interval' flag, and are saved in the data/snapshots directory. Only the
+'--snapshot-keep-recent' most recent snapshots are kept.
+
+A gRPC server exposing the query services of the application's modules is started
+on the address given by '--grpc.address' unless disabled via '--grpc.enable=false'.
+Queries are executed against the latest committed state, or against the state of the
+height given in the 'x-height' header of a request.
+
+Metrics such as transaction throughput, gas used per message type, module BeginBlock and
+EndBlock durations and store latencies can be recorded via '--telemetry.enable'. They are
+exposed to Prometheus under the /metrics path of the '--telemetry.address' endpoint.
+
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
 `,
@@ -77,9 +103,20 @@ which accepts a path for the resulting pprof file.
 		FlagMinGasPrices, "",
 		"Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)",
 	)
+	cmd.Flags().String(
+		FlagMsgMinGasPrices, "",
+		"Minimum gas prices to accept for transactions containing a message type, overriding --minimum-gas-prices (e.g. bank/send=0.01photino;gov/deposit=0.1photino,0.001stake)",
+	)
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Uint64(FlagSnapshotInterval, 0, "State sync snapshot interval in blocks (0 disables snapshots)")
+	cmd.Flags().Uint32(FlagSnapshotKeepRecent, 2, "Number of recent state sync snapshots to keep (0 keeps all)")
+	cmd.Flags().Bool(FlagGRPCEnable, true, "Enable the gRPC query server")
+	cmd.Flags().String(FlagGRPCAddress, config.DefaultGRPCAddress, "The gRPC query server address to listen on")
+	cmd.Flags().Bool(FlagTelemetryEnable, false, "Record metrics and expose them through a Prometheus endpoint")
+	cmd.Flags().String(FlagTelemetryAddress, config.DefaultTelemetryAddress, "The Prometheus metrics endpoint address to listen on")
+	cmd.Flags().String(FlagTelemetryNamespace, config.DefaultTelemetryNamespace, "The namespace of the metrics exposed through Prometheus")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 
 	// add support for all Tendermint-specific command line options
@@ -101,6 +138,11 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 		return err
 	}
 
+	telemetrySrv, err := startTelemetry(ctx.Logger)
+	if err != nil {
+		return err
+	}
+
 	app := appCreator(ctx.Logger, db, traceWriter)
 
 	svr, err := server.NewServer(addr, "socket", app)
@@ -115,8 +157,21 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 		cmn.Exit(err.Error())
 	}
 
+	grpcSrv, err := startGRPCServer(ctx.Logger, app)
+	if err != nil {
+		return err
+	}
+
 	cmn.TrapSignal(ctx.Logger, func() {
 		// cleanup
+		if grpcSrv != nil {
+			grpcSrv.Stop()
+		}
+
+		if telemetrySrv != nil {
+			_ = telemetrySrv.Close()
+		}
+
 		err = svr.Stop()
 		if err != nil {
 			cmn.Exit(err.Error())
@@ -141,6 +196,11 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 		return nil, err
 	}
 
+	telemetrySrv, err := startTelemetry(ctx.Logger)
+	if err != nil {
+		return nil, err
+	}
+
 	app := appCreator(ctx.Logger, db, traceWriter)
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
@@ -169,6 +229,11 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 		return nil, err
 	}
 
+	grpcSrv, err := startGRPCServer(ctx.Logger, app)
+	if err != nil {
+		return nil, err
+	}
+
 	var cpuProfileCleanup func()
 
 	if cpuProfile := viper.GetString(flagCPUProfile); cpuProfile != "" {
@@ -190,6 +255,14 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 	}
 
 	TrapSignal(func() {
+		if grpcSrv != nil {
+			grpcSrv.Stop()
+		}
+
+		if telemetrySrv != nil {
+			_ = telemetrySrv.Close()
+		}
+
 		if tmNode.IsRunning() {
 			_ = tmNode.Stop()
 		}
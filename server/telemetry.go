@@ -0,0 +1,43 @@
+package server
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// startTelemetry enables the recording of the application's metrics and starts
+// an HTTP server exposing them to Prometheus if enabled in the configuration.
+// It must be called before the application is created. It returns a nil server
+// if telemetry is disabled.
+func startTelemetry(logger log.Logger) (*http.Server, error) {
+	if !viper.GetBool(FlagTelemetryEnable) {
+		return nil, nil
+	}
+
+	address := viper.GetString(FlagTelemetryAddress)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.SetDefault(telemetry.PrometheusMetrics(viper.GetString(FlagTelemetryNamespace)))
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	srv := &http.Server{Handler: mux}
+
+	go func() {
+		if err := srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logger.Error("failed to serve Prometheus metrics", "err", err)
+		}
+	}()
+
+	logger.Info("starting Prometheus metrics server", "address", address)
+	return srv, nil
+}
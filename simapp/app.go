@@ -17,10 +17,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
@@ -33,7 +36,17 @@ import (
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 )
 
-const appName = "SimApp"
+const (
+	appName = "SimApp"
+
+	// MinCommissionRateUpgradeName is the name of the software upgrade
+	// introducing the MinCommissionRate staking param
+	MinCommissionRateUpgradeName = "min-commission-rate"
+
+	// AccountPruningUpgradeName is the name of the software upgrade
+	// introducing the AccountPruningBlocks auth param
+	AccountPruningUpgradeName = "account-pruning"
+)
 
 var (
 	// DefaultCLIHome default home directories for the application CLI
@@ -42,6 +55,11 @@ var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome = os.ExpandEnv("$HOME/.simapp")
 
+	// UpgradeMinCommissionRate is the MinCommissionRate staking param set by the
+	// min-commission-rate upgrade, which raises the commission of the validators
+	// charging less
+	UpgradeMinCommissionRate = sdk.NewDecWithPrec(5, 2)
+
 	// ModuleBasics defines the module BasicManager is in charge of setting up basic,
 	// non-dependant module elements, such as codec registration
 	// and genesis verification.
@@ -61,29 +79,45 @@ var (
 		slashing.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		authz.AppModuleBasic{},
+		feemarket.AppModuleBasic{},
+		vesting.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		auth.FeeCollectorName:     nil,
-		distr.ModuleName:          nil,
-		mint.ModuleName:           {supply.Minter},
-		staking.BondedPoolName:    {supply.Burner, supply.Staking},
-		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
-		gov.ModuleName:            {supply.Burner},
+		auth.FeeCollectorName:         nil,
+		distr.ModuleName:              nil,
+		mint.ModuleName:               {supply.Minter},
+		staking.BondedPoolName:        {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName:     {supply.Burner, supply.Staking},
+		staking.TokenizeSharePoolName: {supply.Minter, supply.Burner},
+		gov.ModuleName:                {supply.Burner},
+		feemarket.ModuleName:          {supply.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
 	allowedReceivingModAcc = map[string]bool{
 		distr.ModuleName: true,
 	}
+
+	// module accounts whose transfers are not restricted by the bank
+	// SendEnabled params, as the fees, rewards, deposits and bonded tokens
+	// moved through them by the state machine must keep flowing
+	sendEnabledExemptModAcc = map[string]bool{
+		auth.FeeCollectorName:     true,
+		distr.ModuleName:          true,
+		staking.BondedPoolName:    true,
+		staking.NotBondedPoolName: true,
+		gov.ModuleName:            true,
+	}
 )
 
 // MakeCodec - custom tx codec
 func MakeCodec() *codec.Codec {
 	var cdc = codec.New()
 	ModuleBasics.RegisterCodec(cdc)
-	vesting.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	return cdc
@@ -97,7 +131,8 @@ var _ App = (*SimApp)(nil)
 // capabilities aren't needed for testing.
 type SimApp struct {
 	*bam.BaseApp
-	cdc *codec.Codec
+	cdc      *codec.Codec
+	appCodec *codec.HybridCodec
 
 	invCheckPeriod uint
 
@@ -109,18 +144,21 @@ type SimApp struct {
 	subspaces map[string]params.Subspace
 
 	// keepers
-	AccountKeeper  auth.AccountKeeper
-	BankKeeper     bank.Keeper
-	SupplyKeeper   supply.Keeper
-	StakingKeeper  staking.Keeper
-	SlashingKeeper slashing.Keeper
-	MintKeeper     mint.Keeper
-	DistrKeeper    distr.Keeper
-	GovKeeper      gov.Keeper
-	CrisisKeeper   crisis.Keeper
-	UpgradeKeeper  upgrade.Keeper
-	ParamsKeeper   params.Keeper
-	EvidenceKeeper evidence.Keeper
+	AccountKeeper   auth.AccountKeeper
+	BankKeeper      bank.Keeper
+	SupplyKeeper    supply.Keeper
+	StakingKeeper   staking.Keeper
+	SlashingKeeper  slashing.Keeper
+	MintKeeper      mint.Keeper
+	DistrKeeper     distr.Keeper
+	GovKeeper       gov.Keeper
+	CrisisKeeper    crisis.Keeper
+	UpgradeKeeper   upgrade.Keeper
+	ParamsKeeper    params.Keeper
+	EvidenceKeeper  evidence.Keeper
+	FeeGrantKeeper  feegrant.Keeper
+	AuthzKeeper     authz.Keeper
+	FeeMarketKeeper feemarket.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -136,21 +174,24 @@ func NewSimApp(
 ) *SimApp {
 
 	cdc := MakeCodec()
+	appCodec := codec.NewHybridCodec(cdc)
 
 	bApp := bam.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
 
 	keys := sdk.NewKVStoreKeys(
-		bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
+		bam.MainStoreKey, auth.StoreKey, bank.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
 		gov.StoreKey, params.StoreKey, upgrade.StoreKey, evidence.StoreKey,
+		feegrant.StoreKey, authz.StoreKey, feemarket.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
 
 	app := &SimApp{
 		BaseApp:        bApp,
 		cdc:            cdc,
+		appCodec:       appCodec,
 		invCheckPeriod: invCheckPeriod,
 		keys:           keys,
 		tkeys:          tkeys,
@@ -168,21 +209,23 @@ func NewSimApp(
 	app.subspaces[gov.ModuleName] = app.ParamsKeeper.Subspace(gov.DefaultParamspace).WithKeyTable(gov.ParamKeyTable())
 	app.subspaces[crisis.ModuleName] = app.ParamsKeeper.Subspace(crisis.DefaultParamspace)
 	app.subspaces[evidence.ModuleName] = app.ParamsKeeper.Subspace(evidence.DefaultParamspace)
+	app.subspaces[feemarket.ModuleName] = app.ParamsKeeper.Subspace(feemarket.DefaultParamspace)
 
 	// add keepers
 	app.AccountKeeper = auth.NewAccountKeeper(
-		app.cdc, keys[auth.StoreKey], app.subspaces[auth.ModuleName], auth.ProtoBaseAccount,
+		appCodec, keys[auth.StoreKey], app.subspaces[auth.ModuleName], auth.ProtoBaseAccount,
 	)
 	app.BankKeeper = bank.NewBaseKeeper(
-		app.AccountKeeper, app.subspaces[bank.ModuleName], bank.DefaultCodespace,
-		app.BlacklistedAccAddrs(),
+		app.cdc, keys[bank.StoreKey], app.AccountKeeper, app.subspaces[bank.ModuleName], bank.DefaultCodespace,
+		app.BlacklistedAccAddrs(), app.SendEnabledExemptAccAddrs(),
 	)
 	app.SupplyKeeper = supply.NewKeeper(
 		app.cdc, keys[supply.StoreKey], app.AccountKeeper, app.BankKeeper, maccPerms,
 	)
 	stakingKeeper := staking.NewKeeper(
-		app.cdc, keys[staking.StoreKey], app.SupplyKeeper, app.subspaces[staking.ModuleName],
-		staking.DefaultCodespace)
+		appCodec, keys[staking.StoreKey], app.AccountKeeper, app.SupplyKeeper,
+		app.subspaces[staking.ModuleName], staking.DefaultCodespace,
+	)
 	app.MintKeeper = mint.NewKeeper(
 		app.cdc, keys[mint.StoreKey], app.subspaces[mint.ModuleName], &stakingKeeper,
 		app.SupplyKeeper, auth.FeeCollectorName,
@@ -198,6 +241,12 @@ func NewSimApp(
 		app.subspaces[crisis.ModuleName], invCheckPeriod, app.SupplyKeeper, auth.FeeCollectorName,
 	)
 	app.UpgradeKeeper = upgrade.NewKeeper(keys[upgrade.StoreKey], app.cdc)
+	app.FeeGrantKeeper = feegrant.NewKeeper(app.cdc, keys[feegrant.StoreKey], feegrant.DefaultCodespace)
+	app.AuthzKeeper = authz.NewKeeper(app.cdc, keys[authz.StoreKey], app.Router(), authz.DefaultCodespace)
+	app.FeeMarketKeeper = feemarket.NewKeeper(
+		app.cdc, keys[feemarket.StoreKey], app.subspaces[feemarket.ModuleName], app.SupplyKeeper,
+		auth.FeeCollectorName,
+	)
 
 	// create evidence keeper with router
 	evidenceKeeper := evidence.NewKeeper(
@@ -216,7 +265,7 @@ func NewSimApp(
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
 	app.GovKeeper = gov.NewKeeper(
-		app.cdc, keys[gov.StoreKey], app.subspaces[gov.ModuleName], app.SupplyKeeper,
+		appCodec, keys[gov.StoreKey], app.subspaces[gov.ModuleName], app.SupplyKeeper,
 		&stakingKeeper, gov.DefaultCodespace, govRouter,
 	)
 
@@ -226,11 +275,19 @@ func NewSimApp(
 		staking.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
+	// register the handlers of the software upgrades migrating the state
+	app.UpgradeKeeper.SetUpgradeHandler(MinCommissionRateUpgradeName, func(ctx sdk.Context, _ upgrade.Plan) {
+		app.StakingKeeper.MigrateMinCommissionRate(ctx, UpgradeMinCommissionRate)
+	})
+	app.UpgradeKeeper.SetUpgradeHandler(AccountPruningUpgradeName, func(ctx sdk.Context, _ upgrade.Plan) {
+		app.AccountKeeper.SetAccountPruningBlocks(ctx, auth.DefaultAccountPruningBlocks)
+	})
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
 		genutil.NewAppModule(app.AccountKeeper, app.StakingKeeper, app.BaseApp.DeliverTx),
-		auth.NewAppModule(app.AccountKeeper),
+		auth.NewAppModule(app.AccountKeeper, app.StakingKeeper),
 		bank.NewAppModule(app.BankKeeper, app.AccountKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper),
 		supply.NewAppModule(app.SupplyKeeper, app.AccountKeeper),
@@ -241,31 +298,40 @@ func NewSimApp(
 		staking.NewAppModule(app.StakingKeeper, app.AccountKeeper, app.SupplyKeeper),
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
+		feegrant.NewAppModule(app.FeeGrantKeeper, app.AccountKeeper),
+		authz.NewAppModule(app.AuthzKeeper),
+		feemarket.NewAppModule(app.FeeMarketKeeper),
+		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName, evidence.ModuleName)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName, evidence.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(
+		crisis.ModuleName, gov.ModuleName, staking.ModuleName, auth.ModuleName, supply.ModuleName,
+		feemarket.ModuleName,
+	)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(
 		auth.ModuleName, distr.ModuleName, staking.ModuleName, bank.ModuleName,
 		slashing.ModuleName, gov.ModuleName, mint.ModuleName, supply.ModuleName,
-		crisis.ModuleName, genutil.ModuleName, evidence.ModuleName,
+		crisis.ModuleName, genutil.ModuleName, evidence.ModuleName, feegrant.ModuleName,
+		authz.ModuleName, feemarket.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	app.mm.RegisterQueryServices(app.GRPCQueryRouter())
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
 	// NOTE: this is not required apps that don't use the simulator for fuzz testing
 	// transactions
 	app.sm = module.NewSimulationManager(
-		auth.NewAppModule(app.AccountKeeper),
+		auth.NewAppModule(app.AccountKeeper, app.StakingKeeper),
 		bank.NewAppModule(app.BankKeeper, app.AccountKeeper),
 		supply.NewAppModule(app.SupplyKeeper, app.AccountKeeper),
 		gov.NewAppModule(app.GovKeeper, app.AccountKeeper, app.SupplyKeeper),
@@ -273,6 +339,9 @@ func NewSimApp(
 		staking.NewAppModule(app.StakingKeeper, app.AccountKeeper, app.SupplyKeeper),
 		distr.NewAppModule(app.DistrKeeper, app.AccountKeeper, app.SupplyKeeper, app.StakingKeeper),
 		slashing.NewAppModule(app.SlashingKeeper, app.AccountKeeper, app.StakingKeeper),
+		feegrant.NewAppModule(app.FeeGrantKeeper, app.AccountKeeper),
+		feemarket.NewAppModule(app.FeeMarketKeeper),
+		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		params.NewAppModule(), // NOTE: only used for simulation to generate randomized param change proposals
 	)
 
@@ -285,7 +354,10 @@ func NewSimApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(ante.NewAnteHandler(
+		app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, auth.DefaultSigVerificationGasConsumer,
+		auth.DefaultSignModeHandler(auth.DefaultTxEncoder(app.cdc)),
+	))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -315,7 +387,7 @@ func (app *SimApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Re
 func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
-	return app.mm.InitGenesis(ctx, genesisState)
+	return app.mm.InitGenesis(ctx, app.cdc, genesisState)
 }
 
 // LoadHeight loads a particular height
@@ -343,6 +415,17 @@ func (app *SimApp) BlacklistedAccAddrs() map[string]bool {
 	return blacklistedAddrs
 }
 
+// SendEnabledExemptAccAddrs returns the app's module account addresses exempt
+// from the bank SendEnabled params.
+func (app *SimApp) SendEnabledExemptAccAddrs() map[string]bool {
+	exemptAddrs := make(map[string]bool)
+	for acc := range sendEnabledExemptModAcc {
+		exemptAddrs[supply.NewModuleAddress(acc).String()] = true
+	}
+
+	return exemptAddrs
+}
+
 // Codec returns SimApp's codec.
 //
 // NOTE: This is solely to be used for testing purposes as it may be desirable
@@ -351,6 +434,15 @@ func (app *SimApp) Codec() *codec.Codec {
 	return app.cdc
 }
 
+// AppCodec returns SimApp's app codec, used by modules for protobuf state
+// encoding.
+//
+// NOTE: This is solely to be used for testing purposes as it may be desirable
+// for modules to register their own custom testing types.
+func (app *SimApp) AppCodec() *codec.HybridCodec {
+	return app.appCodec
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -5,10 +5,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -50,3 +56,59 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestMinCommissionRateUpgrade(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 10})
+
+	// chains started before the param existed do not hold it
+	key := append([]byte(staking.DefaultParamspace+"/"), staking.KeyMinCommissionRate...)
+	store := ctx.KVStore(app.GetKey(params.StoreKey))
+	store.Delete(key)
+	require.True(t, app.StakingKeeper.MinCommissionRate(ctx).IsZero())
+
+	// a validator charging less than the new minimum and one charging more
+	lowValAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	lowVal := staking.NewValidator(lowValAddr, ed25519.GenPrivKey().PubKey(), staking.Description{})
+	lowVal.Commission = staking.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2))
+	app.StakingKeeper.SetValidator(ctx, lowVal)
+
+	highValAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	highVal := staking.NewValidator(highValAddr, ed25519.GenPrivKey().PubKey(), staking.Description{})
+	highVal.Commission = staking.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	app.StakingKeeper.SetValidator(ctx, highVal)
+
+	plan := upgrade.Plan{Name: MinCommissionRateUpgradeName, Height: 10}
+	require.NotPanics(t, func() { app.UpgradeKeeper.ApplyUpgrade(ctx, plan) })
+
+	require.True(t, store.Has(key))
+	require.True(t, UpgradeMinCommissionRate.IsPositive())
+	require.Equal(t, UpgradeMinCommissionRate, app.StakingKeeper.GetParams(ctx).MinCommissionRate)
+
+	lowVal, found := app.StakingKeeper.GetValidator(ctx, lowValAddr)
+	require.True(t, found)
+	require.Equal(t, UpgradeMinCommissionRate, lowVal.Commission.Rate)
+	require.Equal(t, UpgradeMinCommissionRate, lowVal.Commission.MaxRate)
+
+	highVal, found = app.StakingKeeper.GetValidator(ctx, highValAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), highVal.Commission.Rate)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), highVal.Commission.MaxRate)
+}
+
+func TestAccountPruningUpgrade(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 10})
+
+	// chains started before the param existed do not hold it
+	key := append([]byte(auth.DefaultParamspace+"/"), auth.KeyAccountPruningBlocks...)
+	store := ctx.KVStore(app.GetKey(params.StoreKey))
+	store.Delete(key)
+	require.Panics(t, func() { app.AccountKeeper.GetParams(ctx) })
+
+	plan := upgrade.Plan{Name: AccountPruningUpgradeName, Height: 10}
+	require.NotPanics(t, func() { app.UpgradeKeeper.ApplyUpgrade(ctx, plan) })
+
+	require.True(t, store.Has(key))
+	require.Equal(t, auth.DefaultAccountPruningBlocks, app.AccountKeeper.GetParams(ctx).AccountPruningBlocks)
+}
@@ -15,5 +15,6 @@ type GenesisState map[string]json.RawMessage
 
 // NewDefaultGenesisState generates the default state for the application.
 func NewDefaultGenesisState() GenesisState {
-	return ModuleBasics.DefaultGenesis()
+	cdc := MakeCodec()
+	return ModuleBasics.DefaultGenesis(cdc)
 }
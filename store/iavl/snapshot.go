@@ -0,0 +1,247 @@
+package iavl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// The IAVL node database layout, see github.com/tendermint/iavl/nodedb.go.
+const (
+	nodeKeyPrefix = 'n' // n<hash>
+	rootKeyPrefix = 'r' // r<version>
+)
+
+// snapshotNode is a decoded IAVL node as persisted in the node database.
+type snapshotNode struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+func (n snapshotNode) isLeaf() bool {
+	return n.height == 0
+}
+
+// decodeSnapshotNode decodes a node encoded by the IAVL node database.
+func decodeSnapshotNode(bz []byte) (snapshotNode, error) {
+	var (
+		node snapshotNode
+		n    int
+		err  error
+	)
+
+	if node.height, n, err = amino.DecodeInt8(bz); err != nil {
+		return node, errors.Wrap(err, "decoding node height")
+	}
+	bz = bz[n:]
+
+	if node.size, n, err = amino.DecodeVarint(bz); err != nil {
+		return node, errors.Wrap(err, "decoding node size")
+	}
+	bz = bz[n:]
+
+	if node.version, n, err = amino.DecodeVarint(bz); err != nil {
+		return node, errors.Wrap(err, "decoding node version")
+	}
+	bz = bz[n:]
+
+	if node.key, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return node, errors.Wrap(err, "decoding node key")
+	}
+	bz = bz[n:]
+
+	if node.isLeaf() {
+		if node.value, _, err = amino.DecodeByteSlice(bz); err != nil {
+			return node, errors.Wrap(err, "decoding node value")
+		}
+
+		return node, nil
+	}
+
+	if node.leftHash, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return node, errors.Wrap(err, "decoding node left hash")
+	}
+	bz = bz[n:]
+
+	if node.rightHash, _, err = amino.DecodeByteSlice(bz); err != nil {
+		return node, errors.Wrap(err, "decoding node right hash")
+	}
+
+	return node, nil
+}
+
+// hash computes the node hash the same way IAVL does, which requires the hashes
+// of any children to be present in the node.
+func (n snapshotNode) hash() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := amino.EncodeInt8(&buf, n.height); err != nil {
+		return nil, err
+	}
+	if err := amino.EncodeVarint(&buf, n.size); err != nil {
+		return nil, err
+	}
+	if err := amino.EncodeVarint(&buf, n.version); err != nil {
+		return nil, err
+	}
+
+	if n.isLeaf() {
+		if err := amino.EncodeByteSlice(&buf, n.key); err != nil {
+			return nil, err
+		}
+		if err := amino.EncodeByteSlice(&buf, tmhash.Sum(n.value)); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := amino.EncodeByteSlice(&buf, n.leftHash); err != nil {
+			return nil, err
+		}
+		if err := amino.EncodeByteSlice(&buf, n.rightHash); err != nil {
+			return nil, err
+		}
+	}
+
+	return tmhash.Sum(buf.Bytes()), nil
+}
+
+func nodeKey(hash []byte) []byte {
+	return append([]byte{nodeKeyPrefix}, hash...)
+}
+
+func rootKey(version int64) []byte {
+	key := make([]byte, 9)
+	key[0] = rootKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], uint64(version))
+
+	return key
+}
+
+// ExportNodes walks the IAVL tree persisted in db at the given version and calls
+// fn with every encoded node in post-order, i.e. children always precede their
+// parent. The root hash of the exported version is returned. The exported nodes
+// can be re-imported with an Importer, which verifies every node hash.
+func ExportNodes(db dbm.DB, version int64, fn func(node []byte) error) ([]byte, error) {
+	rootHash := db.Get(rootKey(version))
+	if rootHash == nil {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
+
+	if len(rootHash) == 0 {
+		// empty tree
+		return nil, nil
+	}
+
+	return rootHash, exportNode(db, rootHash, fn)
+}
+
+func exportNode(db dbm.DB, hash []byte, fn func(node []byte) error) error {
+	bz := db.Get(nodeKey(hash))
+	if bz == nil {
+		return fmt.Errorf("node %X not found; ensure the version has not been pruned", hash)
+	}
+
+	node, err := decodeSnapshotNode(bz)
+	if err != nil {
+		return err
+	}
+
+	if !node.isLeaf() {
+		if err := exportNode(db, node.leftHash, fn); err != nil {
+			return err
+		}
+		if err := exportNode(db, node.rightHash, fn); err != nil {
+			return err
+		}
+	}
+
+	return fn(bz)
+}
+
+// Importer writes nodes produced by ExportNodes into an IAVL node database.
+// Each node is verified against the hashes referenced by its parent, and the
+// resulting root hash is verified when the import is committed.
+type Importer struct {
+	batch   dbm.Batch
+	version int64
+	stack   [][]byte // hashes of imported subtrees not yet claimed by a parent
+}
+
+// NewImporter returns an Importer that writes the given tree version into db.
+// The db is expected to be empty.
+func NewImporter(db dbm.DB, version int64) *Importer {
+	return &Importer{
+		batch:   db.NewBatch(),
+		version: version,
+	}
+}
+
+// Add imports a single encoded node.
+func (imp *Importer) Add(bz []byte) error {
+	node, err := decodeSnapshotNode(bz)
+	if err != nil {
+		return err
+	}
+
+	if node.version > imp.version {
+		return fmt.Errorf("node version %d is newer than imported version %d", node.version, imp.version)
+	}
+
+	if !node.isLeaf() {
+		if len(imp.stack) < 2 {
+			return fmt.Errorf("inner node at height %d is missing its children", node.height)
+		}
+
+		left, right := imp.stack[len(imp.stack)-2], imp.stack[len(imp.stack)-1]
+		if !bytes.Equal(left, node.leftHash) || !bytes.Equal(right, node.rightHash) {
+			return fmt.Errorf("inner node at height %d does not match its children", node.height)
+		}
+
+		imp.stack = imp.stack[:len(imp.stack)-2]
+	}
+
+	hash, err := node.hash()
+	if err != nil {
+		return err
+	}
+
+	imp.batch.Set(nodeKey(hash), bz)
+	imp.stack = append(imp.stack, hash)
+
+	return nil
+}
+
+// Commit verifies the imported tree against the expected root hash and writes
+// it to the database. An empty root hash denotes an empty tree.
+func (imp *Importer) Commit(rootHash []byte) error {
+	defer imp.batch.Close()
+
+	switch {
+	case len(rootHash) == 0 && len(imp.stack) != 0:
+		return fmt.Errorf("expected empty tree, got %d nodes", len(imp.stack))
+
+	case len(rootHash) != 0 && len(imp.stack) != 1:
+		return fmt.Errorf("expected a single root node, got %d", len(imp.stack))
+
+	case len(rootHash) != 0 && !bytes.Equal(imp.stack[0], rootHash):
+		return fmt.Errorf("root hash mismatch: expected %X, got %X", rootHash, imp.stack[0])
+	}
+
+	if rootHash == nil {
+		rootHash = []byte{}
+	}
+
+	imp.batch.Set(rootKey(imp.version), rootHash)
+	imp.batch.Write()
+
+	return nil
+}
@@ -3,12 +3,15 @@ package iavl
 import (
 	"fmt"
 	"io"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	serrors "github.com/cosmos/cosmos-sdk/store/errors"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 
 	"github.com/pkg/errors"
 	"github.com/tendermint/iavl"
@@ -44,6 +47,12 @@ type Store struct {
 	// By default this value should be set the same across all nodes,
 	// so that nodes can know the waypoints their peers store.
 	storeEvery int64
+
+	// Versions held from pruning, e.g. while a snapshot of them is taken, and
+	// the held versions whose pruning was deferred until they are released.
+	heldMtx      sync.Mutex
+	heldVersions map[int64]int
+	heldPruned   map[int64]bool
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally it will load the
@@ -116,12 +125,10 @@ func (st *Store) Commit() types.CommitID {
 	if st.numRecent < previous {
 		toRelease := previous - st.numRecent
 		if st.storeEvery == 0 || toRelease%st.storeEvery != 0 {
-			err := st.tree.DeleteVersion(toRelease)
-			if errCause := errors.Cause(err); errCause != nil && errCause != iavl.ErrVersionDoesNotExist {
-				panic(err)
-			}
+			st.pruneVersion(toRelease)
 		}
 	}
+	st.pruneReleasedVersions()
 
 	return types.CommitID{
 		Version: version,
@@ -129,6 +136,71 @@ func (st *Store) Commit() types.CommitID {
 	}
 }
 
+// pruneVersion deletes a version, unless it is held in which case it is
+// deleted by the first commit after it is released.
+func (st *Store) pruneVersion(version int64) {
+	st.heldMtx.Lock()
+	if st.heldVersions[version] > 0 {
+		if st.heldPruned == nil {
+			st.heldPruned = make(map[int64]bool)
+		}
+		st.heldPruned[version] = true
+		st.heldMtx.Unlock()
+		return
+	}
+	st.heldMtx.Unlock()
+
+	err := st.tree.DeleteVersion(version)
+	if errCause := errors.Cause(err); errCause != nil && errCause != iavl.ErrVersionDoesNotExist {
+		panic(err)
+	}
+}
+
+// pruneReleasedVersions deletes the versions whose pruning was deferred while
+// they were held, and which have been released since.
+func (st *Store) pruneReleasedVersions() {
+	st.heldMtx.Lock()
+	var released []int64
+	for version := range st.heldPruned {
+		if st.heldVersions[version] == 0 {
+			released = append(released, version)
+			delete(st.heldPruned, version)
+		}
+	}
+	st.heldMtx.Unlock()
+
+	sort.Slice(released, func(i, j int) bool { return released[i] < released[j] })
+	for _, version := range released {
+		st.pruneVersion(version)
+	}
+}
+
+// HoldVersion keeps a version from being pruned until it is released with
+// ReleaseVersion. A version may be held several times, in which case it must
+// be released as many times.
+func (st *Store) HoldVersion(version int64) {
+	st.heldMtx.Lock()
+	defer st.heldMtx.Unlock()
+
+	if st.heldVersions == nil {
+		st.heldVersions = make(map[int64]int)
+	}
+	st.heldVersions[version]++
+}
+
+// ReleaseVersion releases a version held by HoldVersion. The version is pruned
+// by the next commit if it was meant to be pruned while it was held.
+func (st *Store) ReleaseVersion(version int64) {
+	st.heldMtx.Lock()
+	defer st.heldMtx.Unlock()
+
+	if st.heldVersions[version] <= 1 {
+		delete(st.heldVersions, version)
+		return
+	}
+	st.heldVersions[version]--
+}
+
 // Implements Committer.
 func (st *Store) LastCommitID() types.CommitID {
 	return types.CommitID{
@@ -165,23 +237,39 @@ func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.Ca
 
 // Implements types.KVStore.
 func (st *Store) Set(key, value []byte) {
+	if telemetry.Enabled() {
+		defer telemetry.ObserveSince(telemetry.Default().StoreWriteLatency.With(telemetry.LabelStore, "iavl", telemetry.LabelOperation, "set"), time.Now())
+	}
+
 	types.AssertValidValue(value)
 	st.tree.Set(key, value)
 }
 
 // Implements types.KVStore.
 func (st *Store) Get(key []byte) []byte {
+	if telemetry.Enabled() {
+		defer telemetry.ObserveSince(telemetry.Default().StoreReadLatency.With(telemetry.LabelStore, "iavl", telemetry.LabelOperation, "get"), time.Now())
+	}
+
 	_, value := st.tree.Get(key)
 	return value
 }
 
 // Implements types.KVStore.
 func (st *Store) Has(key []byte) (exists bool) {
+	if telemetry.Enabled() {
+		defer telemetry.ObserveSince(telemetry.Default().StoreReadLatency.With(telemetry.LabelStore, "iavl", telemetry.LabelOperation, "has"), time.Now())
+	}
+
 	return st.tree.Has(key)
 }
 
 // Implements types.KVStore.
 func (st *Store) Delete(key []byte) {
+	if telemetry.Enabled() {
+		defer telemetry.ObserveSince(telemetry.Default().StoreWriteLatency.With(telemetry.LabelStore, "iavl", telemetry.LabelOperation, "delete"), time.Now())
+	}
+
 	st.tree.Remove(key)
 }
 
@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/errors"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 var (
@@ -467,6 +468,32 @@ func TestIAVLPruneEverything(t *testing.T) {
 	}
 }
 
+func TestIAVLHoldVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, int64(0), int64(0))
+	nextVersion(iavlStore)
+
+	// a held version is not pruned until it is released as many times
+	iavlStore.HoldVersion(1)
+	iavlStore.HoldVersion(1)
+	nextVersion(iavlStore)
+	nextVersion(iavlStore)
+	require.True(t, iavlStore.VersionExists(1))
+	require.False(t, iavlStore.VersionExists(2))
+
+	iavlStore.ReleaseVersion(1)
+	nextVersion(iavlStore)
+	require.True(t, iavlStore.VersionExists(1))
+
+	// the released version is pruned by the next commit
+	iavlStore.ReleaseVersion(1)
+	require.True(t, iavlStore.VersionExists(1))
+	nextVersion(iavlStore)
+	require.False(t, iavlStore.VersionExists(1))
+	require.True(t, iavlStore.VersionExists(5))
+}
+
 func TestIAVLStoreQuery(t *testing.T) {
 	db := dbm.NewMemDB()
 	tree := iavl.NewMutableTree(db, cacheSize)
@@ -579,3 +606,31 @@ func BenchmarkIAVLIteratorNext(b *testing.B) {
 		}
 	}
 }
+
+func TestIAVLStoreTelemetry(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, _ := newAlohaTree(t, db)
+	iavlStore := UnsafeNewStore(tree, numRecent, storeEvery)
+
+	sink := telemetry.NewInMemSink()
+
+	// latencies are not measured while telemetry is disabled
+	iavlStore.Get([]byte("hello"))
+	iavlStore.Set([]byte("hello"), []byte("adios"))
+	require.Empty(t, sink.Observations(telemetry.MetricStoreWriteLatency, telemetry.LabelStore, "iavl", telemetry.LabelOperation, "set"))
+
+	telemetry.SetDefault(sink.Metrics())
+	defer telemetry.SetDefault(nil)
+
+	iavlStore.Get([]byte("hello"))
+	iavlStore.Has([]byte("hello"))
+	iavlStore.Set([]byte("hello"), []byte("adios"))
+	iavlStore.Delete([]byte("hello"))
+
+	for _, op := range []string{"get", "has"} {
+		require.Len(t, sink.Observations(telemetry.MetricStoreReadLatency, telemetry.LabelStore, "iavl", telemetry.LabelOperation, op), 1, op)
+	}
+	for _, op := range []string{"set", "delete"} {
+		require.Len(t, sink.Observations(telemetry.MetricStoreWriteLatency, telemetry.LabelStore, "iavl", telemetry.LabelOperation, op), 1, op)
+	}
+}
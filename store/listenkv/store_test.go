@@ -0,0 +1,93 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+func newListenKVStore(w io.Writer) *listenkv.Store {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	listener := types.NewStoreKVPairWriteListener(w)
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func readKVPairs(t *testing.T, buf *bytes.Buffer) []types.StoreKVPair {
+	r := protoio.NewDelimitedReader(buf, 1<<20)
+
+	var kvPairs []types.StoreKVPair
+	for {
+		var kvPair types.StoreKVPair
+		err := r.ReadMsg(&kvPair)
+		if err == io.EOF {
+			return kvPairs
+		}
+
+		require.NoError(t, err)
+
+		kvPairs = append(kvPairs, kvPair)
+	}
+}
+
+func TestListenKVStoreWrites(t *testing.T) {
+	var buf bytes.Buffer
+	store := newListenKVStore(&buf)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Delete([]byte("key2"))
+
+	// reads are not delivered to the listeners
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.True(t, store.Has([]byte("key1")))
+
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: []byte("key2")},
+	}, readKVPairs(t, &buf))
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	var buf bytes.Buffer
+	store := newListenKVStore(&buf)
+
+	cache := store.CacheWrap().(types.KVStore)
+	cache.Set([]byte("key2"), []byte("value2"))
+	cache.Set([]byte("key1"), []byte("value1"))
+	cache.Delete([]byte("key2"))
+
+	// writes are only delivered once the cache is written, in key order
+	require.Empty(t, readKVPairs(t, &buf))
+
+	cache.(types.CacheWrap).Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: []byte("key2")},
+	}, readKVPairs(t, &buf))
+}
+
+// failingWriter is an io.Writer failing all writes.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, io.ErrClosedPipe }
+
+func TestListenKVStoreListenerFailure(t *testing.T) {
+	store := newListenKVStore(failingWriter{})
+
+	// the write reaches the parent before the listeners are notified
+	require.Panics(t, func() { store.Set([]byte("key1"), []byte("value1")) })
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+
+	require.Panics(t, func() { store.Delete([]byte("key1")) })
+	require.False(t, store.Has([]byte("key1")))
+}
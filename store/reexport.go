@@ -26,6 +26,7 @@ type (
 	StoreKey         = types.StoreKey
 	StoreType        = types.StoreType
 	Queryable        = types.Queryable
+	Snapshotter      = types.Snapshotter
 	TraceContext     = types.TraceContext
 	Gas              = stypes.Gas
 	GasMeter         = types.GasMeter
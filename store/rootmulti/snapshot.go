@@ -0,0 +1,250 @@
+package rootmulti
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// snapshotMaxItemSize is the maximum size of a single item in a snapshot
+// stream, which bounds the size of a single IAVL node.
+const snapshotMaxItemSize = 64 * 1024 * 1024
+
+// snapshotItem is a single entry of a snapshot stream. The stream starts with
+// an item holding the commitInfo of the snapshot height, followed by an item
+// naming each IAVL store and the post-order list of its nodes.
+type snapshotItem struct {
+	CommitInfo *commitInfo `json:"commit_info"`
+	Store      string      `json:"store"`
+	Node       []byte      `json:"node"`
+}
+
+// Snapshot implements Snapshotter. It writes a stream of all mounted IAVL
+// stores at the given height to w. Transient stores are skipped, while any
+// other store type is unsupported.
+func (rs *Store) Snapshot(height uint64, w io.Writer) error {
+	if height == 0 {
+		return fmt.Errorf("cannot snapshot height 0")
+	}
+
+	// NOTE: Snapshots may be taken concurrently with commits, so only persisted
+	// data may be accessed here.
+	cInfo, err := getCommitInfo(rs.db, int64(height))
+	if err != nil {
+		return err
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	if err := writeSnapshotItem(bw, snapshotItem{CommitInfo: &cInfo}); err != nil {
+		return err
+	}
+
+	for _, params := range stores {
+		if err := writeSnapshotItem(bw, snapshotItem{Store: params.key.Name()}); err != nil {
+			return err
+		}
+
+		_, err := iavl.ExportNodes(rs.storeDB(params), int64(height), func(node []byte) error {
+			return writeSnapshotItem(bw, snapshotItem{Node: node})
+		})
+		if err != nil {
+			return fmt.Errorf("failed to export store %s: %v", params.key.Name(), err)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Restore implements Snapshotter. It restores all IAVL stores from a snapshot
+// stream produced by Snapshot and loads the restored height. The snapshot
+// commitInfo is verified against the trusted app hash before any store is
+// written, and each store against the hash recorded in the commitInfo. The
+// Store must be empty, i.e. no version may have been committed yet.
+func (rs *Store) Restore(height uint64, appHash []byte, r io.Reader) error {
+	if height == 0 {
+		return fmt.Errorf("cannot restore snapshot at height 0")
+	}
+	if latest := getLatestVersion(rs.db); latest != 0 {
+		return fmt.Errorf("cannot restore snapshot into non-empty store at version %v", latest)
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+
+	br := bufio.NewReader(r)
+
+	var item snapshotItem
+	if err := readSnapshotItem(br, &item); err != nil {
+		return fmt.Errorf("failed to read snapshot commit info: %v", err)
+	}
+	if item.CommitInfo == nil {
+		return fmt.Errorf("expected snapshot commit info, got %v", item)
+	}
+
+	cInfo := *item.CommitInfo
+	if cInfo.Version != int64(height) {
+		return fmt.Errorf("snapshot commit info has version %v, expected %v", cInfo.Version, height)
+	}
+	if hash := cInfo.Hash(); !bytes.Equal(hash, appHash) {
+		return fmt.Errorf("%w: snapshot commit info hash %X, expected %X",
+			snapshots.ErrAppHashMismatch, hash, appHash)
+	}
+
+	hashes := make(map[string][]byte, len(cInfo.StoreInfos))
+	for _, si := range cInfo.StoreInfos {
+		hashes[si.Name] = si.Core.CommitID.Hash
+	}
+
+	byName := make(map[string]storeParams, len(stores))
+	for _, params := range stores {
+		byName[params.key.Name()] = params
+	}
+
+	var (
+		importer *iavl.Importer
+		current  string
+		restored = make(map[string]bool, len(stores))
+	)
+
+	// commitImport verifies and writes the store currently being imported.
+	commitImport := func() error {
+		if importer == nil {
+			return nil
+		}
+		if err := importer.Commit(hashes[current]); err != nil {
+			return fmt.Errorf("failed to restore store %s: %v", current, err)
+		}
+		restored[current] = true
+		return nil
+	}
+
+	for {
+		item = snapshotItem{}
+		err := readSnapshotItem(br, &item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to read snapshot item: %v", err)
+		}
+
+		switch {
+		case item.Store != "":
+			if err := commitImport(); err != nil {
+				return err
+			}
+
+			params, ok := byName[item.Store]
+			if !ok {
+				return fmt.Errorf("snapshot contains unknown store %s", item.Store)
+			}
+			if _, ok := hashes[item.Store]; !ok {
+				return fmt.Errorf("snapshot commit info is missing store %s", item.Store)
+			}
+			if restored[item.Store] {
+				return fmt.Errorf("snapshot contains store %s more than once", item.Store)
+			}
+
+			current = item.Store
+			importer = iavl.NewImporter(rs.storeDB(params), int64(height))
+
+		case item.Node != nil:
+			if importer == nil {
+				return fmt.Errorf("snapshot node received before any store")
+			}
+			if err := importer.Add(item.Node); err != nil {
+				return fmt.Errorf("failed to restore store %s: %v", current, err)
+			}
+
+		default:
+			return fmt.Errorf("unexpected snapshot item %v", item)
+		}
+	}
+
+	if err := commitImport(); err != nil {
+		return err
+	}
+
+	for name := range hashes {
+		if !restored[name] {
+			return fmt.Errorf("snapshot is missing store %s", name)
+		}
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+	setCommitInfo(batch, int64(height), cInfo)
+	setLatestVersion(batch, int64(height))
+	batch.Write()
+
+	return rs.LoadVersion(int64(height))
+}
+
+// snapshotStores returns the params of all stores included in a snapshot,
+// sorted by name. An error is returned if a store cannot be snapshotted.
+func (rs *Store) snapshotStores() ([]storeParams, error) {
+	stores := make([]storeParams, 0, len(rs.storesParams))
+	for _, params := range rs.storesParams {
+		switch params.typ {
+		case types.StoreTypeIAVL:
+			stores = append(stores, params)
+
+		case types.StoreTypeTransient:
+			// nothing to snapshot
+
+		default:
+			return nil, fmt.Errorf("snapshots are not supported for store %s of type %v",
+				params.key.Name(), params.typ)
+		}
+	}
+
+	sort.Slice(stores, func(i, j int) bool {
+		return stores[i].key.Name() < stores[j].key.Name()
+	})
+
+	return stores, nil
+}
+
+func writeSnapshotItem(w io.Writer, item snapshotItem) error {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(item)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(bz)
+	return err
+}
+
+func readSnapshotItem(r *bufio.Reader, item *snapshotItem) error {
+	if _, err := r.Peek(1); err != nil {
+		return err
+	}
+
+	_, err := cdc.UnmarshalBinaryLengthPrefixedReader(r, item, snapshotMaxItemSize)
+	return err
+}
+
+// storeDB returns the database holding the data of the store with the given
+// params.
+func (rs *Store) storeDB(params storeParams) dbm.DB {
+	if params.db != nil {
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
+	}
+
+	prefix := "s/k:" + params.key.Name() + "/"
+	return dbm.NewPrefixDB(rs.db, []byte(prefix))
+}
@@ -0,0 +1,153 @@
+package rootmulti
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newSnapshotMultiStore(t *testing.T, blocks int) *Store {
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.NoError(t, store.LoadLatestVersion())
+
+	for b := 0; b < blocks; b++ {
+		for i, name := range []string{"store1", "store2"} {
+			kv := store.getStoreByName(name).(types.KVStore)
+			for k := 0; k < 50; k++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", i, k))
+				kv.Set(key, []byte(fmt.Sprintf("value-%d-%d-%d", i, k, b)))
+			}
+			kv.Delete([]byte(fmt.Sprintf("key-%d-%d", i, b)))
+		}
+		store.Commit()
+	}
+
+	return store
+}
+
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	source := newSnapshotMultiStore(t, 3)
+	expected := source.LastCommitID()
+
+	var buf bytes.Buffer
+	require.NoError(t, source.Snapshot(uint64(expected.Version), &buf))
+
+	// add another block to the source, which must not affect the snapshot
+	source.getStoreByName("store1").(types.KVStore).Set([]byte("foo"), []byte("bar"))
+	source.Commit()
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.NoError(t, target.LoadLatestVersion())
+	require.NoError(t, target.Restore(uint64(expected.Version), expected.Hash, &buf))
+
+	require.Equal(t, expected, target.LastCommitID())
+	require.Equal(t, expected, getExpectedCommitID(target, expected.Version))
+
+	for _, name := range []string{"store1", "store2", "store3"} {
+		srcItr := source.getStoreByName(name).(types.KVStore).Iterator(nil, nil)
+		for ; srcItr.Valid(); srcItr.Next() {
+			if bytes.Equal(srcItr.Key(), []byte("foo")) {
+				continue
+			}
+			value := target.getStoreByName(name).(types.KVStore).Get(srcItr.Key())
+			require.Equal(t, srcItr.Value(), value)
+		}
+		srcItr.Close()
+	}
+
+	// the restored store must be able to commit new versions
+	target.getStoreByName("store1").(types.KVStore).Set([]byte("foo"), []byte("bar"))
+	require.Equal(t, source.LastCommitID(), target.Commit())
+}
+
+func TestMultistoreSnapshotErrors(t *testing.T) {
+	source := newSnapshotMultiStore(t, 2)
+
+	err := source.Snapshot(0, &bytes.Buffer{})
+	require.Error(t, err)
+
+	err = source.Snapshot(3, &bytes.Buffer{})
+	require.Error(t, err)
+
+	// non-IAVL stores are not supported
+	db := dbm.NewMemDB()
+	store := NewStore(db)
+	store.MountStoreWithDB(types.NewKVStoreKey("store1"), types.StoreTypeDB, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	store.Commit()
+	require.Error(t, store.Snapshot(1, &bytes.Buffer{}))
+}
+
+func TestMultistoreRestoreErrors(t *testing.T) {
+	source := newSnapshotMultiStore(t, 2)
+
+	var buf bytes.Buffer
+	require.NoError(t, source.Snapshot(2, &buf))
+	snapshot := buf.Bytes()
+	appHash := source.LastCommitID().Hash
+
+	testcases := map[string]struct {
+		height uint64
+		data   func() []byte
+		target func() *Store
+	}{
+		"zero height":      {0, func() []byte { return snapshot }, nil},
+		"wrong height":     {1, func() []byte { return snapshot }, nil},
+		"empty stream":     {2, func() []byte { return nil }, nil},
+		"truncated stream": {2, func() []byte { return snapshot[:len(snapshot)/2] }, nil},
+		"corrupted node": {2, func() []byte {
+			bz := append([]byte{}, snapshot...)
+			bz[len(bz)-3] ^= 0xff
+			return bz
+		}, nil},
+		"non-empty target": {2, func() []byte { return snapshot }, func() *Store {
+			return newSnapshotMultiStore(t, 1)
+		}},
+	}
+
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var target *Store
+			if tc.target != nil {
+				target = tc.target()
+			} else {
+				target = newMultiStoreWithMounts(dbm.NewMemDB())
+				require.NoError(t, target.LoadLatestVersion())
+			}
+
+			err := target.Restore(tc.height, appHash, bytes.NewReader(tc.data()))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMultistoreRestoreAppHashMismatch(t *testing.T) {
+	source := newSnapshotMultiStore(t, 2)
+	expected := source.LastCommitID()
+
+	var buf bytes.Buffer
+	require.NoError(t, source.Snapshot(2, &buf))
+	snapshot := buf.Bytes()
+
+	db := dbm.NewMemDB()
+	target := newMultiStoreWithMounts(db)
+	require.NoError(t, target.LoadLatestVersion())
+
+	// nothing is written when the app hash does not match
+	err := target.Restore(2, []byte("invalid"), bytes.NewReader(snapshot))
+	require.True(t, errors.Is(err, snapshots.ErrAppHashMismatch))
+	require.Equal(t, int64(0), getLatestVersion(db))
+	require.Equal(t, types.CommitID{}, target.LastCommitID())
+
+	// so the restore can be retried
+	require.NoError(t, target.Restore(2, expected.Hash, bytes.NewReader(snapshot)))
+	require.Equal(t, expected, target.LastCommitID())
+}
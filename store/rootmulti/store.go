@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/errors"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -40,6 +41,8 @@ type Store struct {
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CommitMultiStore = (*Store)(nil)
@@ -52,6 +55,7 @@ func NewStore(db dbm.DB) *Store {
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:   make(map[string]types.StoreKey),
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 }
 
@@ -63,6 +67,25 @@ func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	}
 }
 
+// HoldVersion implements CommitMultiStore. It keeps the version of all IAVL
+// stores from being pruned until it is released.
+func (rs *Store) HoldVersion(ver int64) {
+	for key := range rs.stores {
+		if store, ok := rs.GetCommitKVStore(key).(*iavl.Store); ok {
+			store.HoldVersion(ver)
+		}
+	}
+}
+
+// ReleaseVersion implements CommitMultiStore.
+func (rs *Store) ReleaseVersion(ver int64) {
+	for key := range rs.stores {
+		if store, ok := rs.GetCommitKVStore(key).(*iavl.Store); ok {
+			store.ReleaseVersion(ver)
+		}
+	}
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
@@ -268,6 +291,19 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// AddListeners adds listeners for the KVStore belonging to the given StoreKey.
+// Listeners are notified of every write made on the store, including the writes
+// of a cache-wrapped multi-store once it is written, e.g. on Commit.
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns if listening is enabled for the KVStore belonging
+// to the given StoreKey.
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) > 0
+}
+
 //----------------------------------------
 // +CommitStore
 
@@ -317,6 +353,11 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
+		if rs.ListeningEnabled(k) {
+			stores[k] = listenkv.NewStore(v, k, rs.listeners[k])
+			continue
+		}
+
 		stores[k] = v
 	}
 
@@ -370,13 +411,18 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 
 // GetKVStore returns a mounted KVStore for a given StoreKey. If tracing is
 // enabled on the KVStore, a wrapped TraceKVStore will be returned with the root
-// store's tracer, otherwise, the original KVStore will be returned.
+// store's tracer, otherwise, the original KVStore will be returned. If
+// listening is enabled, writes are delivered to the store's listeners.
 //
 // NOTE: The returned KVStore may be wrapped in an inter-block cache if it is
 // set on the root store.
 func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	store := rs.stores[key].(types.KVStore)
 
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
+
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
@@ -473,14 +519,7 @@ func parsePath(path string) (storeName string, subpath string, err errors.Error)
 //----------------------------------------
 // Note: why do we use key and params.key in different places. Seems like there should be only one key used.
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
-
-	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
-	}
+	db := rs.storeDB(params)
 
 	switch params.typ {
 	case types.StoreTypeMulti:
@@ -358,6 +358,49 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+// testWriteListener records the writes it is notified of.
+type testWriteListener struct {
+	writes []types.StoreKVPair
+}
+
+func (l *testWriteListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.writes = append(l.writes, types.StoreKVPair{StoreKey: storeKey.Name(), Delete: delete, Key: key, Value: value})
+	return nil
+}
+
+func TestMultiStoreListening(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key1, key2 := ms.keysByName["store1"], ms.keysByName["store2"]
+	listener := &testWriteListener{}
+	ms.AddListeners(key1, []types.WriteListener{listener})
+
+	require.True(t, ms.ListeningEnabled(key1))
+	require.False(t, ms.ListeningEnabled(key2))
+
+	// writes on a cache-wrapped multi-store are delivered once it is written
+	cms := ms.CacheMultiStore()
+	cms.GetKVStore(key1).Set([]byte("wind"), []byte("blows"))
+	cms.GetKVStore(key1).Delete([]byte("rain"))
+	cms.GetKVStore(key2).Set([]byte("sun"), []byte("shines"))
+	require.Empty(t, listener.writes)
+
+	cms.Write()
+	ms.Commit()
+
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Delete: true, Key: []byte("rain")},
+		{StoreKey: "store1", Key: []byte("wind"), Value: []byte("blows")},
+	}, listener.writes)
+
+	// writes made directly on the store are delivered immediately
+	ms.GetKVStore(key1).Set([]byte("snow"), []byte("falls"))
+	require.Len(t, listener.writes, 3)
+	require.Equal(t, types.StoreKVPair{StoreKey: "store1", Key: []byte("snow"), Value: []byte("falls")}, listener.writes[2])
+}
+
 //-----------------------------------------------------------------------
 // utils
 
@@ -0,0 +1,254 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"io"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	opNone     operation = ""
+	opSnapshot operation = "snapshot"
+	opPrune    operation = "prune"
+	opRestore  operation = "restore"
+)
+
+// operation represents a Manager operation. Only one operation can be in
+// progress at a time.
+type operation string
+
+// Manager manages snapshot and restore operations for an app, making sure only
+// a single long-running operation is in progress at any given time, and
+// provides convenience methods mirroring the ABCI state sync interface.
+type Manager struct {
+	store  *Store
+	target types.Snapshotter
+
+	mtx       sync.Mutex
+	operation operation
+
+	// restore state, only valid while operation is opRestore
+	restoreSnapshot *Snapshot
+	restoreChunk    uint32
+	restoreHasher   hash.Hash
+	restoreWriter   *io.PipeWriter
+	restoreDone     chan error
+}
+
+// NewManager creates a new snapshot manager, which saves snapshots of the target
+// into the given store.
+func NewManager(store *Store, target types.Snapshotter) *Manager {
+	return &Manager{
+		store:  store,
+		target: target,
+	}
+}
+
+// begin starts an operation, or errors if one is already in progress.
+func (m *Manager) begin(op operation) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.operation != opNone {
+		return fmt.Errorf("a %v operation is in progress", m.operation)
+	}
+
+	m.operation = op
+	return nil
+}
+
+// end ends the current operation.
+func (m *Manager) end() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.reset()
+}
+
+// Create creates a snapshot of the target at the given height and saves it.
+func (m *Manager) Create(height uint64) (*Snapshot, error) {
+	if err := m.begin(opSnapshot); err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	latest, err := m.store.List()
+	if err != nil {
+		return nil, err
+	}
+	if len(latest) > 0 && latest[0].Height >= height {
+		return nil, fmt.Errorf("a more recent snapshot already exists at height %v", latest[0].Height)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		_ = pw.CloseWithError(m.target.Snapshot(height, pw))
+	}()
+
+	snapshot, err := m.store.Save(height, CurrentFormat, pr)
+	_ = pr.CloseWithError(err)
+
+	return snapshot, err
+}
+
+// List lists all available snapshots, most recent first.
+func (m *Manager) List() ([]*Snapshot, error) {
+	return m.store.List()
+}
+
+// LoadChunk loads a chunk of a snapshot, or returns nil if it does not exist.
+func (m *Manager) LoadChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
+	return m.store.LoadChunk(height, format, chunk)
+}
+
+// Prune prunes all but the given number of most recent snapshots.
+func (m *Manager) Prune(retain uint32) (uint64, error) {
+	if err := m.begin(opPrune); err != nil {
+		return 0, err
+	}
+	defer m.end()
+
+	return m.store.Prune(retain)
+}
+
+// Restore begins an asynchronous restore of the given snapshot into the target,
+// whose state must result in the given trusted app hash. Chunks must then be
+// applied in order with RestoreChunk.
+func (m *Manager) Restore(snapshot Snapshot, appHash []byte) error {
+	if snapshot.Format != CurrentFormat {
+		return fmt.Errorf("%w: %v", ErrUnknownFormat, snapshot.Format)
+	}
+	if err := snapshot.Validate(); err != nil {
+		return err
+	}
+
+	if err := m.begin(opRestore); err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := m.target.Restore(snapshot.Height, appHash, pr)
+		_ = pr.CloseWithError(err)
+		done <- err
+	}()
+
+	m.mtx.Lock()
+	m.restoreSnapshot = &snapshot
+	m.restoreHasher = sha256.New()
+	m.restoreWriter = pw
+	m.restoreDone = done
+	m.mtx.Unlock()
+
+	return nil
+}
+
+// RestoreChunk applies the next chunk of the snapshot being restored, verifying
+// it against the chunk hash in the snapshot metadata. It returns true once the
+// final chunk has been applied and the restore has completed successfully. Any
+// error aborts the restore.
+func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.operation != opRestore || m.restoreSnapshot == nil {
+		return false, fmt.Errorf("no restore operation in progress")
+	}
+
+	snapshot := m.restoreSnapshot
+	if m.restoreChunk >= snapshot.Chunks {
+		return false, fmt.Errorf("received unexpected chunk %v", m.restoreChunk)
+	}
+
+	chunkHash := sha256.Sum256(chunk)
+	if !bytes.Equal(chunkHash[:], snapshot.Metadata.ChunkHashes[m.restoreChunk]) {
+		m.abortRestore(ErrChunkHashMismatch)
+		return false, fmt.Errorf("%w: chunk %v", ErrChunkHashMismatch, m.restoreChunk)
+	}
+
+	_, _ = m.restoreHasher.Write(chunk)
+	if _, err := m.restoreWriter.Write(chunk); err != nil {
+		m.abortRestore(err)
+		return false, fmt.Errorf("failed to restore chunk %v: %w", m.restoreChunk, err)
+	}
+
+	m.restoreChunk++
+	if m.restoreChunk < snapshot.Chunks {
+		return false, nil
+	}
+
+	// final chunk applied, wait for the restore to complete
+	if !bytes.Equal(m.restoreHasher.Sum(nil), snapshot.Hash) {
+		m.abortRestore(ErrChunkHashMismatch)
+		return false, fmt.Errorf("%w: snapshot hash", ErrChunkHashMismatch)
+	}
+
+	_ = m.restoreWriter.Close()
+	err := <-m.restoreDone
+	m.reset()
+	if err != nil {
+		return false, fmt.Errorf("failed to restore snapshot: %w", err)
+	}
+
+	return true, nil
+}
+
+// abortRestore aborts an in-progress restore. The caller must hold the mutex.
+func (m *Manager) abortRestore(err error) {
+	_ = m.restoreWriter.CloseWithError(err)
+	<-m.restoreDone
+	m.reset()
+}
+
+// reset resets the operation and restore state. The caller must hold the mutex.
+func (m *Manager) reset() {
+	m.operation = opNone
+	m.restoreSnapshot = nil
+	m.restoreChunk = 0
+	m.restoreHasher = nil
+	m.restoreWriter = nil
+	m.restoreDone = nil
+}
+
+// RestoreLocal restores the snapshot at the given height and format from the
+// local snapshot store, e.g. when bootstrapping a node from a snapshot
+// directory copied from another node. The state must result in the given
+// trusted app hash.
+func (m *Manager) RestoreLocal(height uint64, format uint32, appHash []byte) error {
+	snapshot, err := m.store.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot at height %v format %v not found", height, format)
+	}
+
+	if err := m.Restore(*snapshot, appHash); err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := m.store.LoadChunk(height, format, i)
+		if err == nil && chunk == nil {
+			err = fmt.Errorf("snapshot chunk %v not found", i)
+		}
+		if err != nil {
+			m.mtx.Lock()
+			m.abortRestore(err)
+			m.mtx.Unlock()
+			return err
+		}
+
+		if _, err := m.RestoreChunk(chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
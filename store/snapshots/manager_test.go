@@ -0,0 +1,136 @@
+package snapshots
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockSnapshotter snapshots and restores a plain byte slice.
+type mockSnapshotter struct {
+	data     []byte
+	restored []byte
+}
+
+// mockAppHash is the app hash the snapshots of mockSnapshotter result in.
+var mockAppHash = []byte("app hash")
+
+func (m *mockSnapshotter) Snapshot(height uint64, w io.Writer) error {
+	if height == 0 {
+		return errors.New("invalid height")
+	}
+
+	_, err := w.Write(m.data)
+	return err
+}
+
+func (m *mockSnapshotter) Restore(height uint64, appHash []byte, r io.Reader) error {
+	if !bytes.Equal(appHash, mockAppHash) {
+		return ErrAppHashMismatch
+	}
+
+	bz, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	m.restored = bz
+	return nil
+}
+
+func TestManagerCreateRestore(t *testing.T) {
+	store, cleanup := setupStore(t)
+	defer cleanup()
+
+	source := &mockSnapshotter{data: []byte("some snapshot data")}
+	manager := NewManager(store, source)
+
+	snapshot, err := manager.Create(7)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), snapshot.Height)
+	require.Equal(t, uint32(5), snapshot.Chunks)
+
+	// snapshots can only be taken at increasing heights
+	_, err = manager.Create(7)
+	require.Error(t, err)
+	_, err = manager.Create(6)
+	require.Error(t, err)
+
+	snapshots, err := manager.List()
+	require.NoError(t, err)
+	require.Equal(t, []*Snapshot{snapshot}, snapshots)
+
+	// restore into another snapshotter chunk by chunk
+	target := &mockSnapshotter{}
+	restorer := NewManager(store, target)
+	require.NoError(t, restorer.Restore(*snapshot, mockAppHash))
+
+	// only one operation can be in progress at a time
+	require.Error(t, restorer.Restore(*snapshot, mockAppHash))
+	_, err = restorer.Prune(1)
+	require.Error(t, err)
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+
+		done, err := restorer.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == snapshot.Chunks-1, done)
+	}
+	require.Equal(t, source.data, target.restored)
+
+	_, err = restorer.RestoreChunk([]byte{1})
+	require.Error(t, err)
+
+	// restoring from the local store
+	target = &mockSnapshotter{}
+	require.NoError(t, NewManager(store, target).RestoreLocal(snapshot.Height, snapshot.Format, mockAppHash))
+	require.Equal(t, source.data, target.restored)
+}
+
+func TestManagerRestoreErrors(t *testing.T) {
+	store, cleanup := setupStore(t)
+	defer cleanup()
+
+	manager := NewManager(store, &mockSnapshotter{data: []byte("abcdefgh")})
+	snapshot, err := manager.Create(1)
+	require.NoError(t, err)
+
+	invalid := *snapshot
+	invalid.Format = CurrentFormat + 1
+	require.True(t, errors.Is(manager.Restore(invalid, mockAppHash), ErrUnknownFormat))
+
+	invalid = *snapshot
+	invalid.Chunks = 0
+	require.True(t, errors.Is(manager.Restore(invalid, mockAppHash), ErrInvalidMetadata))
+
+	// a corrupted chunk aborts the restore
+	require.NoError(t, manager.Restore(*snapshot, mockAppHash))
+	_, err = manager.RestoreChunk([]byte("abcx"))
+	require.True(t, errors.Is(err, ErrChunkHashMismatch))
+
+	_, err = manager.RestoreChunk([]byte("abcd"))
+	require.Error(t, err)
+
+	// the restore can be retried after aborting
+	require.NoError(t, manager.Restore(*snapshot, mockAppHash))
+	done, err := manager.RestoreChunk([]byte("abcd"))
+	require.NoError(t, err)
+	require.False(t, done)
+	done, err = manager.RestoreChunk([]byte("efgh"))
+	require.NoError(t, err)
+	require.True(t, done)
+
+	require.Error(t, manager.RestoreLocal(2, CurrentFormat, mockAppHash))
+
+	// a restore with a mismatching app hash fails
+	err = manager.RestoreLocal(1, CurrentFormat, []byte("invalid"))
+	require.True(t, errors.Is(err, ErrAppHashMismatch))
+
+	require.NoError(t, manager.RestoreLocal(1, CurrentFormat, mockAppHash))
+}
@@ -0,0 +1,282 @@
+package snapshots
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+const metadataFile = "metadata.json"
+
+// Store is a snapshot store, containing snapshot metadata and binary chunks in
+// a local directory. Snapshots are laid out as <dir>/<height>/<format>/, with a
+// metadata.json file and one file per chunk, named by its index.
+type Store struct {
+	dir       string
+	chunkSize int
+
+	mtx    sync.Mutex
+	saving map[uint64]bool // heights currently being saved
+}
+
+// NewStore creates a new snapshot store in the given directory, which is
+// created if it does not exist.
+func NewStore(dir string) (*Store, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("snapshot directory not given")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory %q: %v", dir, err)
+	}
+
+	return &Store{
+		dir:       dir,
+		chunkSize: DefaultChunkSize,
+		saving:    make(map[uint64]bool),
+	}, nil
+}
+
+// SetChunkSize sets the size in bytes of chunks written by subsequent saves.
+func (s *Store) SetChunkSize(size int) {
+	if size <= 0 {
+		panic(fmt.Sprintf("invalid snapshot chunk size %d", size))
+	}
+
+	s.chunkSize = size
+}
+
+// Save reads a snapshot stream from r and saves it as a snapshot with the given
+// height and format, split into chunks. The snapshot is only visible once it
+// has been saved completely.
+func (s *Store) Save(height uint64, format uint32, r io.Reader) (*Snapshot, error) {
+	if height == 0 {
+		return nil, fmt.Errorf("snapshot height cannot be 0")
+	}
+
+	s.mtx.Lock()
+	if s.saving[height] {
+		s.mtx.Unlock()
+		return nil, fmt.Errorf("a snapshot for height %v is already being saved", height)
+	}
+	s.saving[height] = true
+	s.mtx.Unlock()
+
+	defer func() {
+		s.mtx.Lock()
+		delete(s.saving, height)
+		s.mtx.Unlock()
+	}()
+
+	existing, err := s.Get(height, format)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("snapshot already exists for height %v format %v", height, format)
+	}
+
+	// write chunks into a temporary directory, and move it into place when done
+	tmpDir := s.pathSnapshot(height, format) + ".tmp"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	snapshot := &Snapshot{Height: height, Format: format}
+	streamHasher := sha256.New()
+	buf := make([]byte, s.chunkSize)
+
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			chunk := buf[:n]
+			path := filepath.Join(tmpDir, strconv.FormatUint(uint64(snapshot.Chunks), 10))
+			if err := ioutil.WriteFile(path, chunk, 0644); err != nil {
+				return nil, fmt.Errorf("failed to write snapshot chunk: %v", err)
+			}
+
+			chunkHash := sha256.Sum256(chunk)
+			snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHash[:])
+			snapshot.Chunks++
+			_, _ = streamHasher.Write(chunk)
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read snapshot stream: %v", err)
+		}
+	}
+
+	if snapshot.Chunks == 0 {
+		return nil, fmt.Errorf("snapshot stream for height %v was empty", height)
+	}
+	snapshot.Hash = streamHasher.Sum(nil)
+
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, metadataFile), bz, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write snapshot metadata: %v", err)
+	}
+
+	if err := os.MkdirAll(s.pathHeight(height), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpDir, s.pathSnapshot(height, format)); err != nil {
+		return nil, fmt.Errorf("failed to move snapshot into place: %v", err)
+	}
+
+	return snapshot, nil
+}
+
+// Get fetches snapshot info from the store, or returns nil if it does not exist.
+func (s *Store) Get(height uint64, format uint32) (*Snapshot, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(s.pathSnapshot(height, format), metadataFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot metadata: %v", err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(bz, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot metadata: %v", err)
+	}
+
+	return &snapshot, nil
+}
+
+// List lists all snapshots in the store, ordered by descending height and
+// format.
+func (s *Store) List() ([]*Snapshot, error) {
+	heights, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*Snapshot, 0)
+	for _, h := range heights {
+		height, err := strconv.ParseUint(h.Name(), 10, 64)
+		if err != nil || !h.IsDir() {
+			continue
+		}
+
+		formats, err := ioutil.ReadDir(s.pathHeight(height))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range formats {
+			format, err := strconv.ParseUint(f.Name(), 10, 32)
+			if err != nil || !f.IsDir() {
+				continue
+			}
+
+			snapshot, err := s.Get(height, uint32(format))
+			if err != nil {
+				return nil, err
+			}
+			if snapshot != nil {
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		if snapshots[i].Height == snapshots[j].Height {
+			return snapshots[i].Format > snapshots[j].Format
+		}
+		return snapshots[i].Height > snapshots[j].Height
+	})
+
+	return snapshots, nil
+}
+
+// LoadChunk loads a chunk from disk, or returns nil if it does not exist.
+func (s *Store) LoadChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
+	path := filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
+
+	bz, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	return bz, err
+}
+
+// Delete deletes a snapshot.
+func (s *Store) Delete(height uint64, format uint32) error {
+	s.mtx.Lock()
+	saving := s.saving[height]
+	s.mtx.Unlock()
+
+	if saving {
+		return fmt.Errorf("snapshot for height %v is currently being saved", height)
+	}
+
+	if err := os.RemoveAll(s.pathSnapshot(height, format)); err != nil {
+		return err
+	}
+
+	// remove the height directory if it has become empty
+	entries, err := ioutil.ReadDir(s.pathHeight(height))
+	if err == nil && len(entries) == 0 {
+		return os.Remove(s.pathHeight(height))
+	}
+
+	return nil
+}
+
+// Prune removes old snapshots, retaining the given number of most recent
+// heights. It returns the number of snapshots pruned.
+func (s *Store) Prune(retain uint32) (uint64, error) {
+	snapshots, err := s.List()
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		pruned  uint64
+		heights uint32
+		last    uint64
+	)
+
+	for _, snapshot := range snapshots {
+		if snapshot.Height != last {
+			heights++
+			last = snapshot.Height
+		}
+
+		if heights <= retain {
+			continue
+		}
+
+		if err := s.Delete(snapshot.Height, snapshot.Format); err != nil {
+			return pruned, err
+		}
+		pruned++
+	}
+
+	return pruned, nil
+}
+
+func (s *Store) pathHeight(height uint64) string {
+	return filepath.Join(s.dir, strconv.FormatUint(height, 10))
+}
+
+func (s *Store) pathSnapshot(height uint64, format uint32) string {
+	return filepath.Join(s.pathHeight(height), strconv.FormatUint(uint64(format), 10))
+}
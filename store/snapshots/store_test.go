@@ -0,0 +1,107 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setupStore(t *testing.T) (*Store, func()) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+
+	store, err := NewStore(dir)
+	require.NoError(t, err)
+	store.SetChunkSize(4)
+
+	return store, func() { os.RemoveAll(dir) }
+}
+
+func TestStoreSaveLoad(t *testing.T) {
+	store, cleanup := setupStore(t)
+	defer cleanup()
+
+	data := []byte("0123456789")
+	snapshot, err := store.Save(3, CurrentFormat, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	hash := sha256.Sum256(data)
+	require.Equal(t, uint64(3), snapshot.Height)
+	require.Equal(t, CurrentFormat, snapshot.Format)
+	require.Equal(t, uint32(3), snapshot.Chunks)
+	require.Equal(t, hash[:], snapshot.Hash)
+	require.Len(t, snapshot.Metadata.ChunkHashes, 3)
+	require.NoError(t, snapshot.Validate())
+
+	loaded, err := store.Get(3, CurrentFormat)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, loaded)
+
+	var restored []byte
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := store.LoadChunk(3, CurrentFormat, i)
+		require.NoError(t, err)
+
+		chunkHash := sha256.Sum256(chunk)
+		require.Equal(t, chunkHash[:], snapshot.Metadata.ChunkHashes[i])
+		restored = append(restored, chunk...)
+	}
+	require.Equal(t, data, restored)
+
+	chunk, err := store.LoadChunk(3, CurrentFormat, 3)
+	require.NoError(t, err)
+	require.Nil(t, chunk)
+
+	missing, err := store.Get(4, CurrentFormat)
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	// saving the same snapshot again, or an empty snapshot, must fail
+	_, err = store.Save(3, CurrentFormat, bytes.NewReader(data))
+	require.Error(t, err)
+	_, err = store.Save(4, CurrentFormat, bytes.NewReader(nil))
+	require.Error(t, err)
+	_, err = store.Save(0, CurrentFormat, bytes.NewReader(data))
+	require.Error(t, err)
+}
+
+func TestStoreListPrune(t *testing.T) {
+	store, cleanup := setupStore(t)
+	defer cleanup()
+
+	for _, height := range []uint64{1, 2, 5, 3} {
+		_, err := store.Save(height, CurrentFormat, bytes.NewReader([]byte{byte(height)}))
+		require.NoError(t, err)
+	}
+	_, err := store.Save(3, CurrentFormat+1, bytes.NewReader([]byte{3}))
+	require.NoError(t, err)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 5)
+	require.Equal(t, uint64(5), snapshots[0].Height)
+	require.Equal(t, uint64(3), snapshots[1].Height)
+	require.Equal(t, CurrentFormat+1, snapshots[1].Format)
+	require.Equal(t, uint64(3), snapshots[2].Height)
+	require.Equal(t, uint64(1), snapshots[4].Height)
+
+	pruned, err := store.Prune(2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), pruned)
+
+	snapshots, err = store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	for _, snapshot := range snapshots {
+		require.True(t, snapshot.Height >= 3)
+	}
+
+	require.NoError(t, store.Delete(5, CurrentFormat))
+	snapshot, err := store.Get(5, CurrentFormat)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+}
@@ -0,0 +1,66 @@
+package snapshots
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CurrentFormat is the currently used snapshot format. Snapshots in any other
+// format can not be restored.
+const CurrentFormat uint32 = 1
+
+// DefaultChunkSize is the default size in bytes of a single snapshot chunk.
+const DefaultChunkSize = 10 * 1024 * 1024
+
+var (
+	// ErrUnknownFormat is returned when a snapshot format is not supported.
+	ErrUnknownFormat = errors.New("unknown snapshot format")
+
+	// ErrChunkHashMismatch is returned when a chunk does not match its hash.
+	ErrChunkHashMismatch = errors.New("chunk hash verification failed")
+
+	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
+	ErrInvalidMetadata = errors.New("invalid snapshot metadata")
+
+	// ErrAppHashMismatch is returned when the state of a snapshot does not
+	// match the trusted app hash it is restored with.
+	ErrAppHashMismatch = errors.New("snapshot app hash mismatch")
+)
+
+// Snapshot contains metadata about a snapshot of the application state at a
+// given height. The snapshot data itself is split into chunks which are stored
+// and transferred separately.
+type Snapshot struct {
+	Height   uint64   `json:"height"`
+	Format   uint32   `json:"format"`
+	Chunks   uint32   `json:"chunks"`
+	Hash     []byte   `json:"hash"`
+	Metadata Metadata `json:"metadata"`
+}
+
+// Metadata contains snapshot metadata used to verify the snapshot chunks.
+type Metadata struct {
+	ChunkHashes [][]byte `json:"chunk_hashes"`
+}
+
+// Validate performs basic validation of the snapshot.
+func (s Snapshot) Validate() error {
+	if s.Height == 0 {
+		return fmt.Errorf("%w: height cannot be 0", ErrInvalidMetadata)
+	}
+	if s.Chunks == 0 {
+		return fmt.Errorf("%w: no chunks", ErrInvalidMetadata)
+	}
+	if len(s.Metadata.ChunkHashes) != int(s.Chunks) {
+		return fmt.Errorf("%w: expected %v chunk hashes, got %v",
+			ErrInvalidMetadata, s.Chunks, len(s.Metadata.ChunkHashes))
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer.
+func (s Snapshot) String() string {
+	return fmt.Sprintf("Snapshot{height: %d, format: %d, chunks: %d, hash: %X}",
+		s.Height, s.Format, s.Chunks, s.Hash)
+}
@@ -0,0 +1,178 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+StreamingService writes, for every committed block, the following files to its
+write directory, each holding a sequence of length-prefixed protobuf messages:
+
+	{prefix}block-{N}-begin      RequestBeginBlock, ResponseBeginBlock
+	{prefix}block-{N}-tx-{M}     RequestDeliverTx, ResponseDeliverTx
+	{prefix}block-{N}-end        RequestEndBlock, ResponseEndBlock
+	{prefix}block-{N}-changeset  StoreKVPair...
+
+The change set holds every Set and Delete written to the listened KVStores when
+block N was committed. Each file is written atomically, and the change set file
+is written last, so that its presence marks the files of a block as complete.
+*/
+type StreamingService struct {
+	writeDir   string
+	filePrefix string
+	listeners  map[types.StoreKey][]types.WriteListener
+
+	height     int64
+	beginBlock []byte
+	deliverTxs [][]byte
+	endBlock   []byte
+	changeSet  *bytes.Buffer
+}
+
+// NewStreamingService returns a StreamingService writing the state changes of
+// the KVStores of the given StoreKeys, along with the ABCI requests and
+// responses of each block, to files in writeDir. The directory must exist.
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey) (*StreamingService, error) {
+	info, err := os.Stat(writeDir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", writeDir)
+	}
+
+	changeSet := new(bytes.Buffer)
+	listener := types.NewStoreKVPairWriteListener(changeSet)
+
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		listeners[key] = []types.WriteListener{listener}
+	}
+
+	return &StreamingService{
+		writeDir:   writeDir,
+		filePrefix: filePrefix,
+		listeners:  listeners,
+		changeSet:  changeSet,
+	}, nil
+}
+
+// Listeners returns the WriteListeners to register with the KVStore of each
+// StoreKey.
+func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return fss.listeners
+}
+
+// ListenBeginBlock records the BeginBlock request and response of a new block.
+func (fss *StreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	bz, err := encodeMsgs(&req, &res)
+	if err != nil {
+		return err
+	}
+
+	fss.height = req.Header.Height
+	fss.beginBlock = bz
+	fss.deliverTxs = nil
+	fss.endBlock = nil
+
+	return nil
+}
+
+// ListenDeliverTx records a DeliverTx request and response of the current
+// block.
+func (fss *StreamingService) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	bz, err := encodeMsgs(&req, &res)
+	if err != nil {
+		return err
+	}
+
+	fss.deliverTxs = append(fss.deliverTxs, bz)
+	return nil
+}
+
+// ListenEndBlock records the EndBlock request and response of the current
+// block.
+func (fss *StreamingService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	bz, err := encodeMsgs(&req, &res)
+	if err != nil {
+		return err
+	}
+
+	fss.endBlock = bz
+	return nil
+}
+
+// ListenCommit writes the files of the committed block. The change set is
+// reset even if writing fails, so that it only ever holds the changes of a
+// single block.
+func (fss *StreamingService) ListenCommit(_ sdk.Context, _ abci.ResponseCommit) error {
+	defer fss.changeSet.Reset()
+
+	if err := fss.writeFile(fmt.Sprintf("block-%d-begin", fss.height), fss.beginBlock); err != nil {
+		return err
+	}
+
+	for i, bz := range fss.deliverTxs {
+		if err := fss.writeFile(fmt.Sprintf("block-%d-tx-%d", fss.height, i), bz); err != nil {
+			return err
+		}
+	}
+
+	if err := fss.writeFile(fmt.Sprintf("block-%d-end", fss.height), fss.endBlock); err != nil {
+		return err
+	}
+
+	return fss.writeFile(fmt.Sprintf("block-%d-changeset", fss.height), fss.changeSet.Bytes())
+}
+
+// writeFile atomically writes data to the named file of the write directory by
+// renaming a synced temporary file.
+func (fss *StreamingService) writeFile(name string, data []byte) error {
+	f, err := ioutil.TempFile(fss.writeDir, ".tmp-")
+	if err != nil {
+		return err
+	}
+
+	tmpPath := f.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, filepath.Join(fss.writeDir, fss.filePrefix+name))
+}
+
+// encodeMsgs encodes the given messages as a sequence of length-prefixed
+// protobuf messages.
+func encodeMsgs(msgs ...proto.Message) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := protoio.NewDelimitedWriter(buf)
+
+	for _, msg := range msgs {
+		if err := w.WriteMsg(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,102 @@
+package file_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = (*file.StreamingService)(nil)
+
+func readMsgs(t *testing.T, path string, msgs ...proto.Message) {
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	r := protoio.NewDelimitedReader(bytes.NewReader(bz), 1<<20)
+	for _, msg := range msgs {
+		require.NoError(t, r.ReadMsg(msg))
+	}
+
+	require.Equal(t, io.EOF, r.ReadMsg(&types.StoreKVPair{}))
+}
+
+func TestStreamingService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	listenedKey, otherKey := sdk.NewKVStoreKey("listened"), sdk.NewKVStoreKey("other")
+
+	svc, err := file.NewStreamingService(dir, "test-", []types.StoreKey{listenedKey})
+	require.NoError(t, err)
+
+	txDecoder := func(txBytes []byte) (sdk.Tx, sdk.Error) {
+		return nil, sdk.ErrTxDecode("no txs are decoded")
+	}
+
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), txDecoder, baseapp.SetStreamingService(svc))
+	app.MountStores(listenedKey, otherKey)
+	app.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		ctx.KVStore(listenedKey).Set([]byte("height"), []byte("one"))
+		ctx.KVStore(listenedKey).Delete([]byte("missing"))
+		ctx.KVStore(otherKey).Set([]byte("height"), []byte("one"))
+		return abci.ResponseBeginBlock{}
+	})
+	require.NoError(t, app.LoadLatestVersion(listenedKey))
+
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	appDeliverRes := app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("tx")})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	var (
+		beginReq   abci.RequestBeginBlock
+		beginRes   abci.ResponseBeginBlock
+		deliverReq abci.RequestDeliverTx
+		deliverRes abci.ResponseDeliverTx
+		endReq     abci.RequestEndBlock
+		endRes     abci.ResponseEndBlock
+	)
+
+	readMsgs(t, filepath.Join(dir, "test-block-1-begin"), &beginReq, &beginRes)
+	require.Equal(t, int64(1), beginReq.Header.Height)
+
+	readMsgs(t, filepath.Join(dir, "test-block-1-tx-0"), &deliverReq, &deliverRes)
+	require.Equal(t, []byte("tx"), deliverReq.Tx)
+	require.Equal(t, appDeliverRes.Code, deliverRes.Code)
+
+	readMsgs(t, filepath.Join(dir, "test-block-1-end"), &endReq, &endRes)
+	require.Equal(t, int64(1), endReq.Height)
+
+	// only the writes of the listened store are part of the change set
+	var set, del types.StoreKVPair
+	readMsgs(t, filepath.Join(dir, "test-block-1-changeset"), &set, &del)
+	require.Equal(t, types.StoreKVPair{StoreKey: "listened", Key: []byte("height"), Value: []byte("one")}, set)
+	require.Equal(t, types.StoreKVPair{StoreKey: "listened", Delete: true, Key: []byte("missing")}, del)
+
+	// no temporary files are left behind
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 4)
+}
+
+func TestNewStreamingServiceInvalidDir(t *testing.T) {
+	_, err := file.NewStreamingService(filepath.Join(os.TempDir(), "does-not-exist-streaming"), "", nil)
+	require.Error(t, err)
+}
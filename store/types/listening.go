@@ -0,0 +1,42 @@
+package types
+
+import (
+	"io"
+
+	protoio "github.com/gogo/protobuf/io"
+)
+
+// WriteListener defines an interface for receiving the writes (Sets and Deletes)
+// made on a KVStore, e.g. to stream state changes out of a listenkv.Store.
+type WriteListener interface {
+	// OnWrite is called for every write. The storeKey indicates the source
+	// KVStore, so that the same WriteListener can be used across KVStores. The
+	// value is nil if the key was deleted, which is also indicated by delete.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// StoreKVPairWriteListener is used to configure listening to a KVStore by
+// writing out length-prefixed protobuf encoded StoreKVPairs to an underlying
+// io.Writer.
+type StoreKVPairWriteListener struct {
+	writer protoio.WriteCloser
+}
+
+// NewStoreKVPairWriteListener returns a StoreKVPairWriteListener writing to the
+// given io.Writer.
+func NewStoreKVPairWriteListener(w io.Writer) *StoreKVPairWriteListener {
+	return &StoreKVPairWriteListener{
+		writer: protoio.NewDelimitedWriter(w),
+	}
+}
+
+// OnWrite satisfies the WriteListener interface by writing length-prefixed
+// protobuf encoded StoreKVPairs.
+func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	return wl.writer.WriteMsg(&StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+}
@@ -27,6 +27,19 @@ type CommitStore interface {
 	Store
 }
 
+// Snapshotter is something that can create and restore snapshots of its state
+// at a committed height, as a single binary stream. Chunking, hashing and
+// persistence of the stream are left to the caller.
+type Snapshotter interface {
+	// Snapshot writes a snapshot of the state at the given height to w.
+	Snapshot(height uint64, w io.Writer) error
+
+	// Restore restores the state at the given height from a snapshot stream
+	// previously produced by Snapshot. The state must result in the given
+	// trusted app hash, which is verified before anything is written.
+	Restore(height uint64, appHash []byte, r io.Reader) error
+}
+
 // Queryable allows a Store to expose internal state to the abci.Query
 // interface. Multistore can route requests to the proper Store.
 //
@@ -115,12 +128,26 @@ type MultiStore interface { //nolint
 type CacheMultiStore interface {
 	MultiStore
 	Write() // Writes operations to underlying KVStore
+
+	// CacheMultiStoreWithListeners cache-wraps the multi-store such that the
+	// writes of the returned CacheMultiStore are delivered to the listeners
+	// when they are written to this multi-store.
+	CacheMultiStoreWithListeners(listeners []WriteListener) CacheMultiStore
 }
 
 // A non-cache MultiStore.
 type CommitMultiStore interface {
 	Committer
 	MultiStore
+	Snapshotter
+
+	// HoldVersion keeps the given version from being pruned until it is
+	// released with ReleaseVersion, e.g. while a snapshot of it is taken.
+	HoldVersion(ver int64)
+
+	// ReleaseVersion releases a version held by HoldVersion. The version is
+	// pruned by a later commit unless the pruning options keep it.
+	ReleaseVersion(ver int64)
 
 	// Mount a store of type using the given db.
 	// If db == nil, the new store will use the CommitMultiStore db.
@@ -155,6 +182,14 @@ type CommitMultiStore interface {
 	// Set an inter-block (persistent) cache that maintains a mapping from
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the given
+	// StoreKey. They are notified of every write made on the store.
+	AddListeners(key StoreKey, listeners []WriteListener)
+
+	// ListeningEnabled returns if listening is enabled for the KVStore
+	// belonging to the given StoreKey.
+	ListeningEnabled(key StoreKey) bool
 }
 
 //---------subsp-------------------------------
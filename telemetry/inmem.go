@@ -0,0 +1,144 @@
+package telemetry
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-kit/kit/metrics"
+)
+
+// InMemSink records metrics in memory so that they can be inspected, e.g. in
+// tests. Values are keyed by metric name and label values, irrespective of the
+// order in which labels were provided.
+type InMemSink struct {
+	mtx          sync.Mutex
+	counters     map[string]float64
+	gauges       map[string]float64
+	observations map[string][]float64
+}
+
+// NewInMemSink returns a reference to a new, empty InMemSink.
+func NewInMemSink() *InMemSink {
+	return &InMemSink{
+		counters:     make(map[string]float64),
+		gauges:       make(map[string]float64),
+		observations: make(map[string][]float64),
+	}
+}
+
+// Metrics returns Metrics recording to the sink.
+func (s *InMemSink) Metrics() *Metrics {
+	return &Metrics{
+		Txs:                &inMemCounter{sink: s, name: MetricTxs},
+		MsgGasUsed:         &inMemHistogram{sink: s, name: MetricMsgGasUsed},
+		BeginBlockDuration: &inMemHistogram{sink: s, name: MetricBeginBlockDuration},
+		EndBlockDuration:   &inMemHistogram{sink: s, name: MetricEndBlockDuration},
+		StoreReadLatency:   &inMemHistogram{sink: s, name: MetricStoreReadLatency},
+		StoreWriteLatency:  &inMemHistogram{sink: s, name: MetricStoreWriteLatency},
+		NextAccountNumber:  &inMemGauge{sink: s, name: MetricNextAccountNumber},
+		Supply:             &inMemGauge{sink: s, name: MetricSupply},
+	}
+}
+
+// Counter returns the value of the counter with the given name and label
+// values ("foo", "fooValue").
+func (s *InMemSink) Counter(name string, labelValues ...string) float64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.counters[metricKey(name, labelValues)]
+}
+
+// Gauge returns the value of the gauge with the given name and label values
+// ("foo", "fooValue").
+func (s *InMemSink) Gauge(name string, labelValues ...string) float64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.gauges[metricKey(name, labelValues)]
+}
+
+// Observations returns the values observed by the histogram with the given
+// name and label values ("foo", "fooValue").
+func (s *InMemSink) Observations(name string, labelValues ...string) []float64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return append([]float64(nil), s.observations[metricKey(name, labelValues)]...)
+}
+
+// metricKey returns the key under which a metric with the given name and
+// label values is recorded.
+func metricKey(name string, labelValues []string) string {
+	pairs := make([]string, 0, len(labelValues)/2)
+	for i := 0; i+1 < len(labelValues); i += 2 {
+		pairs = append(pairs, labelValues[i]+"="+labelValues[i+1])
+	}
+
+	sort.Strings(pairs)
+	return name + "{" + strings.Join(pairs, ",") + "}"
+}
+
+func withLabelValues(lvs []string, labelValues []string) []string {
+	return append(append([]string{}, lvs...), labelValues...)
+}
+
+type inMemCounter struct {
+	sink *InMemSink
+	name string
+	lvs  []string
+}
+
+func (c *inMemCounter) With(labelValues ...string) metrics.Counter {
+	return &inMemCounter{sink: c.sink, name: c.name, lvs: withLabelValues(c.lvs, labelValues)}
+}
+
+func (c *inMemCounter) Add(delta float64) {
+	c.sink.mtx.Lock()
+	defer c.sink.mtx.Unlock()
+
+	c.sink.counters[metricKey(c.name, c.lvs)] += delta
+}
+
+type inMemGauge struct {
+	sink *InMemSink
+	name string
+	lvs  []string
+}
+
+func (g *inMemGauge) With(labelValues ...string) metrics.Gauge {
+	return &inMemGauge{sink: g.sink, name: g.name, lvs: withLabelValues(g.lvs, labelValues)}
+}
+
+func (g *inMemGauge) Set(value float64) {
+	g.sink.mtx.Lock()
+	defer g.sink.mtx.Unlock()
+
+	g.sink.gauges[metricKey(g.name, g.lvs)] = value
+}
+
+func (g *inMemGauge) Add(delta float64) {
+	g.sink.mtx.Lock()
+	defer g.sink.mtx.Unlock()
+
+	g.sink.gauges[metricKey(g.name, g.lvs)] += delta
+}
+
+type inMemHistogram struct {
+	sink *InMemSink
+	name string
+	lvs  []string
+}
+
+func (h *inMemHistogram) With(labelValues ...string) metrics.Histogram {
+	return &inMemHistogram{sink: h.sink, name: h.name, lvs: withLabelValues(h.lvs, labelValues)}
+}
+
+func (h *inMemHistogram) Observe(value float64) {
+	h.sink.mtx.Lock()
+	defer h.sink.mtx.Unlock()
+
+	key := metricKey(h.name, h.lvs)
+	h.sink.observations[key] = append(h.sink.observations[key], value)
+}
@@ -0,0 +1,176 @@
+package telemetry
+
+import (
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// Names of the metrics exposed by the SDK. When exported through Prometheus
+// they are prefixed with the configured namespace.
+const (
+	MetricTxs                = "txs"
+	MetricMsgGasUsed         = "msg_gas_used"
+	MetricBeginBlockDuration = "begin_block_duration_seconds"
+	MetricEndBlockDuration   = "end_block_duration_seconds"
+	MetricStoreReadLatency   = "store_read_latency_seconds"
+	MetricStoreWriteLatency  = "store_write_latency_seconds"
+	MetricNextAccountNumber  = "next_account_number"
+	MetricSupply             = "supply"
+)
+
+// Names of the labels attached to the metrics exposed by the SDK.
+const (
+	LabelMode      = "mode"
+	LabelSuccess   = "success"
+	LabelMsgType   = "msg_type"
+	LabelModule    = "module"
+	LabelStore     = "store"
+	LabelOperation = "operation"
+	LabelDenom     = "denom"
+)
+
+// Metrics contains the metrics exposed by the SDK.
+type Metrics struct {
+	// Number of transactions processed, by execution mode and result.
+	Txs metrics.Counter
+	// Gas consumed by the execution of messages, by message type.
+	MsgGasUsed metrics.Histogram
+	// Duration of each module's BeginBlock, by module.
+	BeginBlockDuration metrics.Histogram
+	// Duration of each module's EndBlock, by module.
+	EndBlockDuration metrics.Histogram
+	// Latency of store reads, by store type and operation.
+	StoreReadLatency metrics.Histogram
+	// Latency of store writes, by store type and operation.
+	StoreWriteLatency metrics.Histogram
+	// Account number to be assigned to the next account created. As accounts
+	// can be pruned, it bounds the number of existing accounts from above.
+	NextAccountNumber metrics.Gauge
+	// Total supply of tokens, by denomination.
+	Supply metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics built using the Prometheus client library
+// and registered with its default registerer. Optionally, labels can be
+// provided along with their values ("foo", "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+
+	return &Metrics{
+		Txs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      MetricTxs,
+			Help:      "Number of transactions processed, by execution mode and result.",
+		}, withLabels(labels, LabelMode, LabelSuccess)).With(labelsAndValues...),
+		MsgGasUsed: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      MetricMsgGasUsed,
+			Help:      "Gas consumed by the execution of messages, by message type.",
+			Buckets:   stdprometheus.ExponentialBuckets(1000, 2, 12),
+		}, withLabels(labels, LabelMsgType)).With(labelsAndValues...),
+		BeginBlockDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      MetricBeginBlockDuration,
+			Help:      "Duration of each module's BeginBlock in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0001, 4, 8),
+		}, withLabels(labels, LabelModule)).With(labelsAndValues...),
+		EndBlockDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      MetricEndBlockDuration,
+			Help:      "Duration of each module's EndBlock in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0001, 4, 8),
+		}, withLabels(labels, LabelModule)).With(labelsAndValues...),
+		StoreReadLatency: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      MetricStoreReadLatency,
+			Help:      "Latency of store reads in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.000001, 4, 10),
+		}, withLabels(labels, LabelStore, LabelOperation)).With(labelsAndValues...),
+		StoreWriteLatency: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      MetricStoreWriteLatency,
+			Help:      "Latency of store writes in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.000001, 4, 10),
+		}, withLabels(labels, LabelStore, LabelOperation)).With(labelsAndValues...),
+		NextAccountNumber: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      MetricNextAccountNumber,
+			Help:      "Account number to be assigned to the next account created.",
+		}, labels).With(labelsAndValues...),
+		Supply: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      MetricSupply,
+			Help:      "Total supply of tokens, by denomination.",
+		}, withLabels(labels, LabelDenom)).With(labelsAndValues...),
+	}
+}
+
+// withLabels returns a copy of labels extended with the given label names.
+func withLabels(labels []string, names ...string) []string {
+	return append(append([]string{}, labels...), names...)
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Txs:                discard.NewCounter(),
+		MsgGasUsed:         discard.NewHistogram(),
+		BeginBlockDuration: discard.NewHistogram(),
+		EndBlockDuration:   discard.NewHistogram(),
+		StoreReadLatency:   discard.NewHistogram(),
+		StoreWriteLatency:  discard.NewHistogram(),
+		NextAccountNumber:  discard.NewGauge(),
+		Supply:             discard.NewGauge(),
+	}
+}
+
+// defaultMetrics holds the Metrics recorded by the SDK. Metrics are kept
+// globally so that they can be recorded by stores and keepers without being
+// threaded through their constructors.
+var (
+	defaultMetrics = NopMetrics()
+	enabled        bool
+)
+
+// Default returns the Metrics recorded by the SDK, which are no-op unless
+// replaced via SetDefault.
+func Default() *Metrics {
+	return defaultMetrics
+}
+
+// Enabled returns true if Metrics have been set via SetDefault. Hot paths, such
+// as store operations, check it to skip their measurements altogether while
+// telemetry is disabled.
+func Enabled() bool {
+	return enabled
+}
+
+// SetDefault replaces the Metrics recorded by the SDK and enables telemetry.
+// Passing nil restores the no-op Metrics and disables telemetry. It must be
+// called before the application is created, as it is not safe for concurrent
+// use.
+func SetDefault(m *Metrics) {
+	if m == nil {
+		defaultMetrics = NopMetrics()
+		enabled = false
+		return
+	}
+
+	defaultMetrics = m
+	enabled = true
+}
+
+// ObserveSince records the time elapsed since start, in seconds, with the
+// given histogram. It is meant to be deferred, e.g.
+//
+//	defer telemetry.ObserveSince(h, time.Now())
+func ObserveSince(h metrics.Histogram, start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
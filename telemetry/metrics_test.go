@@ -0,0 +1,71 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemSink(t *testing.T) {
+	sink := NewInMemSink()
+	m := sink.Metrics()
+
+	m.Txs.With(LabelMode, "deliver", LabelSuccess, "true").Add(1)
+	m.Txs.With(LabelSuccess, "true").With(LabelMode, "deliver").Add(2)
+	m.Txs.With(LabelMode, "check", LabelSuccess, "true").Add(1)
+
+	// label values are matched irrespective of their order
+	require.Equal(t, 3.0, sink.Counter(MetricTxs, LabelSuccess, "true", LabelMode, "deliver"))
+	require.Equal(t, 1.0, sink.Counter(MetricTxs, LabelMode, "check", LabelSuccess, "true"))
+	require.Equal(t, 0.0, sink.Counter(MetricTxs, LabelMode, "simulate", LabelSuccess, "true"))
+
+	m.NextAccountNumber.Set(10)
+	m.NextAccountNumber.Add(2)
+	require.Equal(t, 12.0, sink.Gauge(MetricNextAccountNumber))
+
+	m.Supply.With(LabelDenom, "stake").Set(100)
+	require.Equal(t, 100.0, sink.Gauge(MetricSupply, LabelDenom, "stake"))
+	require.Equal(t, 0.0, sink.Gauge(MetricSupply, LabelDenom, "atom"))
+
+	h := m.BeginBlockDuration.With(LabelModule, "bank")
+	ObserveSince(h, time.Now().Add(-time.Second))
+	h.Observe(0.5)
+
+	observations := sink.Observations(MetricBeginBlockDuration, LabelModule, "bank")
+	require.Len(t, observations, 2)
+	require.True(t, observations[0] >= 1)
+	require.Equal(t, 0.5, observations[1])
+}
+
+func TestPrometheusMetrics(t *testing.T) {
+	m := PrometheusMetrics("telemetry_test", "chain_id", "test-chain")
+
+	require.NotPanics(t, func() {
+		m.Txs.With(LabelMode, "deliver", LabelSuccess, "true").Add(1)
+		m.MsgGasUsed.With(LabelMsgType, "bank/send").Observe(1000)
+		m.BeginBlockDuration.With(LabelModule, "bank").Observe(0.1)
+		m.EndBlockDuration.With(LabelModule, "bank").Observe(0.1)
+		m.StoreReadLatency.With(LabelStore, "iavl", LabelOperation, "get").Observe(0.001)
+		m.StoreWriteLatency.With(LabelStore, "iavl", LabelOperation, "set").Observe(0.001)
+		m.NextAccountNumber.Set(1)
+		m.Supply.With(LabelDenom, "stake").Set(1)
+	})
+}
+
+func TestSetDefault(t *testing.T) {
+	require.False(t, Enabled())
+
+	sink := NewInMemSink()
+	SetDefault(sink.Metrics())
+	require.True(t, Enabled())
+
+	Default().Txs.With(LabelMode, "deliver", LabelSuccess, "true").Add(1)
+	require.Equal(t, 1.0, sink.Counter(MetricTxs, LabelMode, "deliver", LabelSuccess, "true"))
+
+	SetDefault(nil)
+	require.False(t, Enabled())
+
+	Default().Txs.With(LabelMode, "deliver", LabelSuccess, "true").Add(1)
+	require.Equal(t, 1.0, sink.Counter(MetricTxs, LabelMode, "deliver", LabelSuccess, "true"))
+}
@@ -33,6 +33,8 @@ type Context struct {
 	checkTx       bool
 	recheckTx     bool // if recheckTx == true, then checkTx must also be true
 	minGasPrice   DecCoins
+	msgGasPrices  map[string]DecCoins
+	priority      int64
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
 }
@@ -169,6 +171,29 @@ func (c Context) WithMinGasPrices(gasPrices DecCoins) Context {
 	return c
 }
 
+// WithMsgMinGasPrices returns a Context with the minimum gas prices of the txs
+// containing a message of a given type, keyed by the "<route>/<type>" of the
+// message.
+func (c Context) WithMsgMinGasPrices(gasPrices map[string]DecCoins) Context {
+	c.msgGasPrices = gasPrices
+	return c
+}
+
+// MsgMinGasPrices returns the minimum gas prices of the txs containing a
+// message of a given type, keyed by the "<route>/<type>" of the message.
+func (c Context) MsgMinGasPrices() map[string]DecCoins { return c.msgGasPrices }
+
+// WithPriority returns a Context with the mempool priority of the tx being
+// processed.
+func (c Context) WithPriority(priority int64) Context {
+	c.priority = priority
+	return c
+}
+
+// Priority returns the mempool priority of the tx being processed, as set by
+// the AnteHandler. A greater priority is processed first.
+func (c Context) Priority() int64 { return c.priority }
+
 func (c Context) WithConsensusParams(params *abci.ConsensusParams) Context {
 	c.consParams = params
 	return c
@@ -11,11 +11,8 @@ import (
 // ----------------------------------------------------------------------------
 // Decimal Coin
 
-// DecCoin defines a coin which can have additional decimal points
-type DecCoin struct {
-	Denom  string `json:"denom"`
-	Amount Dec    `json:"amount"`
-}
+// DecCoin is defined in types.proto and represents a coin which can have
+// additional decimal points.
 
 // NewDecCoin creates a new DecCoin instance from an Int.
 func NewDecCoin(denom string, amount Int) DecCoin {
@@ -659,6 +659,58 @@ func (d *Dec) UnmarshalJSON(bz []byte) error {
 // MarshalYAML returns Ythe AML representation.
 func (d Dec) MarshalYAML() (interface{}, error) { return d.String(), nil }
 
+// String implements the Stringer interface.
+func (dp DecProto) String() string {
+	return dp.Dec.String()
+}
+
+// Marshal implements the gogo proto custom type interface.
+func (d Dec) Marshal() ([]byte, error) {
+	if d.Int == nil {
+		d.Int = new(big.Int)
+	}
+	return d.Int.MarshalText()
+}
+
+// MarshalTo implements the gogo proto custom type interface.
+func (d *Dec) MarshalTo(data []byte) (n int, err error) {
+	bz, err := d.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	copy(data, bz)
+	return len(bz), nil
+}
+
+// Unmarshal implements the gogo proto custom type interface.
+func (d *Dec) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		d.Int = new(big.Int)
+		return nil
+	}
+
+	if d.Int == nil {
+		d.Int = new(big.Int)
+	}
+
+	if err := d.Int.UnmarshalText(data); err != nil {
+		return err
+	}
+
+	if d.Int.BitLen() > maxBitLen {
+		return fmt.Errorf("decimal out of range; got: %d, max: %d", d.Int.BitLen(), maxBitLen)
+	}
+
+	return nil
+}
+
+// Size implements the gogo proto custom type interface.
+func (d *Dec) Size() int {
+	bz, _ := d.Marshal()
+	return len(bz)
+}
+
 //___________________________________________________________________________________
 // helpers
 
@@ -71,6 +71,9 @@ var (
 	// ErrJSONUnmarshal defines an ABCI typed JSON unmarshalling error
 	ErrJSONUnmarshal = Register(RootCodespace, 18, "failed to unmarshal JSON bytes")
 
+	// ErrTxTimeoutHeight defines an error for a tx rejected past its timeout height
+	ErrTxTimeoutHeight = Register(RootCodespace, 19, "tx timeout height")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
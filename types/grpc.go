@@ -0,0 +1,32 @@
+package types
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// GRPCServer defines the subset of a gRPC server needed to register query
+// services. It is implemented by both *grpc.Server and the BaseApp gRPC query
+// router, allowing modules to register a service once for both.
+type GRPCServer interface {
+	RegisterService(sd *grpc.ServiceDesc, ss interface{})
+}
+
+type sdkContextKeyType string
+
+// SdkContextKey is the key under which an sdk.Context is stored in the
+// context.Context handed to gRPC service methods.
+const SdkContextKey sdkContextKeyType = "sdk-context"
+
+// WrapSDKContext returns a context.Context wrapping the given sdk.Context so
+// that it can be passed to gRPC service methods.
+func WrapSDKContext(ctx Context) context.Context {
+	return context.WithValue(ctx.Context(), SdkContextKey, ctx)
+}
+
+// UnwrapSDKContext retrieves the sdk.Context from a context.Context passed to
+// a gRPC service method. It panics if the context does not hold one.
+func UnwrapSDKContext(goCtx context.Context) Context {
+	return goCtx.Value(SdkContextKey).(Context)
+}
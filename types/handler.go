@@ -7,6 +7,10 @@ type Handler func(ctx Context, msg Msg) Result
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
 
+// TxPriorityFunc determines the mempool priority of a tx, given the context
+// returned by the AnteHandler. A greater priority is processed first.
+type TxPriorityFunc func(ctx Context, tx Tx) int64
+
 // AnteDecorator wraps the next AnteHandler to perform custom pre- and post-processing.
 type AnteDecorator interface {
 	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, err error)
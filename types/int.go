@@ -114,6 +114,11 @@ func NewInt(n int64) Int {
 	return Int{big.NewInt(n)}
 }
 
+// NewIntFromUint64 constructs Int from uint64
+func NewIntFromUint64(n uint64) Int {
+	return Int{new(big.Int).SetUint64(n)}
+}
+
 // NewIntFromBigInt constructs Int from big.Int
 func NewIntFromBigInt(i *big.Int) Int {
 	if i.BitLen() > maxBitLen {
@@ -355,6 +360,58 @@ func (i *Int) UnmarshalJSON(bz []byte) error {
 // MarshalYAML returns Ythe AML representation.
 func (i Int) MarshalYAML() (interface{}, error) { return i.String(), nil }
 
+// String implements the Stringer interface.
+func (ip IntProto) String() string {
+	return ip.Int.String()
+}
+
+// Marshal implements the gogo proto custom type interface.
+func (i Int) Marshal() ([]byte, error) {
+	if i.i == nil {
+		i.i = new(big.Int)
+	}
+	return i.i.MarshalText()
+}
+
+// MarshalTo implements the gogo proto custom type interface.
+func (i *Int) MarshalTo(data []byte) (n int, err error) {
+	bz, err := i.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	copy(data, bz)
+	return len(bz), nil
+}
+
+// Unmarshal implements the gogo proto custom type interface.
+func (i *Int) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		i.i = new(big.Int)
+		return nil
+	}
+
+	if i.i == nil {
+		i.i = new(big.Int)
+	}
+
+	if err := i.i.UnmarshalText(data); err != nil {
+		return err
+	}
+
+	if i.i.BitLen() > maxBitLen {
+		return fmt.Errorf("integer out of range; got: %d, max: %d", i.i.BitLen(), maxBitLen)
+	}
+
+	return nil
+}
+
+// Size implements the gogo proto custom type interface.
+func (i *Int) Size() int {
+	bz, _ := i.Marshal()
+	return len(bz)
+}
+
 // intended to be used with require/assert:  require.True(IntEq(...))
 func IntEq(t *testing.T, exp, got Int) (*testing.T, bool, string, string, string) {
 	return t, exp.Equal(got), "expected:\t%v\ngot:\t\t%v", exp.String(), got.String()
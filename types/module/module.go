@@ -30,6 +30,7 @@ package module
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
@@ -38,6 +39,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -49,8 +51,8 @@ type AppModuleBasic interface {
 	RegisterCodec(*codec.Codec)
 
 	// genesis
-	DefaultGenesis() json.RawMessage
-	ValidateGenesis(json.RawMessage) error
+	DefaultGenesis(codec.JSONMarshaler) json.RawMessage
+	ValidateGenesis(codec.JSONMarshaler, json.RawMessage) error
 
 	// client functionality
 	RegisterRESTRoutes(context.CLIContext, *mux.Router)
@@ -78,18 +80,18 @@ func (bm BasicManager) RegisterCodec(cdc *codec.Codec) {
 }
 
 // DefaultGenesis provides default genesis information for all modules
-func (bm BasicManager) DefaultGenesis() map[string]json.RawMessage {
+func (bm BasicManager) DefaultGenesis(cdc codec.JSONMarshaler) map[string]json.RawMessage {
 	genesis := make(map[string]json.RawMessage)
 	for _, b := range bm {
-		genesis[b.Name()] = b.DefaultGenesis()
+		genesis[b.Name()] = b.DefaultGenesis(cdc)
 	}
 	return genesis
 }
 
 // ValidateGenesis performs genesis state validation for all modules
-func (bm BasicManager) ValidateGenesis(genesis map[string]json.RawMessage) error {
+func (bm BasicManager) ValidateGenesis(cdc codec.JSONMarshaler, genesis map[string]json.RawMessage) error {
 	for _, b := range bm {
-		if err := b.ValidateGenesis(genesis[b.Name()]); err != nil {
+		if err := b.ValidateGenesis(cdc, genesis[b.Name()]); err != nil {
 			return err
 		}
 	}
@@ -126,8 +128,8 @@ func (bm BasicManager) AddQueryCommands(rootQueryCmd *cobra.Command, cdc *codec.
 // AppModuleGenesis is the standard form for an application module genesis functions
 type AppModuleGenesis interface {
 	AppModuleBasic
-	InitGenesis(sdk.Context, json.RawMessage) []abci.ValidatorUpdate
-	ExportGenesis(sdk.Context) json.RawMessage
+	InitGenesis(sdk.Context, codec.JSONMarshaler, json.RawMessage) []abci.ValidatorUpdate
+	ExportGenesis(sdk.Context, codec.JSONMarshaler) json.RawMessage
 }
 
 // AppModule is the standard form for an application module
@@ -142,6 +144,7 @@ type AppModule interface {
 	NewHandler() sdk.Handler
 	QuerierRoute() string
 	NewQuerierHandler() sdk.Querier
+	RegisterQueryService(sdk.GRPCServer)
 
 	// ABCI
 	BeginBlock(sdk.Context, abci.RequestBeginBlock)
@@ -177,6 +180,9 @@ func (GenesisOnlyAppModule) QuerierRoute() string { return "" }
 // NewQuerierHandler returns an empty module querier
 func (gam GenesisOnlyAppModule) NewQuerierHandler() sdk.Querier { return nil }
 
+// RegisterQueryService registers no gRPC query service
+func (GenesisOnlyAppModule) RegisterQueryService(_ sdk.GRPCServer) {}
+
 // BeginBlock returns an empty module begin-block
 func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
 
@@ -255,14 +261,21 @@ func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter)
 	}
 }
 
+// RegisterQueryServices registers the gRPC query services of all modules
+func (m *Manager) RegisterQueryServices(server sdk.GRPCServer) {
+	for _, module := range m.Modules {
+		module.RegisterQueryService(server)
+	}
+}
+
 // InitGenesis performs init genesis functionality for modules
-func (m *Manager) InitGenesis(ctx sdk.Context, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
+func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
 	var validatorUpdates []abci.ValidatorUpdate
 	for _, moduleName := range m.OrderInitGenesis {
 		if genesisData[moduleName] == nil {
 			continue
 		}
-		moduleValUpdates := m.Modules[moduleName].InitGenesis(ctx, genesisData[moduleName])
+		moduleValUpdates := m.Modules[moduleName].InitGenesis(ctx, cdc, genesisData[moduleName])
 
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
@@ -279,10 +292,10 @@ func (m *Manager) InitGenesis(ctx sdk.Context, genesisData map[string]json.RawMe
 }
 
 // ExportGenesis performs export genesis functionality for modules
-func (m *Manager) ExportGenesis(ctx sdk.Context) map[string]json.RawMessage {
+func (m *Manager) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) map[string]json.RawMessage {
 	genesisData := make(map[string]json.RawMessage)
 	for _, moduleName := range m.OrderExportGenesis {
-		genesisData[moduleName] = m.Modules[moduleName].ExportGenesis(ctx)
+		genesisData[moduleName] = m.Modules[moduleName].ExportGenesis(ctx, cdc)
 	}
 	return genesisData
 }
@@ -294,7 +307,9 @@ func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 	for _, moduleName := range m.OrderBeginBlockers {
+		start := time.Now()
 		m.Modules[moduleName].BeginBlock(ctx, req)
+		telemetry.ObserveSince(telemetry.Default().BeginBlockDuration.With(telemetry.LabelModule, moduleName), start)
 	}
 
 	return abci.ResponseBeginBlock{
@@ -310,7 +325,9 @@ func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 	validatorUpdates := []abci.ValidatorUpdate{}
 
 	for _, moduleName := range m.OrderEndBlockers {
+		start := time.Now()
 		moduleValUpdates := m.Modules[moduleName].EndBlock(ctx, req)
+		telemetry.ObserveSince(telemetry.Default().EndBlockDuration.With(telemetry.LabelModule, moduleName), start)
 
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
@@ -57,6 +57,7 @@ type BaseReq struct {
 	Gas           string       `json:"gas"`
 	GasAdjustment string       `json:"gas_adjustment"`
 	Simulate      bool         `json:"simulate"`
+	TimeoutHeight uint64       `json:"timeout_height"`
 }
 
 // NewBaseReq creates a new basic request instance and sanitizes its values
@@ -81,10 +82,13 @@ func NewBaseReq(
 
 // Sanitize performs basic sanitization on a BaseReq object.
 func (br BaseReq) Sanitize() BaseReq {
-	return NewBaseReq(
+	sanitized := NewBaseReq(
 		br.From, br.Memo, br.ChainID, br.Gas, br.GasAdjustment,
 		br.AccountNumber, br.Sequence, br.Fees, br.GasPrices, br.Simulate,
 	)
+	sanitized.TimeoutHeight = br.TimeoutHeight
+
+	return sanitized
 }
 
 // ValidateBasic performs basic validation of a BaseReq. If custom validation
@@ -37,6 +37,10 @@ type Result struct {
 	// Events contains a slice of Event objects that were emitted during some
 	// execution.
 	Events Events
+
+	// Priority is the mempool priority of the tx, as determined by the
+	// TxPriorityFunc of the application.
+	Priority int64
 }
 
 // TODO: In the future, more codes may be OK.
@@ -296,3 +300,21 @@ func ParseABCILogs(logs string) (res ABCIMessageLogs, err error) {
 	err = json.Unmarshal([]byte(logs), &res)
 	return res, err
 }
+
+// SimulationResponse defines the response of a transaction simulation. Along
+// with the result of the transaction, it contains the logs of its messages and
+// the state changes it would make if it was delivered.
+type SimulationResponse struct {
+	Result       Result          `json:"result" yaml:"result"`
+	Logs         ABCIMessageLogs `json:"logs" yaml:"logs"`
+	StateChanges []StateChange   `json:"state_changes" yaml:"state_changes"`
+}
+
+// StateChange defines the change of the value of a key in a store. Before or
+// After is nil if the key is not set before the change or deleted by it.
+type StateChange struct {
+	StoreKey string `json:"store_key" yaml:"store_key"`
+	Key      []byte `json:"key" yaml:"key"`
+	Before   []byte `json:"before" yaml:"before"`
+	After    []byte `json:"after" yaml:"after"`
+}
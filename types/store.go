@@ -129,6 +129,9 @@ type KVPair = types.KVPair
 // every trace operation.
 type TraceContext = types.TraceContext
 
+// WriteListener is notified of the writes made on a KVStore it listens to.
+type WriteListener = types.WriteListener
+
 // --------------------------------------
 
 // nolint - reexport
@@ -0,0 +1,15 @@
+package auth
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// EndBlocker prunes the inactive accounts and records the account number to be
+// assigned to the next account created.
+func EndBlocker(ctx sdk.Context, ak AccountKeeper, sk types.StakingKeeper) {
+	ak.PruneInactiveAccounts(ctx, sk)
+
+	telemetry.Default().NextAccountNumber.Set(float64(ak.PeekNextAccountNumber(ctx)))
+}
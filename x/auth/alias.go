@@ -3,12 +3,14 @@
 // aliases generated for the following subdirectories:
 // ALIASGEN: github.com/cosmos/cosmos-sdk/x/auth/ante
 // ALIASGEN: github.com/cosmos/cosmos-sdk/x/auth/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/auth/signing
 // ALIASGEN: github.com/cosmos/cosmos-sdk/x/auth/types
 package auth
 
 import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -23,7 +25,17 @@ const (
 	DefaultTxSizeCostPerByte      = types.DefaultTxSizeCostPerByte
 	DefaultSigVerifyCostED25519   = types.DefaultSigVerifyCostED25519
 	DefaultSigVerifyCostSecp256k1 = types.DefaultSigVerifyCostSecp256k1
+	DefaultAccountPruningBlocks   = types.DefaultAccountPruningBlocks
 	QueryAccount                  = types.QueryAccount
+	QueryTombstone                = types.QueryTombstone
+	EventTypePruneAccount         = types.EventTypePruneAccount
+	AttributeKeyAddress           = types.AttributeKeyAddress
+	AttributeKeyAccountNumber     = types.AttributeKeyAccountNumber
+	AttributeKeySequence          = types.AttributeKeySequence
+	SignModeUnspecified           = types.SignModeUnspecified
+	SignModeDirect                = types.SignModeDirect
+	SignModeTextual               = types.SignModeTextual
+	SignModeLegacyAminoJSON       = types.SignModeLegacyAminoJSON
 )
 
 var (
@@ -61,6 +73,23 @@ var (
 	MakeSignature                     = types.MakeSignature
 	ValidateGenAccounts               = types.ValidateGenAccounts
 	GetGenesisStateFromAppState       = types.GetGenesisStateFromAppState
+	NewQueryServer                    = keeper.NewQueryServer
+	RegisterQueryService              = types.RegisterQueryService
+	NewQueryClient                    = types.NewQueryClient
+	NewHandlerMap                     = signing.NewHandlerMap
+	DefaultSignModeHandler            = signing.DefaultSignModeHandler
+	NewDirectHandler                  = signing.NewDirectHandler
+	SignModeFromString                = types.SignModeFromString
+	DirectSignBytes                   = types.DirectSignBytes
+	RegisterInvariants                = keeper.RegisterInvariants
+	TombstonesInvariant               = keeper.TombstonesInvariant
+	InactiveAccountQueueInvariant     = keeper.InactiveAccountQueueInvariant
+	NewTombstone                      = types.NewTombstone
+	NewQueryTombstoneParams           = types.NewQueryTombstoneParams
+	AccountActivityKey                = types.AccountActivityKey
+	InactiveAccountQueueKey           = types.InactiveAccountQueueKey
+	TombstoneKey                      = types.TombstoneKey
+	NewAccountActivity                = types.NewAccountActivity
 
 	// variable aliases
 	ModuleCdc                 = types.ModuleCdc
@@ -71,6 +100,11 @@ var (
 	KeyTxSizeCostPerByte      = types.KeyTxSizeCostPerByte
 	KeySigVerifyCostED25519   = types.KeySigVerifyCostED25519
 	KeySigVerifyCostSecp256k1 = types.KeySigVerifyCostSecp256k1
+	KeyAccountPruningBlocks   = types.KeyAccountPruningBlocks
+
+	AccountActivityKeyPrefix      = types.AccountActivityKeyPrefix
+	InactiveAccountQueueKeyPrefix = types.InactiveAccountQueueKeyPrefix
+	TombstoneKeyPrefix            = types.TombstoneKeyPrefix
 )
 
 type (
@@ -89,4 +123,20 @@ type (
 	StdSignature                     = types.StdSignature
 	TxBuilder                        = types.TxBuilder
 	GenesisAccountIterator           = types.GenesisAccountIterator
+	QueryServer                      = types.QueryServer
+	QueryClient                      = types.QueryClient
+	QueryAccountRequest              = types.QueryAccountRequest
+	QueryAccountResponse             = types.QueryAccountResponse
+	SignModeHandler                  = signing.SignModeHandler
+	SignerData                       = signing.SignerData
+	HandlerMap                       = signing.HandlerMap
+	LegacyAminoJSONHandler           = signing.LegacyAminoJSONHandler
+	DirectHandler                    = signing.DirectHandler
+	SignMode                         = types.SignMode
+	SignDoc                          = types.SignDoc
+	Tombstone                        = types.Tombstone
+	QueryTombstoneParams             = types.QueryTombstoneParams
+	QueryTombstoneRequest            = types.QueryTombstoneRequest
+	QueryTombstoneResponse           = types.QueryTombstoneResponse
+	AccountActivity                  = types.AccountActivity
 )
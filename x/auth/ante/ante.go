@@ -3,24 +3,33 @@ package ante
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
-func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
+// signer, or from the fee granter if one is set. The feegrant keeper may be nil
+// if the application does not support fee grants. Likewise, the fee market
+// keeper may be nil if the application does not enforce a base fee. Signatures
+// are verified over the bytes given by the sign mode handler for their sign mode.
+func NewAnteHandler(
+	ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, feegrantKeeper types.FeegrantKeeper,
+	feeMarketKeeper types.FeeMarketKeeper, sigGasConsumer SignatureVerificationGasConsumer, signModeHandler signing.SignModeHandler,
+) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewMempoolFeeDecorator(),
 		NewValidateBasicDecorator(),
 		NewValidateMemoDecorator(ak),
+		NewTxTimeoutHeightDecorator(),
 		NewConsumeGasForTxSizeDecorator(ak),
 		NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(ak),
-		NewDeductFeeDecorator(ak, supplyKeeper),
+		NewDeductFeeDecorator(ak, supplyKeeper, feegrantKeeper),
+		NewFeeMarketDecorator(feeMarketKeeper),
 		NewSigGasConsumeDecorator(ak, sigGasConsumer),
-		NewSigVerificationDecorator(ak),
+		NewSigVerificationDecorator(ak, signModeHandler),
 		NewIncrementSequenceDecorator(ak), // innermost AnteDecorator
 	)
 }
@@ -41,7 +41,7 @@ func TestSimulateGasCost(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -92,7 +92,7 @@ func TestSimulateGasCost(t *testing.T) {
 func TestAnteHandlerSigErrors(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -141,7 +141,7 @@ func TestAnteHandlerAccountNumbers(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(false)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -198,7 +198,7 @@ func TestAnteHandlerAccountNumbersAtBlockHeightZero(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(false)
 	ctx = ctx.WithBlockHeight(0)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -254,7 +254,7 @@ func TestAnteHandlerSequences(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(false)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -330,7 +330,7 @@ func TestAnteHandlerSequences(t *testing.T) {
 func TestAnteHandlerFees(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -370,7 +370,7 @@ func TestAnteHandlerMemoGas(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -406,11 +406,46 @@ func TestAnteHandlerMemoGas(t *testing.T) {
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
 
+func TestAnteHandlerTimeoutHeight(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(false)
+	ctx = ctx.WithBlockHeight(10)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(types.NewTestCoins())
+	require.NoError(t, acc1.SetAccountNumber(0))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+
+	// msg and signatures
+	msg := types.NewTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	fee := types.NewTestStdFee()
+
+	// the timeout height is signed over
+	tx := types.NewTestTxWithTimeoutHeight(ctx, []sdk.Msg{msg}, privs, accnums, seqs, fee, 10).(types.StdTx)
+	tx.TimeoutHeight = 11
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// tx past its timeout height is rejected
+	tx = types.NewTestTxWithTimeoutHeight(ctx, []sdk.Msg{msg}, privs, accnums, seqs, fee, 9).(types.StdTx)
+	_, err := anteHandler(ctx, tx, false)
+	require.True(t, sdkerrors.ErrTxTimeoutHeight.Is(err), "expected timeout height error, got %v", err)
+
+	// tx up to its timeout height is accepted
+	tx = types.NewTestTxWithTimeoutHeight(ctx, []sdk.Msg{msg}, privs, accnums, seqs, fee, 10).(types.StdTx)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+}
+
 func TestAnteHandlerMultiSigner(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(false)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -437,7 +472,7 @@ func TestAnteHandlerMultiSigner(t *testing.T) {
 	msg2 := types.NewTestMsg(addr3, addr1)
 	msg3 := types.NewTestMsg(addr2, addr3)
 	msgs := []sdk.Msg{msg1, msg2, msg3}
-	fee := types.NewTestStdFee()
+	fee := types.NewStdFee(150000, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
 
 	// signers in order
 	privs, accnums, seqs := []crypto.PrivKey{priv1, priv2, priv3}, []uint64{0, 1, 2}, []uint64{0, 0, 0}
@@ -460,7 +495,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -514,7 +549,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 	for _, cs := range cases {
 		tx := types.NewTestTxWithSignBytes(
 			msgs, privs, accnums, seqs, fee,
-			types.StdSignBytes(cs.chainID, cs.accnum, cs.seq, cs.fee, cs.msgs, ""),
+			types.StdSignBytes(cs.chainID, cs.accnum, cs.seq, 0, cs.fee, cs.msgs, ""),
 			"",
 		)
 		checkInvalidTx(t, anteHandler, ctx, tx, false, cs.code)
@@ -537,7 +572,7 @@ func TestAnteHandlerSetPubKey(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -655,7 +690,7 @@ func TestAnteHandlerSigLimitExceeded(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -695,7 +730,7 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
 	// setup an ante handler that only accepts PubKeyEd25519
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, func(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params types.Params) error {
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, func(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params types.Params) error {
 		switch pubkey := pubkey.(type) {
 		case ed25519.PubKeyEd25519:
 			meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
@@ -703,7 +738,7 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unrecognized public key type: %T", pubkey)
 		}
-	})
+	}, defaultSignModeHandler(app))
 
 	// verify that an secp256k1 account gets rejected
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -752,7 +787,7 @@ func TestAnteHandlerReCheck(t *testing.T) {
 	require.NoError(t, acc1.SetAccountNumber(0))
 	app.AccountKeeper.SetAccount(ctx, acc1)
 
-	antehandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	antehandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.FeeMarketKeeper, ante.DefaultSigVerificationGasConsumer, defaultSignModeHandler(app))
 
 	// test that operations skipped on recheck do not run
 
@@ -781,9 +816,9 @@ func TestAnteHandlerReCheck(t *testing.T) {
 		name   string
 		params types.Params
 	}{
-		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000)},
+		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultAccountPruningBlocks)},
+		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultAccountPruningBlocks)},
+		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000, types.DefaultAccountPruningBlocks)},
 	}
 	for _, tc := range testCases {
 		// set testcase parameters
@@ -13,7 +13,8 @@ import (
 )
 
 var (
-	_ TxWithMemo = (*types.StdTx)(nil) // assert StdTx implements TxWithMemo
+	_ TxWithMemo          = (*types.StdTx)(nil) // assert StdTx implements TxWithMemo
+	_ TxWithTimeoutHeight = (*types.StdTx)(nil) // assert StdTx implements TxWithTimeoutHeight
 )
 
 // ValidateBasicDecorator will call tx.ValidateBasic and return any non-nil error.
@@ -76,6 +77,38 @@ func (vmd ValidateMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ctx, tx, simulate)
 }
 
+// Tx must have GetTimeoutHeight() method to use TxTimeoutHeightDecorator
+type TxWithTimeoutHeight interface {
+	sdk.Tx
+	GetTimeoutHeight() uint64
+}
+
+// TxTimeoutHeightDecorator rejects a tx included in a block past its timeout
+// height, if it has one, so that a signed tx cannot be included arbitrarily
+// later. Otherwise, it calls the next AnteHandler.
+// CONTRACT: Tx must implement TxWithTimeoutHeight interface
+type TxTimeoutHeightDecorator struct{}
+
+func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
+	return TxTimeoutHeightDecorator{}
+}
+
+func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	timeoutTx, ok := tx.(TxWithTimeoutHeight)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	timeoutHeight := timeoutTx.GetTimeoutHeight()
+	if timeoutHeight > 0 && uint64(ctx.BlockHeight()) > timeoutHeight {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrTxTimeoutHeight,
+			"block height %d is past the timeout height %d", ctx.BlockHeight(), timeoutHeight,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
 // ConsumeTxSizeGasDecorator will take in parameters and consume gas proportional
 // to the size of tx before calling next AnteHandler. Note, the gas costs will be
 // slightly over estimated due to the fact that any given signing account may need
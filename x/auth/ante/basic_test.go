@@ -9,6 +9,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -80,6 +81,50 @@ func TestValidateMemo(t *testing.T) {
 	require.Nil(t, err, "ValidateBasicDecorator returned error on valid tx. err: %v", err)
 }
 
+func TestTxTimeoutHeight(t *testing.T) {
+	// setup
+	_, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(10)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msg1 := types.NewTestMsg(addr1)
+	fee := types.NewTestStdFee()
+
+	msgs := []sdk.Msg{msg1}
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewTxTimeoutHeightDecorator())
+
+	testCases := []struct {
+		name          string
+		timeoutHeight uint64
+		expectErr     bool
+	}{
+		{"no timeout", 0, false},
+		{"timeout in the future", 20, false},
+		{"timeout at the block height", 10, false},
+		{"timeout in the past", 9, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tx := types.NewTestTxWithTimeoutHeight(ctx, msgs, privs, accNums, seqs, fee, tc.timeoutHeight)
+
+			_, err := antehandler(ctx, tx, false)
+			if tc.expectErr {
+				require.True(t, sdkerrors.ErrTxTimeoutHeight.Is(err), "expected timeout height error, got %v", err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestConsumeGasForTxSize(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
@@ -2,6 +2,7 @@ package ante
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
@@ -21,13 +22,18 @@ type FeeTx interface {
 	GetGas() uint64
 	GetFee() sdk.Coins
 	FeePayer() sdk.AccAddress
+	FeeGranter() sdk.AccAddress
 }
 
 // MempoolFeeDecorator will check if the transaction's fee is at least as large
 // as the local validator's minimum gasFee (defined in validator config).
+// Messages whose type has its own minimum gas prices configured are priced
+// with them instead, and the fee must cover the prices of every message type
+// contained in the tx.
 // If fee is too low, decorator returns error and tx is rejected from mempool.
 // Note this only applies when ctx.CheckTx = true
-// If fee is high enough or not CheckTx, then call next AnteHandler
+// If fee is high enough or not CheckTx, then call next AnteHandler with the
+// mempool priority of the tx set to its fee per gas.
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
 type MempoolFeeDecorator struct{}
 
@@ -47,8 +53,11 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	// if this is a CheckTx. This is only for local mempool purposes, and thus
 	// is only ran on check tx.
 	if ctx.IsCheckTx() && !simulate {
-		minGasPrices := ctx.MinGasPrices()
-		if !minGasPrices.IsZero() {
+		for _, minGasPrices := range txMinGasPrices(ctx, tx) {
+			if minGasPrices.IsZero() {
+				continue
+			}
+
 			requiredFees := make(sdk.Coins, len(minGasPrices))
 
 			// Determine the required fees by multiplying each required minimum gas
@@ -65,22 +74,92 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		}
 	}
 
-	return next(ctx, tx, simulate)
+	newCtx = ctx.WithPriority(txPriority(feeCoins, gas))
+	return next(newCtx, tx, simulate)
 }
 
-// DeductFeeDecorator deducts fees from the first signer of the tx
-// If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
+// gasPricePriorityScale scales the gas prices making up the mempool priority of
+// a tx, so that gas prices below one are told apart.
+const gasPricePriorityScale = 1000000
+
+// txPriority returns the mempool priority of a tx given its fee and gas limit:
+// the lowest fee per gas of the denominations of the fee, scaled by
+// gasPricePriorityScale and capped to math.MaxInt64.
+func txPriority(fee sdk.Coins, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	var (
+		priority int64
+		found    bool
+	)
+
+	glDec := sdk.NewIntFromUint64(gas).ToDec()
+	for _, c := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.ToDec().Quo(glDec).MulInt64(gasPricePriorityScale).TruncateInt()
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+
+		if !found || p < priority {
+			priority, found = p, true
+		}
+	}
+
+	return priority
+}
+
+// txMinGasPrices returns the distinct minimum gas prices which apply to the
+// messages of the tx: the prices configured for the message type if any, the
+// validator's minimum gas prices otherwise.
+func txMinGasPrices(ctx sdk.Context, tx sdk.Tx) []sdk.DecCoins {
+	msgGasPrices := ctx.MsgMinGasPrices()
+	if len(msgGasPrices) == 0 {
+		return []sdk.DecCoins{ctx.MinGasPrices()}
+	}
+
+	var (
+		seen      = make(map[string]bool)
+		gasPrices []sdk.DecCoins
+	)
+
+	for _, msg := range tx.GetMsgs() {
+		msgType := fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+		prices, ok := msgGasPrices[msgType]
+		if !ok {
+			msgType, prices = "", ctx.MinGasPrices()
+		}
+
+		if !seen[msgType] {
+			seen[msgType] = true
+			gasPrices = append(gasPrices, prices)
+		}
+	}
+
+	return gasPrices
+}
+
+// DeductFeeDecorator deducts fees from the first signer of the tx, or from the
+// fee granter if the tx names one and the first signer holds a fee allowance
+// from it.
+// If the fee payer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
-	ak           keeper.AccountKeeper
-	supplyKeeper types.SupplyKeeper
+	ak             keeper.AccountKeeper
+	supplyKeeper   types.SupplyKeeper
+	feegrantKeeper types.FeegrantKeeper
 }
 
-func NewDeductFeeDecorator(ak keeper.AccountKeeper, sk types.SupplyKeeper) DeductFeeDecorator {
+// NewDeductFeeDecorator returns a new DeductFeeDecorator. The feegrant keeper
+// may be nil, in which case transactions naming a fee granter are rejected.
+func NewDeductFeeDecorator(ak keeper.AccountKeeper, sk types.SupplyKeeper, fk types.FeegrantKeeper) DeductFeeDecorator {
 	return DeductFeeDecorator{
-		ak:           ak,
-		supplyKeeper: sk,
+		ak:             ak,
+		supplyKeeper:   sk,
+		feegrantKeeper: fk,
 	}
 }
 
@@ -94,16 +173,33 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
 	}
 
+	fee := feeTx.GetFee()
 	feePayer := feeTx.FeePayer()
-	feePayerAcc := dfd.ak.GetAccount(ctx, feePayer)
+	deductFeesFrom := feePayer
+
+	// if a fee granter was set, deduct the fee from the granter's account,
+	// provided the fee payer holds a fee allowance which covers it
+	if feeGranter := feeTx.FeeGranter(); !feeGranter.Empty() && !feeGranter.Equals(feePayer) {
+		if dfd.feegrantKeeper == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "fee grants are not enabled")
+		}
+
+		err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee)
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feePayer, feeGranter)
+		}
 
+		deductFeesFrom = feeGranter
+	}
+
+	feePayerAcc := dfd.ak.GetAccount(ctx, deductFeesFrom)
 	if feePayerAcc == nil {
-		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", feePayer)
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
 	}
 
 	// deduct the fees
-	if !feeTx.GetFee().IsZero() {
-		err = DeductFees(dfd.supplyKeeper, ctx, feePayerAcc, feeTx.GetFee())
+	if !fee.IsZero() {
+		err = DeductFees(dfd.supplyKeeper, ctx, feePayerAcc, fee)
 		if err != nil {
 			return ctx, err
 		}
@@ -112,6 +208,68 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	return next(ctx, tx, simulate)
 }
 
+// FeeMarketDecorator ensures the fee of the tx covers the base fee of the fee
+// market for its gas limit, where baseFee = ceil(baseFeePrice * gasLimit), in
+// any of the denominations of the base fee. The base portion of the fee, in the
+// first denomination it covers, is then burnt or left to be distributed as set
+// by the fee market.
+// CONTRACT: Tx must implement FeeTx interface and the fees must have been
+// deducted by the DeductFeeDecorator to use FeeMarketDecorator
+type FeeMarketDecorator struct {
+	feeMarketKeeper types.FeeMarketKeeper
+}
+
+// NewFeeMarketDecorator returns a new FeeMarketDecorator. The fee market keeper
+// may be nil, in which case no base fee is enforced.
+func NewFeeMarketDecorator(fmk types.FeeMarketKeeper) FeeMarketDecorator {
+	return FeeMarketDecorator{
+		feeMarketKeeper: fmk,
+	}
+}
+
+func (fmd FeeMarketDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if fmd.feeMarketKeeper == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	baseFee := fmd.feeMarketKeeper.GetBaseFee(ctx)
+	if baseFee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	fee := feeTx.GetFee()
+	glDec := sdk.NewIntFromUint64(feeTx.GetGas()).ToDec()
+
+	var basePortion sdk.Coins
+	for _, bf := range baseFee {
+		required := sdk.NewCoin(bf.Denom, bf.Amount.Mul(glDec).Ceil().RoundInt())
+		if fee.AmountOf(required.Denom).GTE(required.Amount) {
+			basePortion = sdk.NewCoins(required)
+			break
+		}
+	}
+
+	// the gas limit of simulated txs is not known yet, hence neither their base fee
+	if basePortion == nil {
+		if simulate {
+			return next(ctx, tx, simulate)
+		}
+
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s base fee: %s", fee, baseFee)
+	}
+
+	if err := fmd.feeMarketKeeper.HandleBaseFee(ctx, basePortion); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
 // DeductFees deducts fees from the given account.
 //
 // NOTE: We could use the BankKeeper (in addition to the AccountKeeper, because
@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,6 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 func TestEnsureMempoolFees(t *testing.T) {
@@ -60,6 +63,115 @@ func TestEnsureMempoolFees(t *testing.T) {
 	require.Nil(t, err, "Decorator should not have errored on fee higher than local gasPrice")
 }
 
+func TestEnsureMempoolFeesMsgMinGasPrices(t *testing.T) {
+	// setup
+	_, ctx := createTestApp(true)
+	ctx = ctx.WithIsCheckTx(true)
+
+	mfd := ante.NewMempoolFeeDecorator()
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	msg1 := types.NewTestMsg(addr1)
+	msgType := msg1.Route() + "/" + msg1.Type()
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, []sdk.Msg{msg1}, privs, accNums, seqs, types.NewTestStdFee())
+
+	lowGasPrice := sdk.DecCoins{sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3))}
+	highGasPrice := sdk.DecCoins{sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(2, 3))}
+
+	// the price of the message type overrides a higher validator price
+	ctx = ctx.WithMinGasPrices(highGasPrice).
+		WithMsgMinGasPrices(map[string]sdk.DecCoins{msgType: lowGasPrice})
+
+	_, err := antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// the price of the message type overrides a lower validator price
+	ctx = ctx.WithMinGasPrices(lowGasPrice).
+		WithMsgMinGasPrices(map[string]sdk.DecCoins{msgType: highGasPrice})
+
+	_, err = antehandler(ctx, tx, false)
+	require.Error(t, err)
+
+	// the validator price applies to the messages without a price of their own
+	ctx = ctx.WithMinGasPrices(highGasPrice).
+		WithMsgMinGasPrices(map[string]sdk.DecCoins{"bank/send": lowGasPrice})
+
+	_, err = antehandler(ctx, tx, false)
+	require.Error(t, err)
+
+	// message type prices are not enforced in DeliverTx
+	ctx = ctx.WithIsCheckTx(false).
+		WithMsgMinGasPrices(map[string]sdk.DecCoins{msgType: highGasPrice})
+
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+}
+
+func TestMempoolFeePriority(t *testing.T) {
+	// setup
+	_, ctx := createTestApp(true)
+	ctx = ctx.WithIsCheckTx(true)
+
+	mfd := ante.NewMempoolFeeDecorator()
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// the priority is the lowest fee per gas of the fee denominations
+	fee := types.NewStdFee(1000, sdk.NewCoins(sdk.NewInt64Coin("atom", 5000), sdk.NewInt64Coin("photon", 2500)))
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	newCtx, err := antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(2500000), newCtx.Priority())
+
+	// the denomination with the lowest fee per gas sets the priority whatever
+	// its position in the fee
+	fee = types.NewStdFee(1000, sdk.NewCoins(sdk.NewInt64Coin("atom", 1500), sdk.NewInt64Coin("photon", 2500)))
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	newCtx, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(1500000), newCtx.Priority())
+
+	// gas prices below one are told apart
+	fee = types.NewStdFee(1000, sdk.NewCoins(sdk.NewInt64Coin("atom", 250), sdk.NewInt64Coin("photon", 2500)))
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	newCtx, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(250000), newCtx.Priority())
+
+	// a zero-priced denomination is the lowest priority, and is not overridden
+	// by the denominations following it
+	fee = types.NewStdFee(1000, sdk.Coins{sdk.NewInt64Coin("atom", 0), sdk.NewInt64Coin("photon", 2500)})
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	newCtx, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Zero(t, newCtx.Priority())
+
+	// gas limits above math.MaxInt64 do not wrap to a negative priority
+	fee = types.NewStdFee(math.MaxUint64, sdk.NewCoins(sdk.NewInt64Coin("atom", 5000)))
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	newCtx, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Zero(t, newCtx.Priority())
+
+	// txs without fees have the lowest priority
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, types.NewStdFee(1000, sdk.Coins{}))
+
+	newCtx, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Zero(t, newCtx.Priority())
+}
+
 func TestDeductFees(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
@@ -81,7 +193,7 @@ func TestDeductFees(t *testing.T) {
 	acc.SetCoins([]sdk.Coin{sdk.NewCoin("atom", sdk.NewInt(10))})
 	app.AccountKeeper.SetAccount(ctx, acc)
 
-	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper)
+	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	_, err := antehandler(ctx, tx, false)
@@ -96,3 +208,117 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestFeeMarket(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(false)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	msg1 := types.NewTestMsg(addr1)
+
+	// the test fee is 150atom for 100000 gas
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, []sdk.Msg{msg1}, privs, accNums, seqs, types.NewTestStdFee())
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	acc.SetCoins([]sdk.Coin{sdk.NewCoin("atom", sdk.NewInt(1000))})
+	app.AccountKeeper.SetAccount(ctx, acc)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(acc.GetCoins()))
+
+	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper)
+	fmd := ante.NewFeeMarketDecorator(app.FeeMarketKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd, fmd)
+
+	feeCollector := func() sdk.Int {
+		return app.SupplyKeeper.GetModuleAccount(ctx, types.FeeCollectorName).GetCoins().AmountOf("atom")
+	}
+
+	// no base fee is required while the fee market is disabled
+	_, err := antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(150), feeCollector())
+
+	// the fee does not cover the base fee
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.DecCoins{sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(2, 3))})
+
+	_, err = antehandler(ctx, tx, false)
+	require.Error(t, err)
+
+	// the base fee is not enforced on simulations
+	_, err = antehandler(ctx, tx, true)
+	require.NoError(t, err)
+
+	// the base portion of the fee is burnt
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3)),
+		sdk.NewDecCoinFromDec("photon", sdk.NewDecWithPrec(1, 3)),
+	})
+	before := feeCollector()
+
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, before.AddRaw(50), feeCollector())
+
+	// gas limits above math.MaxInt64 do not wrap to a negative base fee
+	fee := types.NewStdFee(math.MaxUint64, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	tx = types.NewTestTx(ctx, []sdk.Msg{msg1}, privs, accNums, seqs, fee)
+
+	_, err = antehandler(ctx, tx, false)
+	require.Error(t, err)
+}
+
+func TestDeductFeesWithGrant(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	_, _, addr2 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msg1 := types.NewTestMsg(addr1)
+	fee := types.NewTestStdFee()
+	fee.Granter = addr2
+
+	msgs := []sdk.Msg{msg1}
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	// the grantee has no funds, the granter has sufficient funds
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	acc2 := app.AccountKeeper.NewAccountWithAddress(ctx, addr2)
+	acc2.SetCoins([]sdk.Coin{sdk.NewCoin("atom", sdk.NewInt(200))})
+	app.AccountKeeper.SetAccount(ctx, acc2)
+
+	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	_, err := antehandler(ctx, tx, false)
+	require.NotNil(t, err, "Tx did not error without a fee allowance")
+
+	// the decorator rejects fee granters if fee grants are disabled
+	_, err = sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper, nil))(ctx, tx, false)
+	require.NotNil(t, err, "Tx did not error with fee grants disabled")
+
+	// grant an allowance which does not cover the fee
+	allowance := feegrant.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), feegrant.ExpiresAt{})
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, feegrant.NewFeeAllowanceGrant(addr2, addr1, allowance))
+
+	_, err = antehandler(ctx, tx, false)
+	require.NotNil(t, err, "Tx did not error when the fee exceeded the allowance")
+
+	// grant an allowance which covers the fee
+	allowance = feegrant.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 500)), feegrant.ExpiresAt{})
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, feegrant.NewFeeAllowanceGrant(addr2, addr1, allowance))
+
+	_, err = antehandler(ctx, tx, false)
+	require.Nil(t, err, "Tx errored with a sufficient fee allowance")
+
+	require.True(t, app.AccountKeeper.GetAccount(ctx, addr1).GetCoins().Empty())
+	require.Equal(t, int64(50), app.AccountKeeper.GetAccount(ctx, addr2).GetCoins().AmountOf("atom").Int64())
+
+	remaining := app.FeeGrantKeeper.GetFeeAllowance(ctx, addr2, addr1).(*feegrant.BasicFeeAllowance)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 350)), remaining.SpendLimit)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -16,3 +17,8 @@ func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 
 	return app, ctx
 }
+
+// returns the default sign mode handler for the transactions of the app
+func defaultSignModeHandler(app *simapp.SimApp) signing.SignModeHandler {
+	return signing.DefaultSignModeHandler(authtypes.DefaultTxEncoder(app.Codec()))
+}
@@ -14,6 +14,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -43,6 +44,7 @@ type SigVerifiableTx interface {
 	GetSigners() []sdk.AccAddress
 	GetPubKeys() []crypto.PubKey // If signer already has pubkey in context, this list will have nil in its place
 	GetSignBytes(ctx sdk.Context, acc exported.Account) []byte
+	GetSignModes() []types.SignMode
 }
 
 // SetPubKeyDecorator sets PubKeys in context for any signer which does not already have pubkey set
@@ -154,17 +156,21 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 }
 
 // Verify all signatures for a tx and return an error if any are invalid. Note,
-// the SigVerificationDecorator decorator will not get executed on ReCheck.
+// the SigVerificationDecorator decorator will not get executed on ReCheck. The
+// bytes each signature is verified over are given by the sign mode handler for
+// the sign mode of the signature.
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
-	ak keeper.AccountKeeper
+	ak              keeper.AccountKeeper
+	signModeHandler signing.SignModeHandler
 }
 
-func NewSigVerificationDecorator(ak keeper.AccountKeeper) SigVerificationDecorator {
+func NewSigVerificationDecorator(ak keeper.AccountKeeper, signModeHandler signing.SignModeHandler) SigVerificationDecorator {
 	return SigVerificationDecorator{
-		ak: ak,
+		ak:              ak,
+		signModeHandler: signModeHandler,
 	}
 }
 
@@ -181,6 +187,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	// stdSigs contains the sequence number, account number, and signatures.
 	// When simulating, this would just be a 0-length slice.
 	sigs := sigTx.GetSignatures()
+	signModes := sigTx.GetSignModes()
 
 	// stdSigs contains the sequence number, account number, and signatures.
 	// When simulating, this would just be a 0-length slice.
@@ -198,8 +205,19 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, err
 		}
 
-		// retrieve signBytes of tx
-		signBytes := sigTx.GetSignBytes(ctx, signerAccs[i])
+		// retrieve signBytes of tx in the sign mode of the signature
+		signerData := signing.SignerData{
+			ChainID:  ctx.ChainID(),
+			Sequence: signerAccs[i].GetSequence(),
+		}
+		if ctx.BlockHeight() != 0 {
+			signerData.AccountNumber = signerAccs[i].GetAccountNumber()
+		}
+
+		signBytes, err := svd.signModeHandler.GetSignBytes(signModes[i], signerData, tx)
+		if err != nil {
+			return ctx, err
+		}
 
 		// retrieve pubkey
 		pubKey := signerAccs[i].GetPubKey()
@@ -10,8 +10,10 @@ import (
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -124,7 +126,7 @@ func TestSigVerification(t *testing.T) {
 	fee := types.NewTestStdFee()
 
 	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
-	svd := ante.NewSigVerificationDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper, defaultSignModeHandler(app))
 	antehandler := sdk.ChainAnteDecorators(spkd, svd)
 
 	type testCase struct {
@@ -158,6 +160,67 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+func TestSigVerificationSignModes(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1).WithChainID("mychainid")
+
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/TestMsg", nil)
+	txEncoder := types.DefaultTxEncoder(cdc)
+
+	priv, _, addr := types.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetAccountNumber(3))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	signMsg := types.StdSignMsg{
+		ChainID:       ctx.ChainID(),
+		AccountNumber: 3,
+		Fee:           types.NewTestStdFee(),
+		Msgs:          []sdk.Msg{types.NewTestMsg(addr)},
+		Memo:          "memo",
+	}
+
+	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper, signing.DefaultSignModeHandler(txEncoder))
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	testCases := []struct {
+		name      string
+		signMode  types.SignMode
+		sigMode   types.SignMode
+		shouldErr bool
+	}{
+		{"unspecified sign mode", types.SignModeUnspecified, types.SignModeUnspecified, false},
+		{"legacy amino json sign mode", types.SignModeLegacyAminoJSON, types.SignModeLegacyAminoJSON, false},
+		{"direct sign mode", types.SignModeDirect, types.SignModeDirect, false},
+		{"unspecified sign mode of direct signature", types.SignModeDirect, types.SignModeUnspecified, true},
+		{"direct sign mode of amino json signature", types.SignModeLegacyAminoJSON, types.SignModeDirect, true},
+		{"unsupported textual sign mode", types.SignModeLegacyAminoJSON, types.SignModeTextual, true},
+	}
+	for _, tc := range testCases {
+		signBytes, err := signMsg.SignBytes(tc.signMode, txEncoder)
+		require.NoError(t, err, tc.name)
+
+		sig, err := priv.Sign(signBytes)
+		require.NoError(t, err, tc.name)
+
+		stdSig := types.StdSignature{PubKey: priv.PubKey(), Signature: sig, SignMode: tc.sigMode}
+		tx := types.NewStdTx(signMsg.Msgs, signMsg.Fee, []types.StdSignature{stdSig}, signMsg.Memo)
+
+		_, err = antehandler(ctx, tx, false)
+		if tc.shouldErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
@@ -201,7 +264,7 @@ func runSigDecorators(t *testing.T, params types.Params, multisig bool, privs ..
 
 	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
 	svgc := ante.NewSigGasConsumeDecorator(app.AccountKeeper, ante.DefaultSigVerificationGasConsumer)
-	svd := ante.NewSigVerificationDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper, defaultSignModeHandler(app))
 	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
 
 	// Determine gas consumption of antehandler with default params
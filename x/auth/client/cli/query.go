@@ -35,7 +35,10 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(GetAccountCmd(cdc))
+	cmd.AddCommand(
+		GetAccountCmd(cdc),
+		GetTombstoneCmd(cdc),
+	)
 
 	return cmd
 }
@@ -68,6 +71,41 @@ func GetAccountCmd(cdc *codec.Codec) *cobra.Command {
 	return flags.GetCommands(cmd)[0]
 }
 
+// GetTombstoneCmd returns a query tombstone command that will display the
+// tombstone left by the pruned account at a given address.
+func GetTombstoneCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tombstone [address]",
+		Short: "Query the tombstone of a pruned account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTombstoneParams(addr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTombstone)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var tombstone types.Tombstone
+			cdc.MustUnmarshalJSON(res, &tombstone)
+			return cliCtx.PrintOutput(tombstone)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
 // QueryTxsByEventsCmd returns a command to search through transactions by events.
 func QueryTxsByEventsCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
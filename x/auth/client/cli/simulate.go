@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+)
+
+// GetSimulateCommand returns the tx simulate command.
+func GetSimulateCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate [file]",
+		Short: "Simulate a transaction generated offline",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate the execution of a transaction created with the --generate-only
+flag, which does not need to be signed. Read a transaction from [file] and print
+the result of its execution against the latest state of the node, along with the
+logs of its messages and the state changes it would make, i.e. the keys it would
+write to each store with their values before and after the transaction.
+
+Example:
+$ %s tx simulate ./mytxn.json
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			stdTx, err := utils.ReadStdTxFromFile(cliCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := utils.SimulateStdTx(cliCtx, stdTx)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(res)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
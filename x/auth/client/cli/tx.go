@@ -20,6 +20,9 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		GetMultiSignCommand(cdc),
 		GetSignCommand(cdc),
+		GetComposeCommand(cdc),
+		GetAppendMsgCommand(cdc),
+		GetSimulateCommand(cdc),
 	)
 	return txCmd
 }
@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetComposeCommand returns the transaction compose command.
+func GetComposeCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compose [file] [file]...",
+		Short: "Compose a transaction from the messages of transactions generated offline",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Merge the messages of transactions created with the --generate-only flag,
+possibly by commands of different modules, into a single unsigned transaction.
+
+The signers of the composed transaction are the union of the signers of its messages,
+in order of first appearance, and each of them must sign it in that order, e.g. with
+the 'sign' command. The signers that have yet to sign are printed to STDERR.
+
+The fee and gas of the composed transaction are the sums of the fees and gas of the
+given transactions, and its memo is the memo they share, unless the --fees, --gas-prices,
+--gas or --memo flags are set.
+
+Example:
+$ %s tx compose send.json delegate.json > composed.json
+`,
+				version.ClientName,
+			),
+		),
+		RunE: makeComposeCmd(cdc),
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// GetAppendMsgCommand returns the transaction append-msg command.
+func GetAppendMsgCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "append-msg [file] [msg-file]...",
+		Short: "Append the messages of transactions generated offline to a transaction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Append the messages of the transactions read from [msg-file](s), created with
+the --generate-only flag, to the transaction read from [file].
+
+The fee and memo of the transaction read from [file] are kept, unless the --fees,
+--gas-prices, --gas or --memo flags are set. Its signatures are dropped, as they do not
+sign over the appended messages, and the signers that have yet to sign are printed to
+STDERR.
+
+Example:
+$ %s tx append-msg composed.json vote.json > composed-vote.json
+`,
+				version.ClientName,
+			),
+		),
+		RunE: makeAppendMsgCmd(cdc),
+		Args: cobra.MinimumNArgs(2),
+	}
+
+	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func makeComposeCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		txs, err := readStdTxsFromFiles(cdc, args)
+		if err != nil {
+			return err
+		}
+
+		txBldr, err := newComposeTxBuilder(cmd)
+		if err != nil {
+			return err
+		}
+
+		stdTx, err := txBldr.ComposeStdTx(txs...)
+		if err != nil {
+			return err
+		}
+
+		return printComposedStdTx(cmd, cdc, stdTx)
+	}
+}
+
+func makeAppendMsgCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		txs, err := readStdTxsFromFiles(cdc, args)
+		if err != nil {
+			return err
+		}
+
+		txBldr, err := newComposeTxBuilder(cmd)
+		if err != nil {
+			return err
+		}
+
+		var msgs []sdk.Msg
+		for _, tx := range txs[1:] {
+			msgs = append(msgs, tx.GetMsgs()...)
+		}
+
+		stdTx, err := txBldr.AppendMsgs(txs[0], msgs...)
+		if err != nil {
+			return err
+		}
+
+		return printComposedStdTx(cmd, cdc, stdTx)
+	}
+}
+
+// newComposeTxBuilder returns the TxBuilder of the compose commands. Its gas is
+// only set if the --gas flag was provided, as the gas of the composed
+// transaction is derived from the composed transactions otherwise.
+func newComposeTxBuilder(cmd *cobra.Command) (types.TxBuilder, error) {
+	txBldr := types.NewTxBuilderFromCLI(bufio.NewReader(cmd.InOrStdin()))
+	if !cmd.Flags().Changed("gas") {
+		txBldr = txBldr.WithGas(0)
+	}
+
+	if flags.GasFlagVar.Simulate {
+		return txBldr, fmt.Errorf("--gas=%s is not supported when composing transactions", flags.GasFlagAuto)
+	}
+
+	return txBldr, nil
+}
+
+func readStdTxsFromFiles(cdc *codec.Codec, filenames []string) ([]types.StdTx, error) {
+	txs := make([]types.StdTx, len(filenames))
+	for i, filename := range filenames {
+		stdTx, err := utils.ReadStdTxFromFile(cdc, filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read transaction from %s: %w", filename, err)
+		}
+
+		txs[i] = stdTx
+	}
+
+	return txs, nil
+}
+
+// printComposedStdTx prints the JSON encoding of a composed StdTx and writes
+// its missing signers to STDERR.
+func printComposedStdTx(cmd *cobra.Command, cdc *codec.Codec, stdTx types.StdTx) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	json, err := getSignatureJSON(cdc, stdTx, cliCtx.Indent, false)
+	if err != nil {
+		return err
+	}
+
+	printMissingSigners(cmd.ErrOrStderr(), stdTx)
+
+	if viper.GetString(flagOutfile) == "" {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s\n", json)
+		return nil
+	}
+
+	fp, err := os.OpenFile(
+		viper.GetString(flagOutfile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644,
+	)
+	if err != nil {
+		return err
+	}
+
+	defer fp.Close()
+	fmt.Fprintf(fp, "%s\n", json)
+
+	return nil
+}
+
+// printMissingSigners writes the signers of the StdTx, in signing order, and
+// whether their signature is still missing.
+func printMissingSigners(w io.Writer, stdTx types.StdTx) {
+	missing := make(map[string]bool)
+	for _, signer := range stdTx.GetMissingSigners() {
+		missing[signer.String()] = true
+	}
+
+	fmt.Fprintln(w, "Signers:")
+	for i, signer := range stdTx.GetSigners() {
+		status := "signed"
+		if missing[signer.String()] {
+			status = "missing"
+		}
+
+		fmt.Fprintf(w, "  %d: %s (%s)\n", i, signer, status)
+	}
+}
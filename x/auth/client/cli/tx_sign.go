@@ -52,6 +52,10 @@ the transaction to fail.
 The --multisig=<multisig_key> flag generates a signature on behalf of a multisig account
 key. It implies --signature-only. Full multisig signed transactions may eventually
 be generated via the 'multisign' command.
+
+The --sign-mode flag selects the bytes that are signed: 'amino-json' signs the legacy
+canonical JSON sign document, while 'direct' signs the binary encoded transaction body
+and fee. The legacy Amino JSON sign mode is used by default.
 `,
 		PreRun: preSignCmd,
 		RunE:   makeSignCmd(codec),
@@ -102,10 +106,10 @@ func makeSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error
 		inBuf := bufio.NewReader(cmd.InOrStdin())
 		offline := viper.GetBool(flagOffline)
 		cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
-		txBldr := types.NewTxBuilderFromCLI(inBuf)
+		txBldr := types.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
 		if viper.GetBool(flagValidateSigs) {
-			if !printAndValidateSigs(cliCtx, txBldr.ChainID(), stdTx, offline) {
+			if !printAndValidateSigs(cliCtx, txBldr, stdTx, offline) {
 				return fmt.Errorf("signatures validation failed")
 			}
 
@@ -187,7 +191,7 @@ func getSignatureJSON(cdc *codec.Codec, newTx types.StdTx, indent, generateSigna
 // its expected signers. In addition, if offline has not been supplied, the
 // signature is verified over the transaction sign bytes.
 func printAndValidateSigs(
-	cliCtx context.CLIContext, chainID string, stdTx types.StdTx, offline bool,
+	cliCtx context.CLIContext, txBldr types.TxBuilder, stdTx types.StdTx, offline bool,
 ) bool {
 
 	fmt.Println("Signers:")
@@ -230,10 +234,13 @@ func printAndValidateSigs(
 				return false
 			}
 
-			sigBytes := types.StdSignBytes(
-				chainID, acc.GetAccountNumber(), acc.GetSequence(),
-				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
-			)
+			signMsg := utils.StdSignMsgFromTx(txBldr.ChainID(), acc.GetAccountNumber(), acc.GetSequence(), stdTx)
+
+			sigBytes, err := signMsg.SignBytes(sig.SignMode, txBldr.TxEncoder())
+			if err != nil {
+				fmt.Printf("failed to get sign bytes: %s\n", err)
+				return false
+			}
 
 			if ok := sig.VerifyBytes(sigBytes, sig.Signature); !ok {
 				sigSanity = "ERROR: signature invalid"
@@ -11,6 +11,9 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 	r.HandleFunc(
 		"/auth/accounts/{address}", QueryAccountRequestHandlerFn(storeName, cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/auth/tombstones/{address}", QueryTombstoneRequestHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 // RegisterTxRoutes registers all transaction routes on the provided router.
@@ -20,4 +23,5 @@ func RegisterTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/txs", BroadcastTxRequest(cliCtx)).Methods("POST")
 	r.HandleFunc("/txs/encode", EncodeTxRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/txs/decode", DecodeTxRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/txs/simulate", SimulateTxRequestHandlerFn(cliCtx)).Methods("POST")
 }
@@ -27,7 +27,7 @@ func WriteGenerateStdTxResponse(w http.ResponseWriter, cliCtx context.CLIContext
 	txBldr := types.NewTxBuilder(
 		GetTxEncoder(cliCtx.Codec), br.AccountNumber, br.Sequence, gas, gasAdj,
 		br.Simulate, br.ChainID, br.Memo, br.Fees, br.GasPrices,
-	)
+	).WithTimeoutHeight(br.TimeoutHeight)
 
 	if br.Simulate || simAndExec {
 		if gasAdj < 0 {
@@ -53,7 +53,10 @@ func WriteGenerateStdTxResponse(w http.ResponseWriter, cliCtx context.CLIContext
 		return
 	}
 
-	output, err := cliCtx.Codec.MarshalJSON(types.NewStdTx(stdMsg.Msgs, stdMsg.Fee, nil, stdMsg.Memo))
+	stdTx := types.NewStdTx(stdMsg.Msgs, stdMsg.Fee, nil, stdMsg.Memo)
+	stdTx.TimeoutHeight = stdMsg.TimeoutHeight
+
+	output, err := cliCtx.Codec.MarshalJSON(stdTx)
 	if err != nil {
 		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -53,7 +53,11 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 
 	fromName := cliCtx.GetFromName()
 
-	if txBldr.SimulateAndExecute() || cliCtx.Simulate {
+	if cliCtx.Simulate {
+		return simulateAndPrint(txBldr, cliCtx, msgs)
+	}
+
+	if txBldr.SimulateAndExecute() {
 		txBldr, err = EnrichWithGas(txBldr, cliCtx, msgs)
 		if err != nil {
 			return err
@@ -63,10 +67,6 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", gasEst.String())
 	}
 
-	if cliCtx.Simulate {
-		return nil
-	}
-
 	if !cliCtx.SkipConfirm {
 		stdSignMsg, err := txBldr.BuildSignMsg(msgs)
 		if err != nil {
@@ -142,6 +142,36 @@ func CalculateGas(
 	return estimate, adjusted, nil
 }
 
+// SimulateTx simulates the execution of a transaction and returns its result
+// along with the logs of its messages and the state changes it would make.
+func SimulateTx(
+	queryFunc func(string, []byte) ([]byte, int64, error), cdc *codec.Codec, txBytes []byte,
+) (sdk.SimulationResponse, error) {
+
+	rawRes, _, err := queryFunc("/app/simulate_tx", txBytes)
+	if err != nil {
+		return sdk.SimulationResponse{}, err
+	}
+
+	return parseSimulationResponse(cdc, rawRes)
+}
+
+// SimulateStdTx simulates the execution of a transaction generated offline.
+// The signatures it is missing are replaced by empty signatures, which are not
+// verified in simulation mode.
+func SimulateStdTx(cliCtx context.CLIContext, stdTx authtypes.StdTx) (sdk.SimulationResponse, error) {
+	sigs := make([]authtypes.StdSignature, len(stdTx.GetSigners()))
+	copy(sigs, stdTx.Signatures)
+	stdTx.Signatures = sigs
+
+	txBytes, err := GetTxEncoder(cliCtx.Codec)(stdTx)
+	if err != nil {
+		return sdk.SimulationResponse{}, err
+	}
+
+	return SimulateTx(cliCtx.QueryWithData, cliCtx.Codec, txBytes)
+}
+
 // PrintUnsignedStdTx builds an unsigned StdTx and prints it to os.Stdout.
 func PrintUnsignedStdTx(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) error {
 	stdTx, err := buildUnsignedStdTxOffline(txBldr, cliCtx, msgs)
@@ -238,6 +268,20 @@ func ReadStdTxFromFile(cdc *codec.Codec, filename string) (stdTx authtypes.StdTx
 	return
 }
 
+// StdSignMsgFromTx returns the message a signer with the given account number
+// and sequence signed for the given transaction.
+func StdSignMsgFromTx(chainID string, accNum, sequence uint64, stdTx authtypes.StdTx) authtypes.StdSignMsg {
+	return authtypes.StdSignMsg{
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      sequence,
+		Fee:           stdTx.Fee,
+		Msgs:          stdTx.GetMsgs(),
+		Memo:          stdTx.GetMemo(),
+		TimeoutHeight: stdTx.TimeoutHeight,
+	}
+}
+
 func populateAccountFromState(
 	txBldr authtypes.TxBuilder, cliCtx context.CLIContext, addr sdk.AccAddress,
 ) (authtypes.TxBuilder, error) {
@@ -273,6 +317,25 @@ func simulateMsgs(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []
 	return
 }
 
+// simulateAndPrint simulates the transaction, writes its adjusted gas estimate
+// to STDERR and prints its simulation response.
+func simulateAndPrint(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) error {
+	txBytes, err := txBldr.BuildTxForSim(msgs)
+	if err != nil {
+		return err
+	}
+
+	simRes, err := SimulateTx(cliCtx.QueryWithData, cliCtx.Codec, txBytes)
+	if err != nil {
+		return err
+	}
+
+	gasEst := GasEstimateResponse{GasEstimate: adjustGasEstimate(simRes.Result.GasUsed, txBldr.GasAdjustment())}
+	_, _ = fmt.Fprintf(os.Stderr, "%s\n", gasEst.String())
+
+	return cliCtx.PrintOutput(simRes)
+}
+
 func adjustGasEstimate(estimate uint64, adjustment float64) uint64 {
 	return uint64(adjustment * float64(estimate))
 }
@@ -286,6 +349,15 @@ func parseQueryResponse(cdc *codec.Codec, rawRes []byte) (uint64, error) {
 	return simulationResult.GasUsed, nil
 }
 
+func parseSimulationResponse(cdc *codec.Codec, rawRes []byte) (sdk.SimulationResponse, error) {
+	var simRes sdk.SimulationResponse
+	if err := cdc.UnmarshalBinaryLengthPrefixed(rawRes, &simRes); err != nil {
+		return sdk.SimulationResponse{}, err
+	}
+
+	return simRes, nil
+}
+
 // PrepareTxBuilder populates a TxBuilder in preparation for the build of a Tx.
 func PrepareTxBuilder(txBldr authtypes.TxBuilder, cliCtx context.CLIContext) (authtypes.TxBuilder, error) {
 	from := cliCtx.GetFromAddress()
@@ -334,7 +406,10 @@ func buildUnsignedStdTxOffline(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 		return stdTx, err
 	}
 
-	return authtypes.NewStdTx(stdSignMsg.Msgs, stdSignMsg.Fee, nil, stdSignMsg.Memo), nil
+	stdTx = authtypes.NewStdTx(stdSignMsg.Msgs, stdSignMsg.Fee, nil, stdSignMsg.Memo)
+	stdTx.TimeoutHeight = stdSignMsg.TimeoutHeight
+
+	return stdTx, nil
 }
 
 func isTxSigner(user sdk.AccAddress, signers []sdk.AccAddress) bool {
@@ -69,6 +69,32 @@ func TestCalculateGas(t *testing.T) {
 	}
 }
 
+func TestSimulateTx(t *testing.T) {
+	cdc := makeCodec()
+	simRes := sdk.SimulationResponse{
+		Result: sdk.Result{GasUsed: 10},
+		StateChanges: []sdk.StateChange{
+			{StoreKey: "acc", Key: []byte("key"), Before: []byte("before"), After: []byte("after")},
+		},
+	}
+
+	queryFunc := func(path string, _ []byte) ([]byte, int64, error) {
+		require.Equal(t, "/app/simulate_tx", path)
+		return cdc.MustMarshalBinaryLengthPrefixed(simRes), 0, nil
+	}
+
+	res, err := SimulateTx(queryFunc, cdc, []byte(""))
+	require.NoError(t, err)
+	require.Equal(t, simRes, res)
+
+	queryFunc = func(string, []byte) ([]byte, int64, error) {
+		return nil, 0, errors.New("")
+	}
+
+	_, err = SimulateTx(queryFunc, cdc, []byte(""))
+	require.Error(t, err)
+}
+
 func TestDefaultTxEncoder(t *testing.T) {
 	cdc := makeCodec()
 
@@ -141,3 +167,31 @@ func makeCodec() *codec.Codec {
 	cdc.RegisterConcrete(sdk.TestMsg{}, "cosmos-sdk/Test", nil)
 	return cdc
 }
+
+func TestStdSignMsgFromTx(t *testing.T) {
+	fee := authtypes.NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
+	signMsg := authtypes.StdSignMsg{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+		Fee:           fee,
+		Msgs:          msgs,
+		Memo:          "memo",
+		TimeoutHeight: 100,
+	}
+
+	sig, err := priv.Sign(signMsg.Bytes())
+	require.NoError(t, err)
+	stdTx := authtypes.NewStdTx(msgs, fee, []authtypes.StdSignature{{PubKey: priv.PubKey(), Signature: sig}}, "memo")
+	stdTx.TimeoutHeight = 100
+
+	got := StdSignMsgFromTx("test-chain", 1, 2, stdTx)
+	require.Equal(t, signMsg, got)
+	require.True(t, priv.PubKey().VerifyBytes(got.Bytes(), stdTx.Signatures[0].Signature))
+
+	// the signature does not cover a different timeout height
+	stdTx.TimeoutHeight = 0
+	got = StdSignMsgFromTx("test-chain", 1, 2, stdTx)
+	require.False(t, priv.PubKey().VerifyBytes(got.Bytes(), stdTx.Signatures[0].Signature))
+}
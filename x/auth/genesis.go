@@ -17,6 +17,21 @@ func InitGenesis(ctx sdk.Context, ak AccountKeeper, data GenesisState) {
 		acc := ak.NewAccount(ctx, a)
 		ak.SetAccount(ctx, acc)
 	}
+
+	for _, tombstone := range data.Tombstones {
+		ak.SetTombstone(ctx, tombstone)
+	}
+
+	// Keep counting the inactivity of the accounts from their last activity,
+	// which cannot be after the genesis height when the chain is restarted at
+	// a lower height.
+	for _, activity := range data.AccountActivities {
+		height := activity.Height
+		if height > ctx.BlockHeight() {
+			height = ctx.BlockHeight()
+		}
+		ak.SetLastActivity(ctx, activity.Address, height)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -30,5 +45,12 @@ func ExportGenesis(ctx sdk.Context, ak AccountKeeper) GenesisState {
 		return false
 	})
 
-	return NewGenesisState(params, genAccounts)
+	genState := NewGenesisState(params, genAccounts)
+	genState.Tombstones = ak.GetAllTombstones(ctx)
+	ak.IterateLastActivities(ctx, func(addr sdk.AccAddress, height int64) bool {
+		genState.AccountActivities = append(genState.AccountActivities, NewAccountActivity(addr, height))
+		return false
+	})
+
+	return genState
 }
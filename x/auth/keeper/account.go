@@ -16,11 +16,23 @@ func (ak AccountKeeper) NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddre
 	return ak.NewAccount(ctx, acc)
 }
 
-// NewAccount sets the next account number to a given account interface
+// NewAccount sets the next account number to a given account interface. If
+// the account was pruned before, its sequence is restored from its tombstone so
+// that previously signed transactions cannot be replayed.
 func (ak AccountKeeper) NewAccount(ctx sdk.Context, acc exported.Account) exported.Account {
 	if err := acc.SetAccountNumber(ak.GetNextAccountNumber(ctx)); err != nil {
 		panic(err)
 	}
+
+	if tombstone, found := ak.GetTombstone(ctx, acc.GetAddress()); found {
+		if acc.GetSequence() < tombstone.Sequence {
+			if err := acc.SetSequence(tombstone.Sequence); err != nil {
+				panic(err)
+			}
+		}
+		ak.RemoveTombstone(ctx, acc.GetAddress())
+	}
+
 	return acc
 }
 
@@ -45,15 +57,17 @@ func (ak AccountKeeper) GetAllAccounts(ctx sdk.Context) (accounts []exported.Acc
 	return accounts
 }
 
-// SetAccount implements sdk.AccountKeeper.
+// SetAccount implements sdk.AccountKeeper. The account activity is only
+// recorded while account pruning is enabled.
 func (ak AccountKeeper) SetAccount(ctx sdk.Context, acc exported.Account) {
 	addr := acc.GetAddress()
 	store := ctx.KVStore(ak.key)
-	bz, err := ak.cdc.MarshalBinaryBare(acc)
-	if err != nil {
-		panic(err)
-	}
+	bz := ak.encodeAccount(acc)
 	store.Set(types.AddressStoreKey(addr), bz)
+
+	if ak.AccountPruningBlocks(ctx) != 0 {
+		ak.SetLastActivity(ctx, addr, ctx.BlockHeight())
+	}
 }
 
 // RemoveAccount removes an account for the account mapper store.
@@ -62,6 +76,7 @@ func (ak AccountKeeper) RemoveAccount(ctx sdk.Context, acc exported.Account) {
 	addr := acc.GetAddress()
 	store := ctx.KVStore(ak.key)
 	store.Delete(types.AddressStoreKey(addr))
+	ak.removeLastActivity(ctx, addr)
 }
 
 // IterateAccounts iterates over all the stored accounts and performs a callback function
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// queryServer implements the auth gRPC query service on top of an
+// AccountKeeper.
+type queryServer struct {
+	keeper AccountKeeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the auth gRPC query service
+// backed by the given keeper.
+func NewQueryServer(k AccountKeeper) types.QueryServer {
+	return queryServer{keeper: k}
+}
+
+// Account implements the Query/Account gRPC method.
+func (q queryServer) Account(goCtx context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	acc := q.keeper.GetAccount(ctx, req.Address)
+	if acc == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+
+	msg, ok := acc.(proto.Message)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "account %T is not a protobuf message", acc)
+	}
+
+	any, err := codec.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountResponse{Account: any}, nil
+}
+
+// Tombstone implements the Query/Tombstone gRPC method.
+func (q queryServer) Tombstone(goCtx context.Context, req *types.QueryTombstoneRequest) (*types.QueryTombstoneResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	tombstone, found := q.keeper.GetTombstone(ctx, req.Address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "tombstone %s not found", req.Address)
+	}
+
+	return &types.QueryTombstoneResponse{Tombstone: tombstone}, nil
+}
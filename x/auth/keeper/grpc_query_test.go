@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	keep "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestGRPCQueryAccount(t *testing.T) {
+	app, ctx := createTestApp(true)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keep.NewQueryServer(app.AccountKeeper)
+
+	_, _, addr := types.KeyTestPubAddr()
+	_, _, unknownAddr := types.KeyTestPubAddr()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryAccountRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"empty address", &types.QueryAccountRequest{}, codes.InvalidArgument},
+		{"unknown account", &types.QueryAccountRequest{Address: unknownAddr}, codes.NotFound},
+		{"existing account", &types.QueryAccountRequest{Address: addr}, codes.OK},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.Account(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode != codes.OK {
+			require.Nil(t, res, tc.msg)
+			continue
+		}
+
+		msg, err := codec.UnpackAny(res.Account)
+		require.NoError(t, err, tc.msg)
+		acc, ok := msg.(exported.Account)
+		require.True(t, ok, tc.msg)
+		require.Equal(t, addr, acc.GetAddress(), tc.msg)
+	}
+}
+
+func TestGRPCQueryTombstone(t *testing.T) {
+	app, ctx := createTestApp(true)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keep.NewQueryServer(app.AccountKeeper)
+
+	_, _, addr := types.KeyTestPubAddr()
+	_, _, unknownAddr := types.KeyTestPubAddr()
+	tombstone := types.NewTombstone(addr, 5, 3, 10)
+	app.AccountKeeper.SetTombstone(ctx, tombstone)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryTombstoneRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"empty address", &types.QueryTombstoneRequest{}, codes.InvalidArgument},
+		{"no tombstone", &types.QueryTombstoneRequest{Address: unknownAddr}, codes.NotFound},
+		{"existing tombstone", &types.QueryTombstoneRequest{Address: addr}, codes.OK},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.Tombstone(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode != codes.OK {
+			require.Nil(t, res, tc.msg)
+			continue
+		}
+
+		require.Equal(t, tombstone, res.Tombstone, tc.msg)
+	}
+}
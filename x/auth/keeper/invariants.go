@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterInvariants registers the auth module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, ak AccountKeeper) {
+	ir.RegisterRoute(types.ModuleName, "tombstones", TombstonesInvariant(ak))
+	ir.RegisterRoute(types.ModuleName, "inactive-account-queue", InactiveAccountQueueInvariant(ak))
+}
+
+// TombstonesInvariant checks that no existing account has a tombstone, which
+// is removed as soon as a pruned account is created again.
+func TombstonesInvariant(ak AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		ak.IterateTombstones(ctx, func(tombstone types.Tombstone) bool {
+			if ak.GetAccount(ctx, tombstone.Address) != nil {
+				count++
+				msg += fmt.Sprintf("\taccount %s exists but has a tombstone\n", tombstone.Address)
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "tombstones",
+			fmt.Sprintf("amount of tombstoned existing accounts found %d\n%s", count, msg)), broken
+	}
+}
+
+// InactiveAccountQueueInvariant checks that every entry of the inactive
+// account queue matches the last activity of its account.
+func InactiveAccountQueueInvariant(ak AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		ak.IterateInactiveAccountQueue(ctx, ctx.BlockHeight(), func(height int64, addr sdk.AccAddress) bool {
+			lastActivity, found := ak.GetLastActivity(ctx, addr)
+			if !found || lastActivity != height {
+				count++
+				msg += fmt.Sprintf("\taccount %s is queued at height %d but was last active at height %d\n",
+					addr, height, lastActivity)
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "inactive-account-queue",
+			fmt.Sprintf("amount of mismatching queue entries found %d\n%s", count, msg)), broken
+	}
+}
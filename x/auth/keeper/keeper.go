@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -13,8 +15,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 )
 
-// AccountKeeper encodes/decodes accounts using the go-amino (binary)
-// encoding/decoding library.
+// AccountKeeper encodes/decodes accounts using the provided Marshaler. Accounts
+// are packed into a protobuf Any so that any registered concrete account type
+// can be stored and decoded.
 type AccountKeeper struct {
 	// The (unexposed) key used to access the store from the Context.
 	key sdk.StoreKey
@@ -22,17 +25,17 @@ type AccountKeeper struct {
 	// The prototypical Account constructor.
 	proto func() exported.Account
 
-	// The codec codec for binary encoding/decoding of accounts.
-	cdc *codec.Codec
+	// The codec for binary encoding/decoding of accounts.
+	cdc codec.Marshaler
 
 	paramSubspace subspace.Subspace
 }
 
-// NewAccountKeeper returns a new sdk.AccountKeeper that uses go-amino to
-// (binary) encode and decode concrete sdk.Accounts.
+// NewAccountKeeper returns a new sdk.AccountKeeper that uses the given
+// Marshaler to (binary) encode and decode concrete sdk.Accounts.
 // nolint
 func NewAccountKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, proto func() exported.Account,
+	cdc codec.Marshaler, key sdk.StoreKey, paramstore subspace.Subspace, proto func() exported.Account,
 ) AccountKeeper {
 
 	return AccountKeeper{
@@ -76,25 +79,63 @@ func (ak AccountKeeper) GetNextAccountNumber(ctx sdk.Context) uint64 {
 		// initialize the account numbers
 		accNumber = 0
 	} else {
-		err := ak.cdc.UnmarshalBinaryLengthPrefixed(bz, &accNumber)
+		val := gogotypes.UInt64Value{}
+
+		err := ak.cdc.UnmarshalBinaryLengthPrefixed(bz, &val)
 		if err != nil {
 			panic(err)
 		}
+
+		accNumber = val.GetValue()
 	}
 
-	bz = ak.cdc.MustMarshalBinaryLengthPrefixed(accNumber + 1)
+	bz = ak.cdc.MustMarshalBinaryLengthPrefixed(&gogotypes.UInt64Value{Value: accNumber + 1})
 	store.Set(types.GlobalAccountNumberKey, bz)
 
 	return accNumber
 }
 
+// PeekNextAccountNumber returns the account number that will be assigned to the
+// next account created, without incrementing it.
+func (ak AccountKeeper) PeekNextAccountNumber(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(ak.key).Get(types.GlobalAccountNumberKey)
+	if bz == nil {
+		return 0
+	}
+
+	val := gogotypes.UInt64Value{}
+	ak.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &val)
+
+	return val.GetValue()
+}
+
 // -----------------------------------------------------------------------------
 // Misc.
 
-func (ak AccountKeeper) decodeAccount(bz []byte) (acc exported.Account) {
-	err := ak.cdc.UnmarshalBinaryBare(bz, &acc)
+func (ak AccountKeeper) decodeAccount(bz []byte) exported.Account {
+	msg, err := codec.UnmarshalAny(ak.cdc, bz)
 	if err != nil {
 		panic(err)
 	}
-	return
+
+	acc, ok := msg.(exported.Account)
+	if !ok {
+		panic(fmt.Sprintf("unexpected account type: %T", msg))
+	}
+
+	return acc
+}
+
+func (ak AccountKeeper) encodeAccount(acc exported.Account) []byte {
+	msg, ok := acc.(proto.Message)
+	if !ok {
+		panic(fmt.Sprintf("account %T is not a protobuf message", acc))
+	}
+
+	bz, err := codec.MarshalAny(ak.cdc, msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
 }
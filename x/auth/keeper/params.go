@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -15,3 +16,36 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	ak.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// AccountPruningBlocks returns the number of blocks after which an inactive
+// account is pruned, or 0 if account pruning is disabled, including on chains
+// whose params do not hold it yet.
+func (ak AccountKeeper) AccountPruningBlocks(ctx sdk.Context) (res uint64) {
+	ak.paramSubspace.GetIfExists(ctx, types.KeyAccountPruningBlocks, &res)
+	return
+}
+
+// SetAccountPruningBlocks sets the AccountPruningBlocks param. When it enables
+// account pruning, the accounts whose activity was never recorded, because they
+// were last set while pruning was disabled, are queued as active at the current
+// height so that they can be pruned as well. Chains should therefore enable
+// account pruning through this method, e.g. in an upgrade handler, rather than
+// through a parameter change proposal.
+func (ak AccountKeeper) SetAccountPruningBlocks(ctx sdk.Context, pruningBlocks uint64) {
+	ak.paramSubspace.Set(ctx, types.KeyAccountPruningBlocks, pruningBlocks)
+	if pruningBlocks == 0 {
+		return
+	}
+
+	var addrs []sdk.AccAddress
+	ak.IterateAccounts(ctx, func(acc exported.Account) bool {
+		if _, found := ak.GetLastActivity(ctx, acc.GetAddress()); !found {
+			addrs = append(addrs, acc.GetAddress())
+		}
+		return false
+	})
+
+	for _, addr := range addrs {
+		ak.SetLastActivity(ctx, addr, ctx.BlockHeight())
+	}
+}
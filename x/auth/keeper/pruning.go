@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetLastActivity returns the height at which the account at the given address
+// was last modified.
+func (ak AccountKeeper) GetLastActivity(ctx sdk.Context, addr sdk.AccAddress) (height int64, found bool) {
+	bz := ctx.KVStore(ak.key).Get(types.AccountActivityKey(addr))
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// SetLastActivity records the given height as the last activity of the account
+// at the given address, and moves the account to the matching height of the
+// inactive account queue.
+func (ak AccountKeeper) SetLastActivity(ctx sdk.Context, addr sdk.AccAddress, height int64) {
+	ak.removeLastActivity(ctx, addr)
+
+	store := ctx.KVStore(ak.key)
+	store.Set(types.AccountActivityKey(addr), sdk.Uint64ToBigEndian(uint64(height)))
+	store.Set(types.InactiveAccountQueueKey(height, addr), []byte{})
+}
+
+// IterateLastActivities iterates over the last activity of all the accounts
+// and performs a callback function.
+func (ak AccountKeeper) IterateLastActivities(ctx sdk.Context, cb func(addr sdk.AccAddress, height int64) (stop bool)) {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.AccountActivityKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.AccAddress(iterator.Key()[len(types.AccountActivityKeyPrefix):])
+		height := int64(binary.BigEndian.Uint64(iterator.Value()))
+
+		if cb(addr, height) {
+			break
+		}
+	}
+}
+
+// removeLastActivity removes the last activity of the account at the given
+// address along with its entry in the inactive account queue.
+func (ak AccountKeeper) removeLastActivity(ctx sdk.Context, addr sdk.AccAddress) {
+	height, found := ak.GetLastActivity(ctx, addr)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.InactiveAccountQueueKey(height, addr))
+	store.Delete(types.AccountActivityKey(addr))
+}
+
+// IterateInactiveAccountQueue iterates over the inactive account queue in
+// ascending order of last activity height, up to and including the given
+// height, and performs a callback function.
+func (ak AccountKeeper) IterateInactiveAccountQueue(
+	ctx sdk.Context, endHeight int64, cb func(height int64, addr sdk.AccAddress) (stop bool),
+) {
+	store := ctx.KVStore(ak.key)
+	iterator := store.Iterator(
+		types.InactiveAccountQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.InactiveAccountQueueHeightKey(endHeight)),
+	)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		height, addr := types.SplitInactiveAccountQueueKey(iterator.Key())
+
+		if cb(height, addr) {
+			break
+		}
+	}
+}
+
+// GetTombstone returns the tombstone left by the pruned account at the given
+// address.
+func (ak AccountKeeper) GetTombstone(ctx sdk.Context, addr sdk.AccAddress) (tombstone types.Tombstone, found bool) {
+	bz := ctx.KVStore(ak.key).Get(types.TombstoneKey(addr))
+	if bz == nil {
+		return tombstone, false
+	}
+
+	ak.cdc.MustUnmarshalBinaryBare(bz, &tombstone)
+	return tombstone, true
+}
+
+// SetTombstone sets the tombstone of a pruned account.
+func (ak AccountKeeper) SetTombstone(ctx sdk.Context, tombstone types.Tombstone) {
+	bz := ak.cdc.MustMarshalBinaryBare(&tombstone)
+	ctx.KVStore(ak.key).Set(types.TombstoneKey(tombstone.Address), bz)
+}
+
+// RemoveTombstone removes the tombstone left by the pruned account at the given
+// address.
+func (ak AccountKeeper) RemoveTombstone(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(ak.key).Delete(types.TombstoneKey(addr))
+}
+
+// IterateTombstones iterates over all the tombstones of pruned accounts and
+// performs a callback function.
+func (ak AccountKeeper) IterateTombstones(ctx sdk.Context, cb func(tombstone types.Tombstone) (stop bool)) {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.TombstoneKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var tombstone types.Tombstone
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &tombstone)
+
+		if cb(tombstone) {
+			break
+		}
+	}
+}
+
+// GetAllTombstones returns the tombstones of all the pruned accounts.
+func (ak AccountKeeper) GetAllTombstones(ctx sdk.Context) (tombstones []types.Tombstone) {
+	ak.IterateTombstones(ctx, func(tombstone types.Tombstone) bool {
+		tombstones = append(tombstones, tombstone)
+		return false
+	})
+	return tombstones
+}
+
+// PruneInactiveAccounts removes the accounts which have not been modified for
+// the number of blocks set by the AccountPruningBlocks param and have no
+// balance, no stake, whether delegated, unbonding or redelegated, and no vesting
+// state. A tombstone is left for each
+// pruned account so that its sequence is restored if it is created again.
+//
+// Accounts which cannot be pruned are dropped from the inactive account queue
+// until they are modified again.
+func (ak AccountKeeper) PruneInactiveAccounts(ctx sdk.Context, sk types.StakingKeeper) {
+	pruningBlocks := ak.AccountPruningBlocks(ctx)
+	if pruningBlocks == 0 {
+		return
+	}
+
+	endHeight := ctx.BlockHeight() - int64(pruningBlocks)
+	if endHeight < 0 {
+		return
+	}
+
+	var addrs []sdk.AccAddress
+	ak.IterateInactiveAccountQueue(ctx, endHeight, func(_ int64, addr sdk.AccAddress) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+
+	for _, addr := range addrs {
+		acc := ak.GetAccount(ctx, addr)
+		if acc == nil || !isPrunable(ctx, acc, sk) {
+			ak.removeLastActivity(ctx, addr)
+			continue
+		}
+
+		ak.SetTombstone(ctx, types.NewTombstone(addr, acc.GetAccountNumber(), acc.GetSequence(), ctx.BlockHeight()))
+		ak.RemoveAccount(ctx, acc)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePruneAccount,
+				sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+				sdk.NewAttribute(types.AttributeKeyAccountNumber, fmt.Sprintf("%d", acc.GetAccountNumber())),
+				sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", acc.GetSequence())),
+			),
+		)
+
+		ak.Logger(ctx).Debug(fmt.Sprintf("pruned inactive account %s", addr))
+	}
+}
+
+// moduleAccount and vestingAccount match the module and vesting accounts, which
+// are never pruned, without the keeper depending on the modules defining them.
+type (
+	moduleAccount interface {
+		GetName() string
+		GetPermissions() []string
+	}
+
+	vestingAccount interface {
+		GetVestingCoins(blockTime time.Time) sdk.Coins
+		GetVestedCoins(blockTime time.Time) sdk.Coins
+	}
+)
+
+// isPrunable returns true if the account has no balance, no delegations,
+// unbonding delegations or redelegations and no vesting state, and is not a
+// module account.
+func isPrunable(ctx sdk.Context, acc exported.Account, sk types.StakingKeeper) bool {
+	if !acc.GetCoins().IsZero() {
+		return false
+	}
+
+	switch acc.(type) {
+	case moduleAccount, vestingAccount:
+		return false
+	}
+
+	// the tokens of an unbonding delegation or of a redelegation are credited
+	// to the account once they mature, so it must exist by then
+	return !sk.HasDelegatorStake(ctx, acc.GetAddress())
+}
@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestPruneInactiveAccounts(t *testing.T) {
+	app, ctx := createTestApp(false)
+	ak := app.AccountKeeper
+
+	params := types.DefaultParams()
+	params.AccountPruningBlocks = 10
+	ak.SetParams(ctx, params)
+
+	addrEmpty := sdk.AccAddress([]byte("addr-empty__________"))
+	addrCoins := sdk.AccAddress([]byte("addr-coins__________"))
+	addrVesting := sdk.AccAddress([]byte("addr-vesting________"))
+	addrDelegator := sdk.AccAddress([]byte("addr-delegator______"))
+	addrActive := sdk.AccAddress([]byte("addr-active_________"))
+
+	ctx = ctx.WithBlockHeight(1)
+
+	accEmpty := ak.NewAccountWithAddress(ctx, addrEmpty)
+	require.NoError(t, accEmpty.SetSequence(7))
+	ak.SetAccount(ctx, accEmpty)
+
+	accCoins := ak.NewAccountWithAddress(ctx, addrCoins)
+	require.NoError(t, accCoins.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	ak.SetAccount(ctx, accCoins)
+
+	bacc := types.NewBaseAccountWithAddress(addrVesting)
+	ak.SetAccount(ctx, ak.NewAccount(ctx, vestingtypes.NewDelayedVestingAccount(&bacc, 100)))
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addrDelegator))
+	app.StakingKeeper.SetDelegation(ctx, staking.NewDelegation(addrDelegator, sdk.ValAddress(addrDelegator), sdk.OneDec()))
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addrActive))
+
+	// module accounts are never pruned
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	require.True(t, feeCollector.GetCoins().IsZero())
+
+	height, found := ak.GetLastActivity(ctx, addrEmpty)
+	require.True(t, found)
+	require.Equal(t, int64(1), height)
+
+	// the active account is modified again later on
+	ctx = ctx.WithBlockHeight(5)
+	ak.SetAccount(ctx, ak.GetAccount(ctx, addrActive))
+
+	// no account is pruned before the pruning blocks elapsed
+	ctx = ctx.WithBlockHeight(10)
+	ak.PruneInactiveAccounts(ctx, app.StakingKeeper)
+	require.NotNil(t, ak.GetAccount(ctx, addrEmpty))
+
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	ak.PruneInactiveAccounts(ctx, app.StakingKeeper)
+	require.Nil(t, ak.GetAccount(ctx, addrEmpty))
+	require.NotNil(t, ak.GetAccount(ctx, addrCoins))
+	require.NotNil(t, ak.GetAccount(ctx, addrVesting))
+	require.NotNil(t, ak.GetAccount(ctx, addrDelegator))
+	require.NotNil(t, ak.GetAccount(ctx, addrActive))
+	require.NotNil(t, ak.GetAccount(ctx, feeCollector.GetAddress()))
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypePruneAccount, ctx.EventManager().Events()[0].Type)
+
+	_, found = ak.GetLastActivity(ctx, addrEmpty)
+	require.False(t, found)
+
+	tombstone, found := ak.GetTombstone(ctx, addrEmpty)
+	require.True(t, found)
+	require.Equal(t, types.NewTombstone(addrEmpty, accEmpty.GetAccountNumber(), 7, 11), tombstone)
+
+	ctx = ctx.WithBlockHeight(15)
+	ak.PruneInactiveAccounts(ctx, app.StakingKeeper)
+	require.Nil(t, ak.GetAccount(ctx, addrActive))
+	require.Len(t, ak.GetAllTombstones(ctx), 2)
+
+	msg, broken := keeper.TombstonesInvariant(ak)(ctx)
+	require.False(t, broken, msg)
+	msg, broken = keeper.InactiveAccountQueueInvariant(ak)(ctx)
+	require.False(t, broken, msg)
+
+	// a pruned account which is created again keeps its sequence but gets a new
+	// account number
+	acc := ak.NewAccountWithAddress(ctx, addrEmpty)
+	require.Equal(t, uint64(7), acc.GetSequence())
+	require.True(t, acc.GetAccountNumber() > accEmpty.GetAccountNumber())
+	ak.SetAccount(ctx, acc)
+
+	_, found = ak.GetTombstone(ctx, addrEmpty)
+	require.False(t, found)
+
+	msg, broken = keeper.TombstonesInvariant(ak)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestPruneInactiveAccountsDisabled(t *testing.T) {
+	app, ctx := createTestApp(false)
+	ak := app.AccountKeeper
+
+	addr := sdk.AccAddress([]byte("addr-empty__________"))
+	acc := ak.NewAccountWithAddress(ctx, addr)
+
+	// the activity of the accounts is not recorded while pruning is disabled
+	disabledCtx := ctx.WithBlockHeight(1).WithGasMeter(sdk.NewInfiniteGasMeter())
+	ak.SetAccount(disabledCtx, acc)
+
+	_, found := ak.GetLastActivity(ctx, addr)
+	require.False(t, found)
+
+	ak.PruneInactiveAccounts(ctx.WithBlockHeight(1000), app.StakingKeeper)
+	require.NotNil(t, ak.GetAccount(ctx, addr))
+	require.Empty(t, ak.GetAllTombstones(ctx))
+
+	// recording the activity consumes gas once pruning is enabled
+	params := types.DefaultParams()
+	params.AccountPruningBlocks = 10
+	ak.SetParams(ctx, params)
+
+	enabledCtx := ctx.WithBlockHeight(1).WithGasMeter(sdk.NewInfiniteGasMeter())
+	ak.SetAccount(enabledCtx, acc)
+
+	_, found = ak.GetLastActivity(ctx, addr)
+	require.True(t, found)
+	require.True(t, enabledCtx.GasMeter().GasConsumed() > disabledCtx.GasMeter().GasConsumed())
+}
+
+func TestSetAccountPruningBlocks(t *testing.T) {
+	app, ctx := createTestApp(false)
+	ak := app.AccountKeeper
+
+	addrIdle := sdk.AccAddress([]byte("addr-idle___________"))
+	addrActive := sdk.AccAddress([]byte("addr-active_________"))
+
+	// the accounts set while pruning is disabled are not queued
+	ctx = ctx.WithBlockHeight(1)
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addrIdle))
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addrActive))
+
+	_, found := ak.GetLastActivity(ctx, addrIdle)
+	require.False(t, found)
+
+	// enabling pruning queues them at the current height
+	ctx = ctx.WithBlockHeight(5)
+	ak.SetAccountPruningBlocks(ctx, 10)
+	require.Equal(t, uint64(10), ak.GetParams(ctx).AccountPruningBlocks)
+
+	height, found := ak.GetLastActivity(ctx, addrIdle)
+	require.True(t, found)
+	require.Equal(t, int64(5), height)
+
+	msg, broken := keeper.InactiveAccountQueueInvariant(ak)(ctx)
+	require.False(t, broken, msg)
+
+	// the recorded activity of the accounts is kept
+	ctx = ctx.WithBlockHeight(8)
+	ak.SetAccount(ctx, ak.GetAccount(ctx, addrActive))
+	ak.SetAccountPruningBlocks(ctx, 10)
+
+	height, found = ak.GetLastActivity(ctx, addrActive)
+	require.True(t, found)
+	require.Equal(t, int64(8), height)
+
+	ak.PruneInactiveAccounts(ctx.WithBlockHeight(15), app.StakingKeeper)
+	require.Nil(t, ak.GetAccount(ctx, addrIdle))
+	require.NotNil(t, ak.GetAccount(ctx, addrActive))
+}
+
+func TestPruneInactiveAccountsUnbonding(t *testing.T) {
+	app, ctx := createTestApp(false)
+	ak := app.AccountKeeper
+	sk := app.StakingKeeper
+
+	params := types.DefaultParams()
+	params.AccountPruningBlocks = 10
+	ak.SetParams(ctx, params)
+
+	addrDelegator := sdk.AccAddress([]byte("addr-delegator______"))
+	addrRedelegator := sdk.AccAddress([]byte("addr-redelegator____"))
+	valAddr := sdk.ValAddress([]byte("addr-validator______"))
+	valDstAddr := sdk.ValAddress([]byte("addr-validator-dst__"))
+
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Unix(0, 0).UTC())
+
+	validator := staking.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), staking.Description{})
+	sk.SetValidator(ctx, validator)
+	sk.AfterValidatorCreated(ctx, valAddr)
+
+	bondDenom := sk.BondDenom(ctx)
+	bondAmt := sdk.NewInt(100)
+
+	acc := ak.NewAccountWithAddress(ctx, addrDelegator)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(bondDenom, bondAmt))))
+	ak.SetAccount(ctx, acc)
+
+	shares, err := sk.Delegate(ctx, addrDelegator, bondAmt, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// the account only holds the unbonding delegation of its tokens
+	completionTime, err := sk.Undelegate(ctx, addrDelegator, valAddr, shares)
+	require.NoError(t, err)
+	require.True(t, ak.GetAccount(ctx, addrDelegator).GetCoins().IsZero())
+
+	// the account only holds a redelegation to the destination validator
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addrRedelegator))
+	sk.SetRedelegation(ctx, staking.NewRedelegation(
+		addrRedelegator, valAddr, valDstAddr, 1, completionTime, sdk.OneInt(), sdk.OneDec(),
+	))
+
+	ctx = ctx.WithBlockHeight(11)
+	ak.PruneInactiveAccounts(ctx, sk)
+	require.NotNil(t, ak.GetAccount(ctx, addrDelegator))
+	require.NotNil(t, ak.GetAccount(ctx, addrRedelegator))
+	require.Empty(t, ak.GetAllTombstones(ctx))
+
+	// the unbonded tokens are credited to the account once the unbonding completes
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(completionTime)
+	require.NoError(t, sk.CompleteUnbonding(ctx, addrDelegator, valAddr))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, bondAmt)), ak.GetAccount(ctx, addrDelegator).GetCoins())
+}
@@ -16,6 +16,8 @@ func NewQuerier(keeper AccountKeeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryAccount:
 			return queryAccount(ctx, req, keeper)
+		case types.QueryTombstone:
+			return queryTombstone(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -40,3 +42,22 @@ func queryAccount(ctx sdk.Context, req abci.RequestQuery, keeper AccountKeeper)
 
 	return bz, nil
 }
+
+func queryTombstone(ctx sdk.Context, req abci.RequestQuery, keeper AccountKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTombstoneParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	tombstone, found := keeper.GetTombstone(ctx, params.Address)
+	if !found {
+		return nil, sdk.ErrUnknownAddress(fmt.Sprintf("tombstone %s does not exist", params.Address))
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, tombstone)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
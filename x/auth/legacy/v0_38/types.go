@@ -71,6 +71,20 @@ type (
 		*BaseVestingAccount
 	}
 
+	Period struct {
+		Length int64     `json:"length" yaml:"length"`
+		Amount sdk.Coins `json:"amount" yaml:"amount"`
+	}
+
+	Periods []Period
+
+	PeriodicVestingAccount struct {
+		*BaseVestingAccount
+
+		StartTime      int64   `json:"start_time" yaml:"start_time"`
+		VestingPeriods Periods `json:"vesting_periods" yaml:"vesting_periods"`
+	}
+
 	ModuleAccount struct {
 		*BaseAccount
 
@@ -178,6 +192,35 @@ func (dva DelayedVestingAccount) Validate() error {
 	return dva.BaseVestingAccount.Validate()
 }
 
+func NewPeriodicVestingAccountRaw(bva *BaseVestingAccount, startTime int64, periods Periods) *PeriodicVestingAccount {
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+func (pva PeriodicVestingAccount) Validate() error {
+	endTime := pva.StartTime
+	originalVesting := sdk.NewCoins()
+	for _, p := range pva.VestingPeriods {
+		endTime += p.Length
+		originalVesting = originalVesting.Add(p.Amount)
+	}
+
+	if pva.StartTime >= pva.EndTime {
+		return errors.New("vesting start-time cannot be before end-time")
+	}
+	if endTime != pva.EndTime {
+		return errors.New("vesting end time does not match length of all vesting periods")
+	}
+	if !originalVesting.IsEqual(pva.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return pva.BaseVestingAccount.Validate()
+}
+
 func NewModuleAddress(name string) sdk.AccAddress {
 	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
 }
@@ -258,5 +301,6 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&BaseVestingAccount{}, "cosmos-sdk/BaseVestingAccount", nil)
 	cdc.RegisterConcrete(&ContinuousVestingAccount{}, "cosmos-sdk/ContinuousVestingAccount", nil)
 	cdc.RegisterConcrete(&DelayedVestingAccount{}, "cosmos-sdk/DelayedVestingAccount", nil)
+	cdc.RegisterConcrete(&PeriodicVestingAccount{}, "cosmos-sdk/PeriodicVestingAccount", nil)
 	cdc.RegisterConcrete(&ModuleAccount{}, "cosmos-sdk/ModuleAccount", nil)
 }
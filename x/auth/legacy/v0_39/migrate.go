@@ -0,0 +1,12 @@
+package v039
+
+import (
+	v038auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_38"
+)
+
+// Migrate accepts exported genesis state from v0.38 and migrates it to v0.39
+// genesis state. The v0.38 account types are unchanged, v0.39 only adds the
+// permanent locked and cliff vesting account types.
+func Migrate(oldGenState v038auth.GenesisState) GenesisState {
+	return NewGenesisState(oldGenState.Params, oldGenState.Accounts)
+}
@@ -0,0 +1,88 @@
+package v039
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v034auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_34"
+	v038auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_38"
+)
+
+func TestMigrate(t *testing.T) {
+	v038Codec := codec.New()
+	codec.RegisterCrypto(v038Codec)
+	v038auth.RegisterCodec(v038Codec)
+
+	v039Codec := codec.New()
+	codec.RegisterCrypto(v039Codec)
+	RegisterCodec(v039Codec)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	now := time.Now().Unix()
+
+	oldGenState := v038auth.NewGenesisState(
+		v034auth.Params{MaxMemoCharacters: 10, TxSigLimit: 10},
+		v038auth.GenesisAccounts{
+			v038auth.NewBaseAccount(addr1, coins, 1, 0),
+			v038auth.NewPeriodicVestingAccountRaw(
+				v038auth.NewBaseVestingAccount(
+					v038auth.NewBaseAccount(addr2, coins, 2, 0), coins, nil, nil, now+100,
+				),
+				now, v038auth.Periods{{Length: 100, Amount: coins}},
+			),
+		},
+	)
+
+	bz := v038Codec.MustMarshalJSON(oldGenState)
+	var decoded v038auth.GenesisState
+	require.NoError(t, v038Codec.UnmarshalJSON(bz, &decoded))
+
+	migrated := Migrate(decoded)
+	require.Equal(t, oldGenState.Params, migrated.Params)
+	require.Equal(t, oldGenState.Accounts, migrated.Accounts)
+	require.Equal(t, string(bz), string(v039Codec.MustMarshalJSON(migrated)))
+}
+
+func TestNewAccountTypes(t *testing.T) {
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	RegisterCodec(cdc)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	now := time.Now().Unix()
+
+	genState := NewGenesisState(
+		v034auth.Params{},
+		v038auth.GenesisAccounts{
+			NewPermanentLockedAccountRaw(
+				v038auth.NewBaseVestingAccount(v038auth.NewBaseAccount(addr1, coins, 1, 0), coins, nil, nil, 0),
+			),
+			NewCliffVestingAccountRaw(
+				v038auth.NewBaseVestingAccount(v038auth.NewBaseAccount(addr2, coins, 2, 0), coins, nil, nil, now+100),
+				now, now+50,
+			),
+		},
+	)
+
+	var decoded GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(cdc.MustMarshalJSON(genState), &decoded))
+	require.Equal(t, genState, decoded)
+
+	for _, acc := range decoded.Accounts {
+		require.NoError(t, acc.Validate())
+	}
+
+	invalid := NewCliffVestingAccountRaw(
+		v038auth.NewBaseVestingAccount(v038auth.NewBaseAccount(addr2, coins, 2, 0), coins, nil, nil, now+100),
+		now, now+150,
+	)
+	require.Error(t, invalid.Validate())
+}
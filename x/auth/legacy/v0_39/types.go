@@ -0,0 +1,80 @@
+package v039
+
+// DONTCOVER
+// nolint
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	v034auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_34"
+	v038auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_38"
+)
+
+const (
+	ModuleName = "auth"
+)
+
+type (
+	GenesisState struct {
+		Params   v034auth.Params          `json:"params" yaml:"params"`
+		Accounts v038auth.GenesisAccounts `json:"accounts" yaml:"accounts"`
+	}
+
+	PermanentLockedAccount struct {
+		*v038auth.BaseVestingAccount
+	}
+
+	CliffVestingAccount struct {
+		*v038auth.BaseVestingAccount
+
+		StartTime int64 `json:"start_time"`
+		CliffTime int64 `json:"cliff_time"`
+	}
+)
+
+func NewGenesisState(params v034auth.Params, accounts v038auth.GenesisAccounts) GenesisState {
+	return GenesisState{
+		Params:   params,
+		Accounts: accounts,
+	}
+}
+
+func NewPermanentLockedAccountRaw(bva *v038auth.BaseVestingAccount) *PermanentLockedAccount {
+	return &PermanentLockedAccount{
+		BaseVestingAccount: bva,
+	}
+}
+
+func (plva PermanentLockedAccount) Validate() error {
+	if plva.EndTime > 0 {
+		return errors.New("permanently vested accounts cannot have an end-time")
+	}
+
+	return plva.BaseVestingAccount.Validate()
+}
+
+func NewCliffVestingAccountRaw(bva *v038auth.BaseVestingAccount, startTime, cliffTime int64) *CliffVestingAccount {
+	return &CliffVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		CliffTime:          cliffTime,
+	}
+}
+
+func (cva CliffVestingAccount) Validate() error {
+	if cva.StartTime >= cva.EndTime {
+		return errors.New("vesting start-time must be before end-time")
+	}
+	if cva.CliffTime < cva.StartTime || cva.CliffTime > cva.EndTime {
+		return errors.New("vesting cliff-time must be between start-time and end-time")
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+func RegisterCodec(cdc *codec.Codec) {
+	v038auth.RegisterCodec(cdc)
+	cdc.RegisterConcrete(&PermanentLockedAccount{}, "cosmos-sdk/PermanentLockedAccount", nil)
+	cdc.RegisterConcrete(&CliffVestingAccount{}, "cosmos-sdk/CliffVestingAccount", nil)
+}
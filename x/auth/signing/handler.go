@@ -0,0 +1,100 @@
+package signing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SignerData is the information about a signer, other than the transaction
+// itself, that is signed over.
+type SignerData struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// SignModeHandler defines a handler which returns the bytes a signer is
+// expected to sign for a transaction in the sign modes it supports.
+type SignModeHandler interface {
+	// DefaultMode returns the mode used for signatures which do not specify one.
+	DefaultMode() types.SignMode
+
+	// Modes returns the sign modes supported by the handler.
+	Modes() []types.SignMode
+
+	// GetSignBytes returns the bytes to sign for the transaction and signer in
+	// the given sign mode.
+	GetSignBytes(mode types.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
+}
+
+var _ SignModeHandler = HandlerMap{}
+
+// HandlerMap is a SignModeHandler which delegates to the registered handler of
+// each sign mode.
+type HandlerMap struct {
+	defaultMode types.SignMode
+	modes       []types.SignMode
+	handlers    map[types.SignMode]SignModeHandler
+}
+
+// NewHandlerMap returns a HandlerMap registering the given handlers for each of
+// the modes they support. It panics if a mode is registered more than once or
+// if no handler supports the default mode.
+func NewHandlerMap(defaultMode types.SignMode, handlers ...SignModeHandler) HandlerMap {
+	hm := HandlerMap{
+		defaultMode: defaultMode,
+		handlers:    make(map[types.SignMode]SignModeHandler),
+	}
+
+	for _, h := range handlers {
+		for _, mode := range h.Modes() {
+			if _, ok := hm.handlers[mode]; ok {
+				panic(fmt.Sprintf("duplicate sign mode handler for mode %s", mode))
+			}
+
+			hm.handlers[mode] = h
+			hm.modes = append(hm.modes, mode)
+		}
+	}
+
+	if _, ok := hm.handlers[defaultMode]; !ok {
+		panic(fmt.Sprintf("no sign mode handler for default mode %s", defaultMode))
+	}
+
+	return hm
+}
+
+// DefaultMode implements the SignModeHandler interface.
+func (hm HandlerMap) DefaultMode() types.SignMode { return hm.defaultMode }
+
+// Modes implements the SignModeHandler interface.
+func (hm HandlerMap) Modes() []types.SignMode { return hm.modes }
+
+// GetSignBytes implements the SignModeHandler interface. An unspecified mode
+// resolves to the default mode.
+func (hm HandlerMap) GetSignBytes(mode types.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode == types.SignModeUnspecified {
+		mode = hm.defaultMode
+	}
+
+	h, ok := hm.handlers[mode]
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unsupported sign mode %s", mode)
+	}
+
+	return h.GetSignBytes(mode, data, tx)
+}
+
+// DefaultSignModeHandler returns the SignModeHandler supporting the legacy Amino
+// JSON and the direct sign modes, using the legacy Amino JSON mode by default.
+// The tx encoder must be the one used to encode transactions of the app.
+func DefaultSignModeHandler(txEncoder sdk.TxEncoder) SignModeHandler {
+	return NewHandlerMap(
+		types.SignModeLegacyAminoJSON,
+		LegacyAminoJSONHandler{},
+		NewDirectHandler(txEncoder),
+	)
+}
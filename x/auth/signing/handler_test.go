@@ -0,0 +1,71 @@
+package signing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestHandlerMap(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/TestMsg", nil)
+	txEncoder := types.DefaultTxEncoder(cdc)
+
+	_, _, addr := types.KeyTestPubAddr()
+	msgs := []sdk.Msg{types.NewTestMsg(addr)}
+	fee := types.NewTestStdFee()
+	tx := types.NewStdTx(msgs, fee, nil, "memo")
+	data := signing.SignerData{ChainID: "test-chain", AccountNumber: 1, Sequence: 2}
+
+	handler := signing.DefaultSignModeHandler(txEncoder)
+	require.Equal(t, types.SignModeLegacyAminoJSON, handler.DefaultMode())
+	require.Equal(t, []types.SignMode{types.SignModeLegacyAminoJSON, types.SignModeDirect}, handler.Modes())
+
+	aminoJSONBytes := types.StdSignBytes(data.ChainID, data.AccountNumber, data.Sequence, 0, fee, msgs, "memo")
+	bz, err := handler.GetSignBytes(types.SignModeLegacyAminoJSON, data, tx)
+	require.NoError(t, err)
+	require.Equal(t, aminoJSONBytes, bz)
+
+	// an unspecified mode resolves to the default mode
+	bz, err = handler.GetSignBytes(types.SignModeUnspecified, data, tx)
+	require.NoError(t, err)
+	require.Equal(t, aminoJSONBytes, bz)
+
+	directBytes, err := types.DirectSignBytes(txEncoder, data.ChainID, data.AccountNumber, data.Sequence, 0, fee, msgs, "memo")
+	require.NoError(t, err)
+	bz, err = handler.GetSignBytes(types.SignModeDirect, data, tx)
+	require.NoError(t, err)
+	require.Equal(t, directBytes, bz)
+	require.NotEqual(t, aminoJSONBytes, bz)
+
+	var signDoc types.SignDoc
+	require.NoError(t, signDoc.Unmarshal(bz))
+	require.Equal(t, data.ChainID, signDoc.ChainID)
+	require.Equal(t, data.AccountNumber, signDoc.AccountNumber)
+	require.Equal(t, data.Sequence, signDoc.Sequence)
+
+	_, err = handler.GetSignBytes(types.SignModeTextual, data, tx)
+	require.Error(t, err)
+}
+
+func TestNewHandlerMap(t *testing.T) {
+	require.Panics(t, func() {
+		signing.NewHandlerMap(types.SignModeDirect, signing.LegacyAminoJSONHandler{})
+	})
+	require.Panics(t, func() {
+		signing.NewHandlerMap(
+			types.SignModeLegacyAminoJSON, signing.LegacyAminoJSONHandler{}, signing.LegacyAminoJSONHandler{},
+		)
+	})
+	require.NotPanics(t, func() {
+		signing.NewHandlerMap(types.SignModeDirect, signing.NewDirectHandler(nil))
+	})
+}
@@ -0,0 +1,77 @@
+package signing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ SignModeHandler = LegacyAminoJSONHandler{}
+	_ SignModeHandler = DirectHandler{}
+)
+
+// LegacyAminoJSONHandler is the SignModeHandler of the legacy Amino JSON sign
+// mode, signing over the canonical JSON of the StdSignDoc.
+type LegacyAminoJSONHandler struct{}
+
+// DefaultMode implements the SignModeHandler interface.
+func (LegacyAminoJSONHandler) DefaultMode() types.SignMode { return types.SignModeLegacyAminoJSON }
+
+// Modes implements the SignModeHandler interface.
+func (LegacyAminoJSONHandler) Modes() []types.SignMode {
+	return []types.SignMode{types.SignModeLegacyAminoJSON}
+}
+
+// GetSignBytes implements the SignModeHandler interface.
+func (LegacyAminoJSONHandler) GetSignBytes(mode types.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != types.SignModeLegacyAminoJSON {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected sign mode %s, got %s", types.SignModeLegacyAminoJSON, mode)
+	}
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "expected %T, got %T", types.StdTx{}, tx)
+	}
+
+	return types.StdSignBytes(
+		data.ChainID, data.AccountNumber, data.Sequence, stdTx.TimeoutHeight, stdTx.Fee, stdTx.Msgs, stdTx.Memo,
+	), nil
+}
+
+// DirectHandler is the SignModeHandler of the direct sign mode, signing over the
+// SignDoc of the encoded transaction body and auth info.
+type DirectHandler struct {
+	txEncoder sdk.TxEncoder
+}
+
+// NewDirectHandler returns a DirectHandler encoding transaction bodies with the
+// given tx encoder.
+func NewDirectHandler(txEncoder sdk.TxEncoder) DirectHandler {
+	return DirectHandler{txEncoder: txEncoder}
+}
+
+// DefaultMode implements the SignModeHandler interface.
+func (DirectHandler) DefaultMode() types.SignMode { return types.SignModeDirect }
+
+// Modes implements the SignModeHandler interface.
+func (DirectHandler) Modes() []types.SignMode {
+	return []types.SignMode{types.SignModeDirect}
+}
+
+// GetSignBytes implements the SignModeHandler interface.
+func (h DirectHandler) GetSignBytes(mode types.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != types.SignModeDirect {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected sign mode %s, got %s", types.SignModeDirect, mode)
+	}
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "expected %T, got %T", types.StdTx{}, tx)
+	}
+
+	return types.DirectSignBytes(
+		h.txEncoder, data.ChainID, data.AccountNumber, data.Sequence, stdTx.TimeoutHeight,
+		stdTx.Fee, stdTx.Msgs, stdTx.Memo,
+	)
+}
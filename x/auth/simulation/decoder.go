@@ -2,28 +2,50 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // DecodeStore unmarshals the KVPair's Value to the corresponding auth type
 func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	appCodec := codec.NewHybridCodec(cdc)
+
 	switch {
 	case bytes.Equal(kvA.Key[:1], types.AddressStoreKeyPrefix):
-		var accA, accB exported.Account
-		cdc.MustUnmarshalBinaryBare(kvA.Value, &accA)
-		cdc.MustUnmarshalBinaryBare(kvB.Value, &accB)
+		accA, err := codec.UnmarshalAny(appCodec, kvA.Value)
+		if err != nil {
+			panic(err)
+		}
+		accB, err := codec.UnmarshalAny(appCodec, kvB.Value)
+		if err != nil {
+			panic(err)
+		}
 		return fmt.Sprintf("%v\n%v", accA, accB)
 	case bytes.Equal(kvA.Key, types.GlobalAccountNumberKey):
-		var globalAccNumberA, globalAccNumberB uint64
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &globalAccNumberA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &globalAccNumberB)
-		return fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumberA, globalAccNumberB)
+		var globalAccNumberA, globalAccNumberB gogotypes.UInt64Value
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &globalAccNumberA)
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &globalAccNumberB)
+		return fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumberA.Value, globalAccNumberB.Value)
+	case bytes.Equal(kvA.Key[:1], types.AccountActivityKeyPrefix):
+		heightA := int64(binary.BigEndian.Uint64(kvA.Value))
+		heightB := int64(binary.BigEndian.Uint64(kvB.Value))
+		return fmt.Sprintf("LastActivityA: %d\nLastActivityB: %d", heightA, heightB)
+	case bytes.Equal(kvA.Key[:1], types.InactiveAccountQueueKeyPrefix):
+		heightA, addrA := types.SplitInactiveAccountQueueKey(kvA.Key)
+		heightB, addrB := types.SplitInactiveAccountQueueKey(kvB.Key)
+		return fmt.Sprintf("InactiveAccountA: %d %s\nInactiveAccountB: %d %s", heightA, addrA, heightB, addrB)
+	case bytes.Equal(kvA.Key[:1], types.TombstoneKeyPrefix):
+		var tombstoneA, tombstoneB types.Tombstone
+		appCodec.MustUnmarshalBinaryBare(kvA.Value, &tombstoneA)
+		appCodec.MustUnmarshalBinaryBare(kvB.Value, &tombstoneB)
+		return fmt.Sprintf("%v\n%v", tombstoneA, tombstoneB)
 	default:
 		panic(fmt.Sprintf("invalid account key %X", kvA.Key))
 	}
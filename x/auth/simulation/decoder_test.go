@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -29,12 +30,20 @@ func makeTestCodec() (cdc *codec.Codec) {
 
 func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
+	appCodec := codec.NewHybridCodec(cdc)
 	acc := types.NewBaseAccountWithAddress(delAddr1)
-	globalAccNumber := uint64(10)
+	globalAccNumber := gogotypes.UInt64Value{Value: 10}
+	tombstone := types.NewTombstone(delAddr1, 1, 5, 20)
+
+	accBz, err := codec.MarshalAny(appCodec, &acc)
+	require.NoError(t, err)
 
 	kvPairs := cmn.KVPairs{
-		cmn.KVPair{Key: types.AddressStoreKey(delAddr1), Value: cdc.MustMarshalBinaryBare(acc)},
-		cmn.KVPair{Key: types.GlobalAccountNumberKey, Value: cdc.MustMarshalBinaryLengthPrefixed(globalAccNumber)},
+		cmn.KVPair{Key: types.AddressStoreKey(delAddr1), Value: accBz},
+		cmn.KVPair{Key: types.GlobalAccountNumberKey, Value: appCodec.MustMarshalBinaryLengthPrefixed(&globalAccNumber)},
+		cmn.KVPair{Key: types.AccountActivityKey(delAddr1), Value: sdk.Uint64ToBigEndian(15)},
+		cmn.KVPair{Key: types.InactiveAccountQueueKey(15, delAddr1), Value: []byte{}},
+		cmn.KVPair{Key: types.TombstoneKey(delAddr1), Value: appCodec.MustMarshalBinaryBare(&tombstone)},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 	tests := []struct {
@@ -42,7 +51,10 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Account", fmt.Sprintf("%v\n%v", acc, acc)},
-		{"GlobalAccNumber", fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumber, globalAccNumber)},
+		{"GlobalAccNumber", fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumber.Value, globalAccNumber.Value)},
+		{"LastActivity", "LastActivityA: 15\nLastActivityB: 15"},
+		{"InactiveAccount", fmt.Sprintf("InactiveAccountA: 15 %s\nInactiveAccountB: 15 %s", delAddr1, delAddr1)},
+		{"Tombstone", fmt.Sprintf("%v\n%v", tombstone, tombstone)},
 		{"other", ""},
 	}
 
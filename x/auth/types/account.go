@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,26 +20,19 @@ import (
 var _ exported.Account = (*BaseAccount)(nil)
 var _ exported.GenesisAccount = (*BaseAccount)(nil)
 
-// BaseAccount - a base account structure.
-// This can be extended by embedding within in your AppAccount.
-// However one doesn't have to use BaseAccount as long as your struct
-// implements Account.
-type BaseAccount struct {
-	Address       sdk.AccAddress `json:"address" yaml:"address"`
-	Coins         sdk.Coins      `json:"coins" yaml:"coins"`
-	PubKey        crypto.PubKey  `json:"public_key" yaml:"public_key"`
-	AccountNumber uint64         `json:"account_number" yaml:"account_number"`
-	Sequence      uint64         `json:"sequence" yaml:"sequence"`
-}
-
 // NewBaseAccount creates a new BaseAccount object
 func NewBaseAccount(address sdk.AccAddress, coins sdk.Coins,
 	pubKey crypto.PubKey, accountNumber uint64, sequence uint64) *BaseAccount {
 
+	var pkBz []byte
+	if pubKey != nil {
+		pkBz = pubKey.Bytes()
+	}
+
 	return &BaseAccount{
 		Address:       address,
 		Coins:         coins,
-		PubKey:        pubKey,
+		PubKey:        pkBz,
 		AccountNumber: accountNumber,
 		Sequence:      sequence,
 	}
@@ -72,12 +66,26 @@ func (acc *BaseAccount) SetAddress(addr sdk.AccAddress) error {
 
 // GetPubKey - Implements sdk.Account.
 func (acc BaseAccount) GetPubKey() crypto.PubKey {
-	return acc.PubKey
+	if len(acc.PubKey) == 0 {
+		return nil
+	}
+
+	pk, err := cryptoamino.PubKeyFromBytes(acc.PubKey)
+	if err != nil {
+		panic(err)
+	}
+
+	return pk
 }
 
 // SetPubKey - Implements sdk.Account.
 func (acc *BaseAccount) SetPubKey(pubKey crypto.PubKey) error {
-	acc.PubKey = pubKey
+	if pubKey == nil {
+		acc.PubKey = nil
+	} else {
+		acc.PubKey = pubKey.Bytes()
+	}
+
 	return nil
 }
 
@@ -122,8 +130,8 @@ func (acc *BaseAccount) SpendableCoins(_ time.Time) sdk.Coins {
 
 // Validate checks for errors on the account fields
 func (acc BaseAccount) Validate() error {
-	if acc.PubKey != nil && acc.Address != nil &&
-		!bytes.Equal(acc.PubKey.Address().Bytes(), acc.Address.Bytes()) {
+	if len(acc.PubKey) != 0 && acc.Address != nil &&
+		!bytes.Equal(acc.GetPubKey().Address().Bytes(), acc.Address.Bytes()) {
 		return errors.New("pubkey and address pair is invalid")
 	}
 
@@ -152,8 +160,8 @@ func (acc BaseAccount) MarshalYAML() (interface{}, error) {
 		Sequence:      acc.Sequence,
 	}
 
-	if acc.PubKey != nil {
-		pks, err := sdk.Bech32ifyAccPub(acc.PubKey)
+	if len(acc.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(acc.GetPubKey())
 		if err != nil {
 			return nil, err
 		}
@@ -178,8 +186,8 @@ func (acc BaseAccount) MarshalJSON() ([]byte, error) {
 		Sequence:      acc.Sequence,
 	}
 
-	if acc.PubKey != nil {
-		pks, err := sdk.Bech32ifyAccPub(acc.PubKey)
+	if len(acc.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(acc.GetPubKey())
 		if err != nil {
 			return nil, err
 		}
@@ -203,7 +211,7 @@ func (acc *BaseAccount) UnmarshalJSON(bz []byte) error {
 			return err
 		}
 
-		acc.PubKey = pk
+		acc.PubKey = pk.Bytes()
 	}
 
 	acc.Address = alias.Address
@@ -0,0 +1,10 @@
+package types
+
+// auth module event types
+const (
+	EventTypePruneAccount = "prune_account"
+
+	AttributeKeyAddress       = "address"
+	AttributeKeyAccountNumber = "account_number"
+	AttributeKeySequence      = "sequence"
+)
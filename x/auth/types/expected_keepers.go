@@ -11,3 +11,20 @@ type SupplyKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) exported.ModuleAccountI
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
+
+// FeegrantKeeper defines the expected feegrant Keeper (noalias)
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error
+}
+
+// FeeMarketKeeper defines the expected fee market Keeper (noalias)
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) sdk.DecCoins
+	HandleBaseFee(ctx sdk.Context, fees sdk.Coins) error
+}
+
+// StakingKeeper defines the expected staking Keeper used to check that pruned
+// accounts have no delegations, unbonding delegations or redelegations (noalias)
+type StakingKeeper interface {
+	HasDelegatorStake(ctx sdk.Context, delegator sdk.AccAddress) bool
+}
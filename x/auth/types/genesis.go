@@ -6,13 +6,31 @@ import (
 	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 )
 
 // GenesisState - all auth state that must be provided at genesis
 type GenesisState struct {
-	Params   Params                   `json:"params" yaml:"params"`
-	Accounts exported.GenesisAccounts `json:"accounts" yaml:"accounts"`
+	Params            Params                   `json:"params" yaml:"params"`
+	Accounts          exported.GenesisAccounts `json:"accounts" yaml:"accounts"`
+	Tombstones        []Tombstone              `json:"tombstones,omitempty" yaml:"tombstones,omitempty"`
+	AccountActivities []AccountActivity        `json:"account_activities,omitempty" yaml:"account_activities,omitempty"`
+}
+
+// AccountActivity defines the height at which an account was last modified,
+// from which its inactivity is counted for account pruning.
+type AccountActivity struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Height  int64          `json:"height" yaml:"height"`
+}
+
+// NewAccountActivity creates a new AccountActivity instance
+func NewAccountActivity(addr sdk.AccAddress, height int64) AccountActivity {
+	return AccountActivity{
+		Address: addr,
+		Height:  height,
+	}
 }
 
 // NewGenesisState - Create a new genesis state
@@ -46,7 +64,66 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(data.Accounts)
+	if err := ValidateGenAccounts(data.Accounts); err != nil {
+		return err
+	}
+
+	if err := validateTombstones(data.Tombstones, data.Accounts); err != nil {
+		return err
+	}
+
+	return validateAccountActivities(data.AccountActivities, data.Accounts)
+}
+
+// validateAccountActivities checks that every account activity belongs to an
+// existing account and is not duplicated.
+func validateAccountActivities(activities []AccountActivity, accounts exported.GenesisAccounts) error {
+	accMap := make(map[string]bool, len(accounts))
+	for _, acc := range accounts {
+		accMap[acc.GetAddress().String()] = true
+	}
+
+	activityMap := make(map[string]bool, len(activities))
+	for _, activity := range activities {
+		addrStr := activity.Address.String()
+		if !accMap[addrStr] {
+			return fmt.Errorf("account activity found for unknown account in genesis state; address: %s", addrStr)
+		}
+		if activityMap[addrStr] {
+			return fmt.Errorf("duplicate account activity found in genesis state; address: %s", addrStr)
+		}
+		if activity.Height < 0 {
+			return fmt.Errorf("invalid account activity height in genesis state; address: %s, height: %d", addrStr, activity.Height)
+		}
+
+		activityMap[addrStr] = true
+	}
+
+	return nil
+}
+
+// validateTombstones validates the tombstones of pruned accounts and checks
+// that none of them belongs to an existing account.
+func validateTombstones(tombstones []Tombstone, accounts exported.GenesisAccounts) error {
+	addrMap := make(map[string]bool, len(accounts)+len(tombstones))
+	for _, acc := range accounts {
+		addrMap[acc.GetAddress().String()] = true
+	}
+
+	for _, tombstone := range tombstones {
+		if err := tombstone.Validate(); err != nil {
+			return err
+		}
+
+		addrStr := tombstone.Address.String()
+		if _, ok := addrMap[addrStr]; ok {
+			return fmt.Errorf("duplicate tombstone or account found in genesis state; address: %s", addrStr)
+		}
+
+		addrMap[addrStr] = true
+	}
+
+	return nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
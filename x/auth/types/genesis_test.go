@@ -90,3 +90,51 @@ func TestGenesisAccountIterator(t *testing.T) {
 	require.Equal(t, addresses[0], acc1.GetAddress())
 	require.Equal(t, addresses[1], acc2.GetAddress())
 }
+
+func TestValidateGenesisTombstones(t *testing.T) {
+	acc1 := NewBaseAccountWithAddress(sdk.AccAddress(addr1))
+	acc1.Coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
+
+	genState := DefaultGenesisState()
+	genState.Accounts = exported.GenesisAccounts{&acc1}
+	genState.Tombstones = []Tombstone{NewTombstone(sdk.AccAddress(addr2), 1, 5, 10)}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// require a tombstone cannot belong to an existing account
+	genState.Tombstones = append(genState.Tombstones, NewTombstone(sdk.AccAddress(addr1), 2, 5, 10))
+	require.Error(t, ValidateGenesis(genState))
+
+	// require duplicate tombstones fail validation
+	genState.Tombstones = []Tombstone{
+		NewTombstone(sdk.AccAddress(addr2), 1, 5, 10),
+		NewTombstone(sdk.AccAddress(addr2), 1, 5, 10),
+	}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Tombstones = []Tombstone{NewTombstone(nil, 1, 5, 10)}
+	require.Error(t, ValidateGenesis(genState))
+}
+
+func TestValidateGenesisAccountActivities(t *testing.T) {
+	acc1 := NewBaseAccountWithAddress(sdk.AccAddress(addr1))
+	acc1.Coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
+
+	genState := DefaultGenesisState()
+	genState.Accounts = exported.GenesisAccounts{&acc1}
+	genState.AccountActivities = []AccountActivity{NewAccountActivity(sdk.AccAddress(addr1), 10)}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// require an account activity cannot belong to an unknown account
+	genState.AccountActivities = []AccountActivity{NewAccountActivity(sdk.AccAddress(addr2), 10)}
+	require.Error(t, ValidateGenesis(genState))
+
+	// require duplicate account activities fail validation
+	genState.AccountActivities = []AccountActivity{
+		NewAccountActivity(sdk.AccAddress(addr1), 10),
+		NewAccountActivity(sdk.AccAddress(addr1), 12),
+	}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.AccountActivities = []AccountActivity{NewAccountActivity(sdk.AccAddress(addr1), -1)}
+	require.Error(t, ValidateGenesis(genState))
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,6 +24,15 @@ var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// AccountActivityKeyPrefix prefix for the last activity height by address store
+	AccountActivityKeyPrefix = []byte{0x02}
+
+	// InactiveAccountQueueKeyPrefix prefix for the queue of accounts by last activity height
+	InactiveAccountQueueKeyPrefix = []byte{0x03}
+
+	// TombstoneKeyPrefix prefix for the tombstones of pruned accounts by address
+	TombstoneKeyPrefix = []byte{0x04}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 )
@@ -30,3 +41,32 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// AccountActivityKey returns the key of the last activity height of an account
+func AccountActivityKey(addr sdk.AccAddress) []byte {
+	return append(AccountActivityKeyPrefix, addr.Bytes()...)
+}
+
+// InactiveAccountQueueHeightKey returns the prefix of the queue entries of the
+// accounts last active at the given height
+func InactiveAccountQueueHeightKey(height int64) []byte {
+	return append(InactiveAccountQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// InactiveAccountQueueKey returns the key of the queue entry of an account last
+// active at the given height
+func InactiveAccountQueueKey(height int64, addr sdk.AccAddress) []byte {
+	return append(InactiveAccountQueueHeightKey(height), addr.Bytes()...)
+}
+
+// SplitInactiveAccountQueueKey returns the height and the address of an
+// inactive account queue key
+func SplitInactiveAccountQueueKey(key []byte) (int64, sdk.AccAddress) {
+	height := int64(binary.BigEndian.Uint64(key[1:9]))
+	return height, sdk.AccAddress(key[9:])
+}
+
+// TombstoneKey returns the key of the tombstone of a pruned account
+func TombstoneKey(addr sdk.AccAddress) []byte {
+	return append(TombstoneKeyPrefix, addr.Bytes()...)
+}
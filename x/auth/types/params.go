@@ -19,6 +19,9 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+
+	// DefaultAccountPruningBlocks disables account pruning
+	DefaultAccountPruningBlocks uint64 = 0
 )
 
 // Parameter keys
@@ -28,6 +31,7 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyAccountPruningBlocks   = []byte("AccountPruningBlocks")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -39,11 +43,16 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `json:"tx_size_cost_per_byte" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `json:"sig_verify_cost_ed25519" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `json:"sig_verify_cost_secp256k1" yaml:"sig_verify_cost_secp256k1"`
+
+	// AccountPruningBlocks is the number of blocks after which an inactive
+	// account without balance, delegations nor vesting state is pruned. Zero
+	// disables account pruning.
+	AccountPruningBlocks uint64 `json:"account_pruning_blocks" yaml:"account_pruning_blocks"`
 }
 
 // NewParams creates a new Params object
 func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte,
-	sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64) Params {
+	sigVerifyCostED25519, sigVerifyCostSecp256k1, accountPruningBlocks uint64) Params {
 
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -51,6 +60,7 @@ func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte,
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		AccountPruningBlocks:   accountPruningBlocks,
 	}
 }
 
@@ -69,6 +79,7 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		params.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		params.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		params.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		params.NewParamSetPair(KeyAccountPruningBlocks, &p.AccountPruningBlocks, validateAccountPruningBlocks),
 	}
 }
 
@@ -87,6 +98,7 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		AccountPruningBlocks:   DefaultAccountPruningBlocks,
 	}
 }
 
@@ -99,6 +111,7 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("TxSizeCostPerByte: %d\n", p.TxSizeCostPerByte))
 	sb.WriteString(fmt.Sprintf("SigVerifyCostED25519: %d\n", p.SigVerifyCostED25519))
 	sb.WriteString(fmt.Sprintf("SigVerifyCostSecp256k1: %d\n", p.SigVerifyCostSecp256k1))
+	sb.WriteString(fmt.Sprintf("AccountPruningBlocks: %d\n", p.AccountPruningBlocks))
 	return sb.String()
 }
 
@@ -167,6 +180,15 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateAccountPruningBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
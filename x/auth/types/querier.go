@@ -6,7 +6,8 @@ import (
 
 // query endpoints supported by the auth Querier
 const (
-	QueryAccount = "account"
+	QueryAccount   = "account"
+	QueryTombstone = "tombstone"
 )
 
 // QueryAccountParams defines the params for querying accounts.
@@ -18,3 +19,20 @@ type QueryAccountParams struct {
 func NewQueryAccountParams(addr sdk.AccAddress) QueryAccountParams {
 	return QueryAccountParams{Address: addr}
 }
+
+// QueryTombstoneParams defines the params for querying the tombstone of a
+// pruned account.
+type QueryTombstoneParams struct {
+	Address sdk.AccAddress
+}
+
+// NewQueryTombstoneParams creates a new instance of QueryTombstoneParams.
+func NewQueryTombstoneParams(addr sdk.AccAddress) QueryTombstoneParams {
+	return QueryTombstoneParams{Address: addr}
+}
+
+// RegisterQueryService registers the auth gRPC query service with the given
+// server.
+func RegisterQueryService(server sdk.GRPCServer, srv QueryServer) {
+	server.RegisterService(&_Query_serviceDesc, srv)
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SignMode defines the mode in which a signer produced a signature, i.e. which
+// bytes of the transaction were signed.
+type SignMode byte
+
+// Sign modes
+const (
+	// SignModeUnspecified is the sign mode of signatures which do not set one,
+	// they are verified using the default mode of the sign mode handler.
+	SignModeUnspecified SignMode = 0x00
+	// SignModeDirect signs over the SignDoc of the encoded transaction body and
+	// auth info.
+	SignModeDirect SignMode = 0x01
+	// SignModeTextual is reserved for a human readable textual representation
+	// of the transaction. It is not yet supported by any sign mode handler.
+	SignModeTextual SignMode = 0x02
+	// SignModeLegacyAminoJSON signs over the canonical Amino JSON of the
+	// StdSignDoc.
+	SignModeLegacyAminoJSON SignMode = 0x7f
+)
+
+// SignModeFromString returns a SignMode from a string. It returns an error if
+// the string is invalid.
+func SignModeFromString(str string) (SignMode, error) {
+	switch str {
+	case "":
+		return SignModeUnspecified, nil
+
+	case "direct":
+		return SignModeDirect, nil
+
+	case "textual":
+		return SignModeTextual, nil
+
+	case "amino-json":
+		return SignModeLegacyAminoJSON, nil
+
+	default:
+		return SignModeUnspecified, fmt.Errorf("'%s' is not a valid sign mode", str)
+	}
+}
+
+// String implements the Stringer interface.
+func (m SignMode) String() string {
+	switch m {
+	case SignModeDirect:
+		return "direct"
+	case SignModeTextual:
+		return "textual"
+	case SignModeLegacyAminoJSON:
+		return "amino-json"
+	case SignModeUnspecified:
+		return ""
+	default:
+		return fmt.Sprintf("SignMode(%d)", byte(m))
+	}
+}
+
+// MarshalJSON marshals the sign mode to JSON using its string representation.
+func (m SignMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON decodes a sign mode from its JSON string representation.
+func (m *SignMode) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	mode, err := SignModeFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*m = mode
+	return nil
+}
+
+// DirectSignBytes returns the bytes to sign for a transaction in the direct
+// sign mode. The body bytes are the transaction encoded with only its messages,
+// memo and timeout height set, while the auth info bytes are the binary encoded
+// fee.
+func DirectSignBytes(
+	txEncoder sdk.TxEncoder, chainID string, accnum, sequence, timeoutHeight uint64,
+	fee StdFee, msgs []sdk.Msg, memo string,
+) ([]byte, error) {
+
+	if txEncoder == nil {
+		return nil, fmt.Errorf("a tx encoder is required to sign in %s mode", SignModeDirect)
+	}
+
+	bodyBytes, err := txEncoder(StdTx{Msgs: msgs, Memo: memo, TimeoutHeight: timeoutHeight})
+	if err != nil {
+		return nil, err
+	}
+
+	authInfoBytes, err := ModuleCdc.MarshalBinaryBare(fee)
+	if err != nil {
+		return nil, err
+	}
+
+	signDoc := SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainID:       chainID,
+		AccountNumber: accnum,
+		Sequence:      sequence,
+	}
+
+	return signDoc.Marshal()
+}
@@ -0,0 +1,470 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: x/auth/types/signing.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SignDoc is the document signed over in the direct sign mode. Instead of the
+// canonical JSON of the StdSignDoc, it commits to the encoded body (messages
+// and memo) and auth info (fee) of the transaction.
+type SignDoc struct {
+	BodyBytes     []byte `protobuf:"bytes,1,opt,name=body_bytes,json=bodyBytes,proto3" json:"body_bytes,omitempty"`
+	AuthInfoBytes []byte `protobuf:"bytes,2,opt,name=auth_info_bytes,json=authInfoBytes,proto3" json:"auth_info_bytes,omitempty"`
+	ChainID       string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AccountNumber uint64 `protobuf:"varint,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Sequence      uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *SignDoc) Reset()         { *m = SignDoc{} }
+func (m *SignDoc) String() string { return proto.CompactTextString(m) }
+func (*SignDoc) ProtoMessage()    {}
+func (*SignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_202c812fb6e83558, []int{0}
+}
+func (m *SignDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignDoc.Merge(m, src)
+}
+func (m *SignDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignDoc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignDoc proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SignDoc)(nil), "cosmos_sdk.x.auth.v1.SignDoc")
+}
+
+func init() { proto.RegisterFile("x/auth/types/signing.proto", fileDescriptor_202c812fb6e83558) }
+
+var fileDescriptor_202c812fb6e83558 = []byte{
+	// 285 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
+	0x18, 0x80, 0x1b, 0x9d, 0x6e, 0x8b, 0x4e, 0xa1, 0xec, 0x50, 0x06, 0xc6, 0x21, 0x28, 0xf3, 0x60,
+	0x83, 0xf8, 0x06, 0x73, 0x20, 0xbb, 0x78, 0x98, 0x37, 0x2f, 0xa5, 0x4d, 0xb3, 0x34, 0x8c, 0xe6,
+	0x9f, 0x4b, 0x2a, 0xeb, 0x5b, 0xf8, 0x3e, 0xbe, 0xc0, 0x8e, 0x3b, 0x7a, 0x12, 0x6d, 0x5f, 0x44,
+	0x92, 0x55, 0xf1, 0x94, 0xfc, 0xdf, 0xff, 0xf1, 0x1f, 0x3e, 0x3c, 0x58, 0xd3, 0xb8, 0x30, 0x19,
+	0x35, 0xe5, 0x92, 0x6b, 0xaa, 0xa5, 0x50, 0x52, 0x89, 0x70, 0xb9, 0x02, 0x03, 0x7e, 0x9f, 0x81,
+	0xce, 0x41, 0x47, 0x3a, 0x5d, 0x84, 0xeb, 0xd0, 0x6a, 0xe1, 0xeb, 0xed, 0xa0, 0x2f, 0x40, 0x80,
+	0x13, 0xa8, 0xfd, 0xed, 0xdc, 0x8b, 0x77, 0x84, 0xdb, 0x4f, 0x52, 0xa8, 0x09, 0x30, 0xff, 0x0c,
+	0xe3, 0x04, 0xd2, 0x32, 0x4a, 0x4a, 0xc3, 0x75, 0x80, 0x86, 0x68, 0x74, 0x3c, 0xeb, 0x5a, 0x32,
+	0xb6, 0xc0, 0xbf, 0xc2, 0xa7, 0xf6, 0x56, 0x24, 0xd5, 0x1c, 0x1a, 0x67, 0xcf, 0x39, 0x3d, 0x8b,
+	0xa7, 0x6a, 0x0e, 0xbf, 0x5e, 0x87, 0x65, 0xb1, 0x54, 0x91, 0x4c, 0x83, 0xfd, 0x21, 0x1a, 0x75,
+	0xc7, 0x47, 0xd5, 0xe7, 0x79, 0xfb, 0xde, 0xb2, 0xe9, 0x64, 0xd6, 0x76, 0xcb, 0x69, 0xea, 0x5f,
+	0xe2, 0x93, 0x98, 0x31, 0x28, 0x94, 0x89, 0x54, 0x91, 0x27, 0x7c, 0x15, 0xb4, 0x86, 0x68, 0xd4,
+	0x9a, 0xf5, 0x1a, 0xfa, 0xe8, 0xa0, 0x3f, 0xc0, 0x1d, 0xcd, 0x5f, 0x0a, 0xae, 0x18, 0x0f, 0x0e,
+	0x9c, 0xf0, 0x37, 0x8f, 0x1f, 0x36, 0xdf, 0xc4, 0xdb, 0x54, 0x04, 0x6d, 0x2b, 0x82, 0xbe, 0x2a,
+	0x82, 0xde, 0x6a, 0xe2, 0x6d, 0x6b, 0xe2, 0x7d, 0xd4, 0xc4, 0x7b, 0xbe, 0x16, 0xd2, 0x64, 0x45,
+	0x12, 0x32, 0xc8, 0xe9, 0x2e, 0x49, 0xf3, 0xdc, 0xe8, 0x74, 0x41, 0xff, 0x07, 0x4c, 0x0e, 0x5d,
+	0x8d, 0xbb, 0x9f, 0x01, 0x00, 0x77, 0x99, 0xbd, 0x0c, 0x57, 0x01, 0x00, 0x00,
+}
+
+func (m *SignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintSigning(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintSigning(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AuthInfoBytes) > 0 {
+		i -= len(m.AuthInfoBytes)
+		copy(dAtA[i:], m.AuthInfoBytes)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.AuthInfoBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BodyBytes) > 0 {
+		i -= len(m.BodyBytes)
+		copy(dAtA[i:], m.BodyBytes)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.BodyBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSigning(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSigning(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BodyBytes)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	l = len(m.AuthInfoBytes)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovSigning(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovSigning(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovSigning(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSigning(x uint64) (n int) {
+	return sovSigning(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SignDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BodyBytes = append(m.BodyBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.BodyBytes == nil {
+				m.BodyBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfoBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthInfoBytes = append(m.AuthInfoBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.AuthInfoBytes == nil {
+				m.AuthInfoBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSigning(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSigning
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSigning
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSigning
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSigning        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSigning          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSigning = fmt.Errorf("proto: unexpected end of group")
+)
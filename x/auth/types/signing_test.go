@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignModeFromString(t *testing.T) {
+	for _, mode := range []SignMode{SignModeUnspecified, SignModeDirect, SignModeTextual, SignModeLegacyAminoJSON} {
+		res, err := SignModeFromString(mode.String())
+		require.NoError(t, err)
+		require.Equal(t, mode, res)
+
+		bz, err := ModuleCdc.MarshalJSON(mode)
+		require.NoError(t, err)
+		require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &res))
+		require.Equal(t, mode, res)
+	}
+
+	_, err := SignModeFromString("foo")
+	require.Error(t, err)
+}
+
+func TestStdSignatureSignMode(t *testing.T) {
+	priv, _, _ := KeyTestPubAddr()
+	sig := StdSignature{PubKey: priv.PubKey(), Signature: []byte("sig")}
+
+	// signatures without a sign mode keep their encoding
+	bz, err := ModuleCdc.MarshalJSON(sig)
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), "sign_mode")
+
+	sig.SignMode = SignModeDirect
+	bz, err = ModuleCdc.MarshalJSON(sig)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"sign_mode":"direct"`)
+
+	var res StdSignature
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &res))
+	require.Equal(t, sig, res)
+
+	bz, err = ModuleCdc.MarshalBinaryBare(sig)
+	require.NoError(t, err)
+	res = StdSignature{}
+	require.NoError(t, ModuleCdc.UnmarshalBinaryBare(bz, &res))
+	require.Equal(t, sig, res)
+}
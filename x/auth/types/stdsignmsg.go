@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,9 +16,27 @@ type StdSignMsg struct {
 	Fee           StdFee    `json:"fee" yaml:"fee"`
 	Msgs          []sdk.Msg `json:"msgs" yaml:"msgs"`
 	Memo          string    `json:"memo" yaml:"memo"`
+	TimeoutHeight uint64    `json:"timeout_height,omitempty" yaml:"timeout_height,omitempty"`
 }
 
 // get message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.TimeoutHeight, msg.Fee, msg.Msgs, msg.Memo)
+}
+
+// SignBytes returns the bytes to sign in the given sign mode. The tx encoder is
+// only required by the direct sign mode.
+func (msg StdSignMsg) SignBytes(mode SignMode, txEncoder sdk.TxEncoder) ([]byte, error) {
+	switch mode {
+	case SignModeUnspecified, SignModeLegacyAminoJSON:
+		return msg.Bytes(), nil
+
+	case SignModeDirect:
+		return DirectSignBytes(
+			txEncoder, msg.ChainID, msg.AccountNumber, msg.Sequence, msg.TimeoutHeight, msg.Fee, msg.Msgs, msg.Memo,
+		)
+
+	default:
+		return nil, fmt.Errorf("unsupported sign mode: %s", mode)
+	}
 }
@@ -21,11 +21,13 @@ var (
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
 // NOTE: the first signature is the fee payer (Signatures must not be nil).
+// A non-zero TimeoutHeight is the last block height the tx may be included at.
 type StdTx struct {
-	Msgs       []sdk.Msg      `json:"msg" yaml:"msg"`
-	Fee        StdFee         `json:"fee" yaml:"fee"`
-	Signatures []StdSignature `json:"signatures" yaml:"signatures"`
-	Memo       string         `json:"memo" yaml:"memo"`
+	Msgs          []sdk.Msg      `json:"msg" yaml:"msg"`
+	Fee           StdFee         `json:"fee" yaml:"fee"`
+	Signatures    []StdSignature `json:"signatures" yaml:"signatures"`
+	Memo          string         `json:"memo" yaml:"memo"`
+	TimeoutHeight uint64         `json:"timeout_height,omitempty" yaml:"timeout_height,omitempty"`
 }
 
 func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
@@ -95,6 +97,25 @@ func (tx StdTx) GetSigners() []sdk.AccAddress {
 	return signers
 }
 
+// GetMissingSigners returns the signers, in the order returned by GetSigners,
+// that have not signed the transaction yet. Signatures are matched to signers
+// by position, and a signature counts if it is non-empty and its public key, if
+// included, belongs to the signer.
+func (tx StdTx) GetMissingSigners() []sdk.AccAddress {
+	var missing []sdk.AccAddress
+	for i, signer := range tx.GetSigners() {
+		if i < len(tx.Signatures) {
+			sig := tx.Signatures[i]
+			if len(sig.Signature) != 0 && (sig.PubKey == nil || signer.Equals(sdk.AccAddress(sig.PubKey.Address()))) {
+				continue
+			}
+		}
+
+		missing = append(missing, signer)
+	}
+	return missing
+}
+
 // GetMemo returns the memo
 func (tx StdTx) GetMemo() string { return tx.Memo }
 
@@ -123,6 +144,16 @@ func (tx StdTx) GetPubKeys() []crypto.PubKey {
 	return pks
 }
 
+// GetSignModes returns the sign modes of the signatures of the tx, in the same
+// order as the signatures.
+func (tx StdTx) GetSignModes() []SignMode {
+	modes := make([]SignMode, len(tx.Signatures))
+	for i, stdSig := range tx.Signatures {
+		modes[i] = stdSig.SignMode
+	}
+	return modes
+}
+
 // GetSignBytes returns the signBytes of the tx for a given signer
 func (tx StdTx) GetSignBytes(ctx sdk.Context, acc exported.Account) []byte {
 	genesis := ctx.BlockHeight() == 0
@@ -133,10 +164,14 @@ func (tx StdTx) GetSignBytes(ctx sdk.Context, acc exported.Account) []byte {
 	}
 
 	return StdSignBytes(
-		chainID, accNum, acc.GetSequence(), tx.Fee, tx.Msgs, tx.Memo,
+		chainID, accNum, acc.GetSequence(), tx.TimeoutHeight, tx.Fee, tx.Msgs, tx.Memo,
 	)
 }
 
+// GetTimeoutHeight returns the height after which the tx is no longer valid,
+// or zero if it does not time out.
+func (tx StdTx) GetTimeoutHeight() uint64 { return tx.TimeoutHeight }
+
 // GetGas returns the Gas in StdFee
 func (tx StdTx) GetGas() uint64 { return tx.Fee.Gas }
 
@@ -153,14 +188,21 @@ func (tx StdTx) FeePayer() sdk.AccAddress {
 	return sdk.AccAddress{}
 }
 
+// FeeGranter returns the address of the account that granted the fee allowance
+// used to pay the fee, if any. It is empty when the fee payer pays for itself.
+func (tx StdTx) FeeGranter() sdk.AccAddress { return tx.Fee.Granter }
+
 //__________________________________________________________
 
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
-// which must be above some miminum to be accepted into the mempool.
+// which must be above some miminum to be accepted into the mempool. If a granter
+// is set, the fee is paid from the granter's account using a fee allowance
+// granted to the fee payer.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount" yaml:"amount"`
-	Gas    uint64    `json:"gas" yaml:"gas"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+	Gas     uint64         `json:"gas" yaml:"gas"`
+	Granter sdk.AccAddress `json:"granter,omitempty" yaml:"granter,omitempty"`
 }
 
 // NewStdFee returns a new instance of StdFee
@@ -210,10 +252,12 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo" yaml:"memo"`
 	Msgs          []json.RawMessage `json:"msgs" yaml:"msgs"`
 	Sequence      uint64            `json:"sequence" yaml:"sequence"`
+	TimeoutHeight uint64            `json:"timeout_height,omitempty" yaml:"timeout_height,omitempty"`
 }
 
-// StdSignBytes returns the bytes to sign for a transaction.
-func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+// StdSignBytes returns the bytes to sign for a transaction. A zero timeout
+// height is omitted, so that the bytes of txs without one are unchanged.
+func StdSignBytes(chainID string, accnum, sequence, timeoutHeight uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
 	msgsBytes := make([]json.RawMessage, 0, len(msgs))
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
@@ -225,6 +269,7 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, ms
 		Memo:          memo,
 		Msgs:          msgsBytes,
 		Sequence:      sequence,
+		TimeoutHeight: timeoutHeight,
 	})
 	if err != nil {
 		panic(err)
@@ -232,10 +277,12 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, ms
 	return sdk.MustSortJSON(bz)
 }
 
-// StdSignature represents a sig
+// StdSignature represents a sig. The sign mode determines the bytes that were
+// signed, signatures without one use the default mode of the verifier.
 type StdSignature struct {
 	crypto.PubKey `json:"pub_key" yaml:"pub_key"` // optional
 	Signature     []byte                          `json:"signature" yaml:"signature"`
+	SignMode      SignMode                        `json:"sign_mode,omitempty" yaml:"sign_mode,omitempty"`
 }
 
 // DefaultTxDecoder logic for standard transaction decoding
@@ -35,12 +35,13 @@ func TestStdTx(t *testing.T) {
 
 func TestStdSignBytes(t *testing.T) {
 	type args struct {
-		chainID  string
-		accnum   uint64
-		sequence uint64
-		fee      StdFee
-		msgs     []sdk.Msg
-		memo     string
+		chainID       string
+		accnum        uint64
+		sequence      uint64
+		timeoutHeight uint64
+		fee           StdFee
+		msgs          []sdk.Msg
+		memo          string
 	}
 	defaultFee := NewTestStdFee()
 	tests := []struct {
@@ -48,12 +49,18 @@ func TestStdSignBytes(t *testing.T) {
 		want string
 	}{
 		{
-			args{"1234", 3, 6, defaultFee, []sdk.Msg{sdk.NewTestMsg(addr)}, "memo"},
+			args{"1234", 3, 6, 0, defaultFee, []sdk.Msg{sdk.NewTestMsg(addr)}, "memo"},
 			fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150\",\"denom\":\"atom\"}],\"gas\":\"100000\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"sequence\":\"6\"}", addr),
 		},
+		{
+			args{"1234", 3, 6, 10, defaultFee, []sdk.Msg{sdk.NewTestMsg(addr)}, "memo"},
+			fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150\",\"denom\":\"atom\"}],\"gas\":\"100000\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"sequence\":\"6\",\"timeout_height\":\"10\"}", addr),
+		},
 	}
 	for i, tc := range tests {
-		got := string(StdSignBytes(tc.args.chainID, tc.args.accnum, tc.args.sequence, tc.args.fee, tc.args.msgs, tc.args.memo))
+		got := string(StdSignBytes(
+			tc.args.chainID, tc.args.accnum, tc.args.sequence, tc.args.timeoutHeight, tc.args.fee, tc.args.msgs, tc.args.memo,
+		))
 		require.Equal(t, tc.want, got, "Got unexpected result on test case i: %d", i)
 	}
 }
@@ -162,3 +169,31 @@ func TestStdSignatureMarshalYAML(t *testing.T) {
 		require.Equal(t, tc.output, string(bz), "test case #%d", i)
 	}
 }
+
+func TestStdTxGetMissingSigners(t *testing.T) {
+	priv1, _, addr1 := KeyTestPubAddr()
+	priv2, _, addr2 := KeyTestPubAddr()
+	msgs := []sdk.Msg{sdk.NewTestMsg(addr1, addr2)}
+	fee := NewTestStdFee()
+
+	testCases := []struct {
+		name    string
+		sigs    []StdSignature
+		missing []sdk.AccAddress
+	}{
+		{"no signatures", nil, []sdk.AccAddress{addr1, addr2}},
+		{"first signer signed", []StdSignature{{PubKey: priv1.PubKey(), Signature: []byte("sig")}}, []sdk.AccAddress{addr2}},
+		{"empty signature", []StdSignature{{PubKey: priv1.PubKey()}}, []sdk.AccAddress{addr1, addr2}},
+		{"signature of another signer", []StdSignature{{PubKey: priv2.PubKey(), Signature: []byte("sig")}}, []sdk.AccAddress{addr1, addr2}},
+		{"signature without pubkey", []StdSignature{{Signature: []byte("sig")}}, []sdk.AccAddress{addr2}},
+		{
+			"all signers signed",
+			[]StdSignature{{PubKey: priv1.PubKey(), Signature: []byte("sig")}, {PubKey: priv2.PubKey(), Signature: []byte("sig")}},
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		tx := NewStdTx(msgs, fee, tc.sigs, "")
+		require.Equal(t, tc.missing, tx.GetMissingSigners(), tc.name)
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"errors"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTombstone returns a new Tombstone for an account pruned at the given height.
+func NewTombstone(addr sdk.AccAddress, accountNumber, sequence uint64, height int64) Tombstone {
+	return Tombstone{
+		Address:       addr,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+		Height:        height,
+	}
+}
+
+// Validate performs a basic validation of the tombstone fields.
+func (t Tombstone) Validate() error {
+	if t.Address.Empty() {
+		return errors.New("tombstone address cannot be empty")
+	}
+	if t.Height < 0 {
+		return errors.New("tombstone height cannot be negative")
+	}
+
+	return nil
+}
+
+func (t Tombstone) String() string {
+	out, _ := yaml.Marshal(t)
+	return string(out)
+}
@@ -28,6 +28,9 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeGranter         sdk.AccAddress
+	signMode           SignMode
+	timeoutHeight      uint64
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -67,11 +70,26 @@ func NewTxBuilderFromCLI(input io.Reader) TxBuilder {
 		simulateAndExecute: flags.GasFlagVar.Simulate,
 		chainID:            viper.GetString(flags.FlagChainID),
 		memo:               viper.GetString(flags.FlagMemo),
+		timeoutHeight:      viper.GetUint64(flags.FlagTimeoutHeight),
 	}
 
 	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
 
+	signMode, err := SignModeFromString(viper.GetString(flags.FlagSignMode))
+	if err != nil {
+		panic(err)
+	}
+	txbldr = txbldr.WithSignMode(signMode)
+
+	if feeAccount := viper.GetString(flags.FlagFeeAccount); feeAccount != "" {
+		granter, err := sdk.AccAddressFromBech32(feeAccount)
+		if err != nil {
+			panic(err)
+		}
+		txbldr = txbldr.WithFeeGranter(granter)
+	}
+
 	return txbldr
 }
 
@@ -109,6 +127,16 @@ func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 // GasPrices returns the gas prices set for the transaction, if any.
 func (bldr TxBuilder) GasPrices() sdk.DecCoins { return bldr.gasPrices }
 
+// FeeGranter returns the account paying the fee through a fee allowance, if any.
+func (bldr TxBuilder) FeeGranter() sdk.AccAddress { return bldr.feeGranter }
+
+// SignMode returns the mode in which the transaction is signed.
+func (bldr TxBuilder) SignMode() SignMode { return bldr.signMode }
+
+// TimeoutHeight returns the height after which the transaction is no longer
+// valid, or zero if it does not time out.
+func (bldr TxBuilder) TimeoutHeight() uint64 { return bldr.timeoutHeight }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -149,6 +177,25 @@ func (bldr TxBuilder) WithGasPrices(gasPrices string) TxBuilder {
 	return bldr
 }
 
+// WithFeeGranter returns a copy of the context with an updated fee granter.
+func (bldr TxBuilder) WithFeeGranter(granter sdk.AccAddress) TxBuilder {
+	bldr.feeGranter = granter
+	return bldr
+}
+
+// WithSignMode returns a copy of the context with an updated sign mode. Signing
+// in the direct sign mode requires a tx encoder to be set.
+func (bldr TxBuilder) WithSignMode(mode SignMode) TxBuilder {
+	bldr.signMode = mode
+	return bldr
+}
+
+// WithTimeoutHeight returns a copy of the context with an updated timeout height.
+func (bldr TxBuilder) WithTimeoutHeight(height uint64) TxBuilder {
+	bldr.timeoutHeight = height
+	return bldr
+}
+
 // WithKeybase returns a copy of the context with updated keybase.
 func (bldr TxBuilder) WithKeybase(keybase crkeys.Keybase) TxBuilder {
 	bldr.keybase = keybase
@@ -198,25 +245,32 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		}
 	}
 
+	fee := NewStdFee(bldr.gas, fees)
+	fee.Granter = bldr.feeGranter
+
 	return StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
 		Msgs:          msgs,
-		Fee:           NewStdFee(bldr.gas, fees),
+		Fee:           fee,
+		TimeoutHeight: bldr.timeoutHeight,
 	}, nil
 }
 
 // Sign signs a transaction given a name, passphrase, and a single message to
 // signed. An error is returned if signing fails.
 func (bldr TxBuilder) Sign(name, passphrase string, msg StdSignMsg) ([]byte, error) {
-	sig, err := MakeSignature(bldr.keybase, name, passphrase, msg)
+	sig, err := makeSignature(bldr.keybase, name, passphrase, msg, bldr.signMode, bldr.txEncoder)
 	if err != nil {
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msgs, msg.Fee, []StdSignature{sig}, msg.Memo))
+	stdTx := NewStdTx(msg.Msgs, msg.Fee, []StdSignature{sig}, msg.Memo)
+	stdTx.TimeoutHeight = msg.TimeoutHeight
+
+	return bldr.txEncoder(stdTx)
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -240,7 +294,10 @@ func (bldr TxBuilder) BuildTxForSim(msgs []sdk.Msg) ([]byte, error) {
 
 	// the ante handler will populate with a sentinel pubkey
 	sigs := []StdSignature{{}}
-	return bldr.txEncoder(NewStdTx(signMsg.Msgs, signMsg.Fee, sigs, signMsg.Memo))
+	stdTx := NewStdTx(signMsg.Msgs, signMsg.Fee, sigs, signMsg.Memo)
+	stdTx.TimeoutHeight = signMsg.TimeoutHeight
+
+	return bldr.txEncoder(stdTx)
 }
 
 // SignStdTx appends a signature to a StdTx and returns a copy of it. If append
@@ -250,14 +307,15 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig
 		return StdTx{}, fmt.Errorf("chain ID required but not specified")
 	}
 
-	stdSignature, err := MakeSignature(bldr.keybase, name, passphrase, StdSignMsg{
+	stdSignature, err := makeSignature(bldr.keybase, name, passphrase, StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
 		Fee:           stdTx.Fee,
 		Msgs:          stdTx.GetMsgs(),
 		Memo:          stdTx.GetMemo(),
-	})
+		TimeoutHeight: stdTx.TimeoutHeight,
+	}, bldr.signMode, bldr.txEncoder)
 	if err != nil {
 		return
 	}
@@ -269,12 +327,136 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig
 		sigs = append(sigs, stdSignature)
 	}
 	signedStdTx = NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo())
+	signedStdTx.TimeoutHeight = stdTx.TimeoutHeight
 	return
 }
 
+// ComposeStdTx merges the messages of the given transactions, in order, into a
+// single unsigned StdTx. Its signers are the union of the signers of the
+// transactions, in order of first appearance. Its fee and gas are the sums of
+// those of the transactions, and its memo and timeout height are those they
+// share, unless they are set on the builder. It returns an error if the
+// transactions have different memos, timeout heights or fee granters which are
+// not set on the builder.
+func (bldr TxBuilder) ComposeStdTx(txs ...StdTx) (StdTx, error) {
+	if len(txs) == 0 {
+		return StdTx{}, errors.New("no transactions to compose")
+	}
+
+	var (
+		msgs          []sdk.Msg
+		fee           StdFee
+		memo          string
+		timeoutHeight uint64
+	)
+
+	for i, tx := range txs {
+		msgs = append(msgs, tx.GetMsgs()...)
+		fee.Amount = fee.Amount.Add(tx.Fee.Amount)
+		fee.Gas += tx.Fee.Gas
+
+		if i == 0 {
+			memo, timeoutHeight, fee.Granter = tx.Memo, tx.TimeoutHeight, tx.Fee.Granter
+			continue
+		}
+
+		if tx.Memo != memo && bldr.memo == "" {
+			return StdTx{}, fmt.Errorf("transaction %d has memo %q, expected %q", i, tx.Memo, memo)
+		}
+		if tx.TimeoutHeight != timeoutHeight && bldr.timeoutHeight == 0 {
+			return StdTx{}, fmt.Errorf("transaction %d has timeout height %d, expected %d", i, tx.TimeoutHeight, timeoutHeight)
+		}
+		if !tx.Fee.Granter.Equals(fee.Granter) && bldr.feeGranter.Empty() {
+			return StdTx{}, fmt.Errorf("transaction %d has fee granter %s, expected %s", i, tx.Fee.Granter, fee.Granter)
+		}
+	}
+
+	if bldr.memo != "" {
+		memo = bldr.memo
+	}
+	if bldr.timeoutHeight != 0 {
+		timeoutHeight = bldr.timeoutHeight
+	}
+
+	fee, err := bldr.overrideFee(fee)
+	if err != nil {
+		return StdTx{}, err
+	}
+
+	stdTx := NewStdTx(msgs, fee, nil, memo)
+	stdTx.TimeoutHeight = timeoutHeight
+
+	return stdTx, nil
+}
+
+// AppendMsgs appends the given messages to a StdTx and returns an unsigned copy
+// of it. Existing signatures are dropped as they do not sign over the appended
+// messages. The fee, memo and timeout height of the StdTx are kept, unless set
+// on the builder.
+func (bldr TxBuilder) AppendMsgs(stdTx StdTx, msgs ...sdk.Msg) (StdTx, error) {
+	memo := stdTx.Memo
+	if bldr.memo != "" {
+		memo = bldr.memo
+	}
+
+	timeoutHeight := stdTx.TimeoutHeight
+	if bldr.timeoutHeight != 0 {
+		timeoutHeight = bldr.timeoutHeight
+	}
+
+	fee, err := bldr.overrideFee(stdTx.Fee)
+	if err != nil {
+		return StdTx{}, err
+	}
+
+	allMsgs := append(append([]sdk.Msg{}, stdTx.GetMsgs()...), msgs...)
+	appended := NewStdTx(allMsgs, fee, nil, memo)
+	appended.TimeoutHeight = timeoutHeight
+
+	return appended, nil
+}
+
+// overrideFee returns the fee with the gas, fees or gas prices and fee granter
+// of the builder applied, when set. Only a non-zero gas overrides the gas.
+func (bldr TxBuilder) overrideFee(fee StdFee) (StdFee, error) {
+	if bldr.gas != 0 {
+		fee.Gas = bldr.gas
+	}
+
+	switch {
+	case !bldr.fees.IsZero() && !bldr.gasPrices.IsZero():
+		return StdFee{}, errors.New("cannot provide both fees and gas prices")
+
+	case !bldr.fees.IsZero():
+		fee.Amount = bldr.fees
+
+	case !bldr.gasPrices.IsZero():
+		glDec := sdk.NewDec(int64(fee.Gas))
+
+		// fee = ceil(gasPrice * gasLimit)
+		fee.Amount = make(sdk.Coins, len(bldr.gasPrices))
+		for i, gp := range bldr.gasPrices {
+			fee.Amount[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+		}
+	}
+
+	if !bldr.feeGranter.Empty() {
+		fee.Granter = bldr.feeGranter
+	}
+
+	return fee, nil
+}
+
 // MakeSignature builds a StdSignature given keybase, key name, passphrase, and a StdSignMsg.
 func MakeSignature(keybase crkeys.Keybase, name, passphrase string,
 	msg StdSignMsg) (sig StdSignature, err error) {
+	return makeSignature(keybase, name, passphrase, msg, SignModeUnspecified, nil)
+}
+
+// makeSignature builds a StdSignature over the sign bytes of the StdSignMsg in
+// the given sign mode.
+func makeSignature(keybase crkeys.Keybase, name, passphrase string,
+	msg StdSignMsg, mode SignMode, txEncoder sdk.TxEncoder) (sig StdSignature, err error) {
 	if keybase == nil {
 		keybase, err = keys.NewKeyringFromHomeFlag(os.Stdin)
 		if err != nil {
@@ -282,12 +464,18 @@ func MakeSignature(keybase crkeys.Keybase, name, passphrase string,
 		}
 	}
 
-	sigBytes, pubkey, err := keybase.Sign(name, passphrase, msg.Bytes())
+	signBytes, err := msg.SignBytes(mode, txEncoder)
+	if err != nil {
+		return
+	}
+
+	sigBytes, pubkey, err := keybase.Sign(name, passphrase, signBytes)
 	if err != nil {
 		return
 	}
 	return StdSignature{
 		PubKey:    pubkey,
 		Signature: sigBytes,
+		SignMode:  mode,
 	}, nil
 }
@@ -146,3 +146,85 @@ func TestTxBuilderBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestTxBuilderComposeStdTx(t *testing.T) {
+	_, _, addr1 := KeyTestPubAddr()
+	_, _, addr2 := KeyTestPubAddr()
+	granter := sdk.AccAddress("granter")
+
+	tx1 := NewStdTx(
+		[]sdk.Msg{sdk.NewTestMsg(addr1)}, NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))), nil, "memo",
+	)
+	tx2 := NewStdTx(
+		[]sdk.Msg{sdk.NewTestMsg(addr2, addr1)}, NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("atom", 2))), nil, "memo",
+	)
+
+	bldr := TxBuilder{}
+	res, err := bldr.ComposeStdTx(tx1, tx2)
+	require.NoError(t, err)
+	require.Equal(t, append(tx1.GetMsgs(), tx2.GetMsgs()...), res.GetMsgs())
+	require.Equal(t, []sdk.AccAddress{addr1, addr2}, res.GetSigners())
+	require.Equal(t, []sdk.AccAddress{addr1, addr2}, res.GetMissingSigners())
+	require.Equal(t, NewStdFee(150000, sdk.NewCoins(sdk.NewInt64Coin("atom", 3))), res.Fee)
+	require.Equal(t, "memo", res.Memo)
+	require.Empty(t, res.Signatures)
+
+	// the builder overrides the fee, memo and fee granter
+	bldr = bldr.WithGas(300000).WithGasPrices("0.1atom").WithMemo("composed").WithFeeGranter(granter)
+	res, err = bldr.ComposeStdTx(tx1, tx2)
+	require.NoError(t, err)
+	require.Equal(t, StdFee{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 30000)), Gas: 300000, Granter: granter}, res.Fee)
+	require.Equal(t, "composed", res.Memo)
+
+	// conflicting memos and fee granters must be set on the builder
+	tx2.Memo = "other"
+	_, err = TxBuilder{}.ComposeStdTx(tx1, tx2)
+	require.Error(t, err)
+	_, err = TxBuilder{}.WithMemo("composed").ComposeStdTx(tx1, tx2)
+	require.NoError(t, err)
+
+	tx2.Memo = "memo"
+	tx2.Fee.Granter = granter
+	_, err = TxBuilder{}.ComposeStdTx(tx1, tx2)
+	require.Error(t, err)
+	_, err = TxBuilder{}.WithFeeGranter(granter).ComposeStdTx(tx1, tx2)
+	require.NoError(t, err)
+
+	tx2.Fee.Granter = nil
+	tx2.TimeoutHeight = 10
+	_, err = TxBuilder{}.ComposeStdTx(tx1, tx2)
+	require.Error(t, err)
+	res, err = TxBuilder{}.WithTimeoutHeight(20).ComposeStdTx(tx1, tx2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), res.TimeoutHeight)
+
+	_, err = TxBuilder{}.ComposeStdTx()
+	require.Error(t, err)
+	_, err = TxBuilder{}.WithFees("1atom").WithGasPrices("0.1atom").ComposeStdTx(tx1)
+	require.Error(t, err)
+}
+
+func TestTxBuilderAppendMsgs(t *testing.T) {
+	priv1, _, addr1 := KeyTestPubAddr()
+	_, _, addr2 := KeyTestPubAddr()
+
+	fee := NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))
+	sig := StdSignature{PubKey: priv1.PubKey(), Signature: []byte("signature")}
+	stdTx := NewStdTx([]sdk.Msg{sdk.NewTestMsg(addr1)}, fee, []StdSignature{sig}, "memo")
+	require.Empty(t, stdTx.GetMissingSigners())
+
+	msg := sdk.NewTestMsg(addr2)
+	res, err := TxBuilder{}.AppendMsgs(stdTx, msg)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{stdTx.GetMsgs()[0], msg}, res.GetMsgs())
+	require.Equal(t, fee, res.Fee)
+	require.Equal(t, "memo", res.Memo)
+	require.Empty(t, res.Signatures)
+	require.Equal(t, []sdk.AccAddress{addr1, addr2}, res.GetMissingSigners())
+	require.Len(t, stdTx.GetMsgs(), 1)
+
+	res, err = TxBuilder{}.WithFees("5atom").WithMemo("appended").AppendMsgs(stdTx, msg)
+	require.NoError(t, err)
+	require.Equal(t, NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", 5))), res.Fee)
+	require.Equal(t, "appended", res.Memo)
+}
@@ -8,26 +8,61 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
+const (
+	ModuleName                          = types.ModuleName
+	RouterKey                           = types.RouterKey
+	EventTypeCreateVestingAccount       = types.EventTypeCreateVestingAccount
+	AttributeKeyAccount                 = types.AttributeKeyAccount
+	AttributeKeyStartTime               = types.AttributeKeyStartTime
+	AttributeKeyEndTime                 = types.AttributeKeyEndTime
+	AttributeValueCategory              = types.AttributeValueCategory
+	TypeMsgCreateVestingAccount         = types.TypeMsgCreateVestingAccount
+	TypeMsgCreatePeriodicVestingAccount = types.TypeMsgCreatePeriodicVestingAccount
+	TypeMsgCreateClawbackVestingAccount = types.TypeMsgCreateClawbackVestingAccount
+	TypeMsgClawback                     = types.TypeMsgClawback
+	EventTypeClawback                   = types.EventTypeClawback
+	AttributeKeyFunder                  = types.AttributeKeyFunder
+	AttributeKeyAmount                  = types.AttributeKeyAmount
+	AttributeKeyUnbonding               = types.AttributeKeyUnbonding
+)
+
 var (
 	// functions aliases
-	RegisterCodec                  = types.RegisterCodec
-	NewBaseVestingAccount          = types.NewBaseVestingAccount
-	NewContinuousVestingAccountRaw = types.NewContinuousVestingAccountRaw
-	NewContinuousVestingAccount    = types.NewContinuousVestingAccount
-	NewPeriodicVestingAccountRaw   = types.NewPeriodicVestingAccountRaw
-	NewPeriodicVestingAccount      = types.NewPeriodicVestingAccount
-	NewDelayedVestingAccountRaw    = types.NewDelayedVestingAccountRaw
-	NewDelayedVestingAccount       = types.NewDelayedVestingAccount
+	RegisterCodec                      = types.RegisterCodec
+	NewBaseVestingAccount              = types.NewBaseVestingAccount
+	NewContinuousVestingAccountRaw     = types.NewContinuousVestingAccountRaw
+	NewContinuousVestingAccount        = types.NewContinuousVestingAccount
+	NewPeriodicVestingAccountRaw       = types.NewPeriodicVestingAccountRaw
+	NewPeriodicVestingAccount          = types.NewPeriodicVestingAccount
+	NewDelayedVestingAccountRaw        = types.NewDelayedVestingAccountRaw
+	NewDelayedVestingAccount           = types.NewDelayedVestingAccount
+	NewMsgCreateVestingAccount         = types.NewMsgCreateVestingAccount
+	NewMsgCreatePeriodicVestingAccount = types.NewMsgCreatePeriodicVestingAccount
+	NewClawbackVestingAccountRaw       = types.NewClawbackVestingAccountRaw
+	NewClawbackVestingAccount          = types.NewClawbackVestingAccount
+	NewMsgCreateClawbackVestingAccount = types.NewMsgCreateClawbackVestingAccount
+	NewMsgClawback                     = types.NewMsgClawback
+	NewPermanentLockedAccountRaw       = types.NewPermanentLockedAccountRaw
+	NewPermanentLockedAccount          = types.NewPermanentLockedAccount
+	NewCliffVestingAccountRaw          = types.NewCliffVestingAccountRaw
+	NewCliffVestingAccount             = types.NewCliffVestingAccount
 
 	// variable aliases
 	VestingCdc = types.VestingCdc
 )
 
 type (
-	BaseVestingAccount       = types.BaseVestingAccount
-	ContinuousVestingAccount = types.ContinuousVestingAccount
-	PeriodicVestingAccount   = types.PeriodicVestingAccount
-	DelayedVestingAccount    = types.DelayedVestingAccount
-	Period                   = types.Period
-	Periods                  = types.Periods
+	BaseVestingAccount              = types.BaseVestingAccount
+	ContinuousVestingAccount        = types.ContinuousVestingAccount
+	PeriodicVestingAccount          = types.PeriodicVestingAccount
+	DelayedVestingAccount           = types.DelayedVestingAccount
+	Period                          = types.Period
+	Periods                         = types.Periods
+	MsgCreateVestingAccount         = types.MsgCreateVestingAccount
+	MsgCreatePeriodicVestingAccount = types.MsgCreatePeriodicVestingAccount
+	ClawbackVestingAccount          = types.ClawbackVestingAccount
+	MsgCreateClawbackVestingAccount = types.MsgCreateClawbackVestingAccount
+	MsgClawback                     = types.MsgClawback
+	PermanentLockedAccount          = types.PermanentLockedAccount
+	CliffVestingAccount             = types.CliffVestingAccount
 )
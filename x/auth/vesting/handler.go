@@ -0,0 +1,253 @@
+package vesting
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// NewHandler returns a handler for "vesting" type messages.
+func NewHandler(ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, ak, bk, msg)
+
+		case types.MsgCreatePeriodicVestingAccount:
+			return handleMsgCreatePeriodicVestingAccount(ctx, ak, bk, msg)
+
+		case types.MsgCreateClawbackVestingAccount:
+			return handleMsgCreateClawbackVestingAccount(ctx, ak, bk, msg)
+
+		case types.MsgClawback:
+			return handleMsgClawback(ctx, ak, bk, sk, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// Handle MsgCreateVestingAccount.
+func handleMsgCreateVestingAccount(
+	ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, msg types.MsgCreateVestingAccount,
+) sdk.Result {
+	startTime := ctx.BlockTime().Unix()
+	if msg.EndTime <= startTime {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("end time %d must be after the current block time %d", msg.EndTime, startTime),
+		).Result()
+	}
+
+	baseAcc, err := newBaseVestingAccount(ctx, ak, bk, msg.ToAddress, msg.Amount, msg.EndTime)
+	if err != nil {
+		return err.Result()
+	}
+
+	var acc exported.VestingAccount
+	if msg.Delayed {
+		acc = types.NewDelayedVestingAccountRaw(baseAcc)
+	} else {
+		acc = types.NewContinuousVestingAccountRaw(baseAcc, startTime)
+	}
+
+	return createVestingAccount(ctx, ak, bk, msg.FromAddress, acc)
+}
+
+// Handle MsgCreatePeriodicVestingAccount.
+func handleMsgCreatePeriodicVestingAccount(
+	ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, msg types.MsgCreatePeriodicVestingAccount,
+) sdk.Result {
+	endTime := msg.StartTime + msg.VestingPeriods.TotalLength()
+
+	baseAcc, err := newBaseVestingAccount(
+		ctx, ak, bk, msg.ToAddress, msg.VestingPeriods.TotalAmount(), endTime,
+	)
+	if err != nil {
+		return err.Result()
+	}
+
+	acc := types.NewPeriodicVestingAccountRaw(baseAcc, msg.StartTime, msg.VestingPeriods)
+	return createVestingAccount(ctx, ak, bk, msg.FromAddress, acc)
+}
+
+// Handle MsgCreateClawbackVestingAccount.
+func handleMsgCreateClawbackVestingAccount(
+	ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, msg types.MsgCreateClawbackVestingAccount,
+) sdk.Result {
+	endTime := msg.StartTime + msg.VestingPeriods.TotalLength()
+
+	baseAcc, err := newBaseVestingAccount(
+		ctx, ak, bk, msg.ToAddress, msg.VestingPeriods.TotalAmount(), endTime,
+	)
+	if err != nil {
+		return err.Result()
+	}
+
+	acc := types.NewClawbackVestingAccountRaw(baseAcc, msg.FromAddress, msg.StartTime, msg.VestingPeriods)
+	return createVestingAccount(ctx, ak, bk, msg.FromAddress, acc)
+}
+
+// Handle MsgClawback. The unvested coins held by the account are sent to the
+// funder, while the unvested coins it delegated are unbonded to the funder.
+func handleMsgClawback(
+	ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, msg types.MsgClawback,
+) sdk.Result {
+	acc, ok := ak.GetAccount(ctx, msg.Address).(*types.ClawbackVestingAccount)
+	if !ok {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("account %s is not a clawback vesting account", msg.Address)).Result()
+	}
+
+	if !acc.GetFunder().Equals(msg.FunderAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not the funder of %s", msg.FunderAddress, msg.Address)).Result()
+	}
+
+	held, delegated := acc.Clawback(ctx.BlockTime())
+
+	// the account must be stored before sending its coins, as they are only
+	// spendable once its vesting periods have been removed
+	ak.SetAccount(ctx, acc)
+
+	if !held.IsZero() {
+		if err := bk.SendCoins(ctx, msg.Address, msg.FunderAddress, held); err != nil {
+			return err.Result()
+		}
+	}
+
+	unbonding, err := unbondTo(ctx, sk, msg.Address, msg.FunderAddress, delegated.AmountOf(sk.BondDenom(ctx)))
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, held.String()),
+			sdk.NewAttribute(types.AttributeKeyUnbonding, unbonding.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FunderAddress.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// unbondTo unbonds up to the given amount of tokens delegated by the delegator,
+// creating unbonding delegations for the recipient. It returns the amount of
+// tokens unbonding, which is less than the given amount if the delegations
+// have been slashed.
+func unbondTo(
+	ctx sdk.Context, sk types.StakingKeeper, delAddr, recipientAddr sdk.AccAddress, amount sdk.Int,
+) (sdk.Int, sdk.Error) {
+	unbonding := sdk.ZeroInt()
+	if !amount.IsPositive() {
+		return unbonding, nil
+	}
+
+	var delegations []stakingexported.DelegationI
+	sk.IterateDelegations(ctx, delAddr, func(_ int64, del stakingexported.DelegationI) (stop bool) {
+		delegations = append(delegations, del)
+		return false
+	})
+
+	for _, del := range delegations {
+		remaining := amount.Sub(unbonding)
+		if !remaining.IsPositive() {
+			break
+		}
+
+		shares := del.GetShares()
+		validator := sk.Validator(ctx, del.GetValidatorAddr())
+		if validator.TokensFromShares(shares).TruncateInt().GT(remaining) {
+			var err sdk.Error
+			shares, err = validator.SharesFromTokens(remaining)
+			if err != nil {
+				return unbonding, err
+			}
+		}
+
+		amt, _, err := sk.UndelegateTo(ctx, delAddr, del.GetValidatorAddr(), recipientAddr, shares)
+		if err != nil {
+			return unbonding, err
+		}
+
+		unbonding = unbonding.Add(amt)
+	}
+
+	return unbonding, nil
+}
+
+// newBaseVestingAccount returns a BaseVestingAccount for a new account at the
+// given address, vesting the given coins until the end time. The coins are not
+// yet transferred to the account.
+func newBaseVestingAccount(
+	ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	addr sdk.AccAddress, amount sdk.Coins, endTime int64,
+) (*types.BaseVestingAccount, sdk.Error) {
+	if err := bk.SendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
+	if bk.BlacklistedAddr(addr) {
+		return nil, sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", addr))
+	}
+
+	if ak.GetAccount(ctx, addr) != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("account %s already exists", addr))
+	}
+
+	acc := ak.NewAccountWithAddress(ctx, addr)
+	baseAcc, ok := acc.(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdk.ErrInternal(fmt.Sprintf("invalid account type; expected: BaseAccount, got: %T", acc))
+	}
+
+	return &types.BaseVestingAccount{
+		BaseAccount:      baseAcc,
+		OriginalVesting:  amount.Sort(),
+		DelegatedFree:    sdk.NewCoins(),
+		DelegatedVesting: sdk.NewCoins(),
+		EndTime:          endTime,
+	}, nil
+}
+
+// createVestingAccount stores the new vesting account and funds it with its
+// original vesting coins from the sender.
+func createVestingAccount(
+	ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	fromAddr sdk.AccAddress, acc exported.VestingAccount,
+) sdk.Result {
+	ak.SetAccount(ctx, acc)
+
+	if err := bk.SendCoins(ctx, fromAddr, acc.GetAddress(), acc.GetOriginalVesting()); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateVestingAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, acc.GetAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, fmt.Sprintf("%d", acc.GetStartTime())),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", acc.GetEndTime())),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, fromAddr.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,166 @@
+package vesting_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestInvalidMsg(t *testing.T) {
+	h := vesting.NewHandler(nil, nil, nil)
+
+	res := h(sdk.NewContext(nil, abci.Header{}, false, nil), sdk.NewTestMsg())
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "unrecognized vesting message type"))
+}
+
+func TestHandleMsgCreateVestingAccount(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: now})
+	handler := vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+
+	from := sdk.AccAddress("from________________")
+	to1 := sdk.AccAddress("to1_________________")
+	to2 := sdk.AccAddress("to2_________________")
+	balance := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+	require.NoError(t, app.BankKeeper.SetCoins(ctx, from, balance))
+
+	// the end time must be in the future
+	res := handler(ctx, vesting.NewMsgCreateVestingAccount(from, to1, coins, now.Unix(), false))
+	require.False(t, res.IsOK())
+
+	endTime := now.Add(time.Hour).Unix()
+	res = handler(ctx, vesting.NewMsgCreateVestingAccount(from, to1, coins, endTime, false))
+	require.True(t, res.IsOK(), res.Log)
+
+	acc, ok := app.AccountKeeper.GetAccount(ctx, to1).(*vesting.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, now.Unix(), acc.GetStartTime())
+	require.Equal(t, endTime, acc.GetEndTime())
+	require.Equal(t, coins, acc.GetOriginalVesting())
+	require.Equal(t, coins, acc.GetCoins())
+	require.True(t, acc.SpendableCoins(now).Empty())
+	require.Equal(t, balance.Sub(coins), app.BankKeeper.GetCoins(ctx, from))
+
+	// the account already exists
+	res = handler(ctx, vesting.NewMsgCreateVestingAccount(from, to1, coins, endTime, true))
+	require.False(t, res.IsOK())
+
+	res = handler(ctx, vesting.NewMsgCreateVestingAccount(from, to2, coins, endTime, true))
+	require.True(t, res.IsOK(), res.Log)
+
+	_, ok = app.AccountKeeper.GetAccount(ctx, to2).(*vesting.DelayedVestingAccount)
+	require.True(t, ok)
+
+	// insufficient funds
+	res = handler(ctx, vesting.NewMsgCreateVestingAccount(
+		from, sdk.AccAddress("to3_________________"), coins, endTime, true,
+	))
+	require.False(t, res.IsOK())
+}
+
+func TestHandleMsgCreatePeriodicVestingAccount(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: now})
+	handler := vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	require.NoError(t, app.BankKeeper.SetCoins(ctx, from, sdk.NewCoins(sdk.NewInt64Coin("atom", 100))))
+
+	periods := vesting.Periods{
+		{Length: 60, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))},
+		{Length: 60, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 20))},
+	}
+
+	res := handler(ctx, vesting.NewMsgCreatePeriodicVestingAccount(from, to, now.Unix(), periods))
+	require.True(t, res.IsOK(), res.Log)
+
+	acc, ok := app.AccountKeeper.GetAccount(ctx, to).(*vesting.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.NoError(t, acc.Validate())
+	require.Equal(t, now.Unix()+120, acc.GetEndTime())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 30)), acc.GetCoins())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), acc.SpendableCoins(now.Add(time.Minute)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 70)), app.BankKeeper.GetCoins(ctx, from))
+}
+
+func TestHandleMsgClawback(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: now})
+	handler := vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("addr________________")
+	valAddr := sdk.ValAddress("validator___________")
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, app.BankKeeper.SetCoins(ctx, funder, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))))
+	require.NoError(t, app.BankKeeper.SetCoins(ctx, sdk.AccAddress(valAddr), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))))
+
+	res := stakingHandler(ctx, staking.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(bondDenom, 100), staking.Description{},
+		staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	))
+	require.True(t, res.IsOK(), res.Log)
+
+	periods := vesting.Periods{
+		{Length: 60, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+		{Length: 60, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 200))},
+	}
+	res = handler(ctx, vesting.NewMsgCreateClawbackVestingAccount(funder, addr, now.Unix(), periods))
+	require.True(t, res.IsOK(), res.Log)
+
+	// only the funder can claw back
+	res = handler(ctx, vesting.NewMsgClawback(addr, addr))
+	require.False(t, res.IsOK())
+
+	// delegate 250 vesting tokens
+	res = stakingHandler(ctx, staking.NewMsgDelegate(addr, valAddr, sdk.NewInt64Coin(bondDenom, 250)))
+	require.True(t, res.IsOK(), res.Log)
+
+	// claw back the 200 tokens of the second period, which are all delegated
+	ctx = ctx.WithBlockTime(now.Add(time.Minute))
+	res = handler(ctx, vesting.NewMsgClawback(funder, addr))
+	require.True(t, res.IsOK(), res.Log)
+
+	acc := app.AccountKeeper.GetAccount(ctx, addr).(*vesting.ClawbackVestingAccount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), acc.GetOriginalVesting())
+	require.Empty(t, acc.GetDelegatedVesting())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50)), acc.GetDelegatedFree())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50)), acc.SpendableCoins(ctx.BlockTime()))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 700)), app.BankKeeper.GetCoins(ctx, funder))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(50), delegation.Shares)
+
+	// the unbonded tokens are returned to the funder
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, funder, valAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, sdk.NewInt(200), ubd.Entries[0].Balance)
+
+	staking.EndBlocker(ctx.WithBlockTime(ubd.Entries[0].CompletionTime), app.StakingKeeper)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 900)), app.BankKeeper.GetCoins(ctx, funder))
+
+	// the delegation left can be undelegated
+	res = stakingHandler(ctx, staking.NewMsgUndelegate(addr, valAddr, sdk.NewInt64Coin(bondDenom, 50)))
+	require.True(t, res.IsOK(), res.Log)
+
+	_, broken := vesting.ClawbackAccountsInvariant(app.AccountKeeper)(ctx)
+	require.False(t, broken)
+}
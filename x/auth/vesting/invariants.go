@@ -0,0 +1,42 @@
+package vesting
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// RegisterInvariants registers the vesting module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, ak types.AccountKeeper) {
+	ir.RegisterRoute(types.ModuleName, "clawback-accounts",
+		ClawbackAccountsInvariant(ak))
+}
+
+// ClawbackAccountsInvariant checks that all clawback vesting accounts have a
+// funder and a vesting schedule matching their original vesting coins, and
+// that they do not track more delegated vesting coins than they vest.
+func ClawbackAccountsInvariant(ak types.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		ak.IterateAccounts(ctx, func(acc exported.Account) (stop bool) {
+			cva, ok := acc.(*types.ClawbackVestingAccount)
+			if !ok {
+				return false
+			}
+
+			if err := cva.Validate(); err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s is invalid: %s\n", cva.GetAddress(), err)
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "clawback-accounts",
+			fmt.Sprintf("amount of invalid clawback vesting accounts found %d\n%s", count, msg)), broken
+	}
+}
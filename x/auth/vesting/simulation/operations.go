@@ -0,0 +1,296 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateVestingAccount         = "op_weight_msg_create_vesting_account"
+	OpWeightMsgCreatePeriodicVestingAccount = "op_weight_msg_create_periodic_vesting_account"
+	OpWeightMsgCreateClawbackVestingAccount = "op_weight_msg_create_clawback_vesting_account"
+	OpWeightMsgClawback                     = "op_weight_msg_clawback"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper,
+	bk types.BankKeeper) simulation.WeightedOperations {
+
+	var (
+		weightMsgCreateVestingAccount         int
+		weightMsgCreatePeriodicVestingAccount int
+		weightMsgCreateClawbackVestingAccount int
+		weightMsgClawback                     int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateVestingAccount, &weightMsgCreateVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateVestingAccount = simappparams.DefaultWeightMsgCreateVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePeriodicVestingAccount, &weightMsgCreatePeriodicVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreatePeriodicVestingAccount = simappparams.DefaultWeightMsgCreatePeriodicVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClawbackVestingAccount, &weightMsgCreateClawbackVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClawbackVestingAccount = simappparams.DefaultWeightMsgCreateClawbackVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgClawback, &weightMsgClawback, nil,
+		func(_ *rand.Rand) {
+			weightMsgClawback = simappparams.DefaultWeightMsgClawback
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateVestingAccount,
+			SimulateMsgCreateVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreatePeriodicVestingAccount,
+			SimulateMsgCreatePeriodicVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateClawbackVestingAccount,
+			SimulateMsgCreateClawbackVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClawback,
+			SimulateMsgClawback(ak),
+		),
+	}
+}
+
+// SimulateMsgCreateVestingAccount generates a MsgCreateVestingAccount that
+// funds a new continuous or delayed vesting account with a random subset of
+// the sender's spendable coins.
+func SimulateMsgCreateVestingAccount(ak types.AccountKeeper, bk types.BankKeeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		simAccount, coins, skip := randomVestingFields(r, ctx, accs, ak, bk)
+		if skip {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		toAcc := simulation.RandomAccounts(r, 1)[0]
+		endTime := ctx.BlockTime().Unix() + int64(simulation.RandIntBetween(r, 1, 60*60*24*365))
+
+		msg := types.NewMsgCreateVestingAccount(simAccount.Address, toAcc.Address, coins, endTime, r.Intn(2) == 0)
+
+		err := sendMsg(r, app, ak, msg, coins, ctx, chainID, simAccount.PrivKey)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgCreatePeriodicVestingAccount generates a
+// MsgCreatePeriodicVestingAccount that funds a new periodic vesting account
+// with a random subset of the sender's spendable coins, split over a random
+// number of vesting periods.
+func SimulateMsgCreatePeriodicVestingAccount(ak types.AccountKeeper, bk types.BankKeeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		simAccount, coins, skip := randomVestingFields(r, ctx, accs, ak, bk)
+		if skip {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		toAcc := simulation.RandomAccounts(r, 1)[0]
+		msg := types.NewMsgCreatePeriodicVestingAccount(
+			simAccount.Address, toAcc.Address, ctx.BlockTime().Unix(), randomVestingPeriods(r, coins),
+		)
+
+		err := sendMsg(r, app, ak, msg, coins, ctx, chainID, simAccount.PrivKey)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgCreateClawbackVestingAccount generates a
+// MsgCreateClawbackVestingAccount that funds a new clawback vesting account
+// with a random subset of the sender's spendable coins, split over a random
+// number of vesting periods.
+func SimulateMsgCreateClawbackVestingAccount(ak types.AccountKeeper, bk types.BankKeeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		simAccount, coins, skip := randomVestingFields(r, ctx, accs, ak, bk)
+		if skip {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		toAcc := simulation.RandomAccounts(r, 1)[0]
+		msg := types.NewMsgCreateClawbackVestingAccount(
+			simAccount.Address, toAcc.Address, ctx.BlockTime().Unix(), randomVestingPeriods(r, coins),
+		)
+
+		err := sendMsg(r, app, ak, msg, coins, ctx, chainID, simAccount.PrivKey)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgClawback generates a MsgClawback for a random clawback vesting
+// account funded by one of the simulation accounts.
+func SimulateMsgClawback(ak types.AccountKeeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var clawbackAccs []*types.ClawbackVestingAccount
+		ak.IterateAccounts(ctx, func(acc exported.Account) (stop bool) {
+			if cva, ok := acc.(*types.ClawbackVestingAccount); ok {
+				clawbackAccs = append(clawbackAccs, cva)
+			}
+			return false
+		})
+
+		if len(clawbackAccs) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		cva := clawbackAccs[r.Intn(len(clawbackAccs))]
+		funder, found := simulation.FindAccount(accs, cva.GetFunder())
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgClawback(funder.Address, cva.GetAddress())
+
+		err := sendMsg(r, app, ak, msg, nil, ctx, chainID, funder.PrivKey)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomVestingPeriods splits the coins into a random number of vesting
+// periods of random lengths.
+func randomVestingPeriods(r *rand.Rand, coins sdk.Coins) types.Periods {
+	var periods types.Periods
+	remaining := coins
+	for i := r.Intn(4); i > 0 && !remaining.Empty(); i-- {
+		amount := simulation.RandSubsetCoins(r, remaining)
+		if amount.Empty() {
+			continue
+		}
+
+		periods = append(periods, types.Period{
+			Length: int64(simulation.RandIntBetween(r, 1, 60*60*24*30)),
+			Amount: amount,
+		})
+		remaining = remaining.Sub(amount)
+	}
+	if !remaining.Empty() {
+		periods = append(periods, types.Period{
+			Length: int64(simulation.RandIntBetween(r, 1, 60*60*24*30)),
+			Amount: remaining,
+		})
+	}
+
+	return periods
+}
+
+// randomVestingFields returns a random sender and a random subset of its
+// spendable coins which can be transferred. It returns skip = true if no
+// coins can be vested.
+func randomVestingFields(
+	r *rand.Rand, ctx sdk.Context, accs []simulation.Account, ak types.AccountKeeper, bk types.BankKeeper,
+) (simulation.Account, sdk.Coins, bool) {
+
+	simAccount, _ := simulation.RandomAcc(r, accs)
+
+	acc := ak.GetAccount(ctx, simAccount.Address)
+	if acc == nil {
+		return simAccount, nil, true
+	}
+
+	coins := simulation.RandSubsetCoins(r, acc.SpendableCoins(ctx.BlockTime()))
+	if coins.Empty() {
+		return simAccount, nil, true
+	}
+
+	// skip coins which cannot be transferred
+	if err := bk.SendEnabledCoins(ctx, coins...); err != nil {
+		return simAccount, nil, true
+	}
+
+	return simAccount, coins, false
+}
+
+// sendMsg sends a transaction with the given message, paying random fees out
+// of the sender's spendable coins left after the vested amount.
+func sendMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ak types.AccountKeeper, msg sdk.Msg,
+	amount sdk.Coins, ctx sdk.Context, chainID string, privkey crypto.PrivKey,
+) error {
+
+	account := ak.GetAccount(ctx, msg.GetSigners()[0])
+	coins := account.SpendableCoins(ctx.BlockTime())
+
+	var (
+		fees sdk.Coins
+		err  error
+	)
+	coins, hasNeg := coins.SafeSub(amount)
+	if !hasNeg {
+		fees, err = simulation.RandomFees(r, ctx, coins)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		privkey,
+	)
+
+	res := app.Deliver(tx)
+	if !res.IsOK() {
+		return errors.New(res.Log)
+	}
+
+	return nil
+}
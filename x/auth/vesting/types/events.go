@@ -0,0 +1,16 @@
+package types
+
+// vesting module event types
+const (
+	EventTypeCreateVestingAccount = "create_vesting_account"
+	EventTypeClawback             = "clawback"
+
+	AttributeKeyAccount   = "account"
+	AttributeKeyStartTime = "start_time"
+	AttributeKeyEndTime   = "end_time"
+	AttributeKeyFunder    = "funder"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyUnbonding = "unbonding"
+
+	AttributeValueCategory = ModuleName
+)
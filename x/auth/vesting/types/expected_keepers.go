@@ -0,0 +1,34 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// AccountKeeper defines the expected account keeper (noalias)
+type AccountKeeper interface {
+	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) exported.Account
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) exported.Account
+	SetAccount(ctx sdk.Context, acc exported.Account)
+	IterateAccounts(ctx sdk.Context, process func(exported.Account) (stop bool))
+}
+
+// BankKeeper defines the expected bank keeper (noalias)
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error
+	BlacklistedAddr(addr sdk.AccAddress) bool
+}
+
+// StakingKeeper defines the expected staking keeper (noalias)
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	Validator(ctx sdk.Context, address sdk.ValAddress) stakingexported.ValidatorI
+	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress,
+		fn func(index int64, delegation stakingexported.DelegationI) (stop bool))
+	UndelegateTo(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, recipientAddr sdk.AccAddress,
+		sharesAmount sdk.Dec) (sdk.Int, time.Time, sdk.Error)
+}
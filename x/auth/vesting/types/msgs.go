@@ -0,0 +1,252 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// vesting message types
+const (
+	TypeMsgCreateVestingAccount         = "create_vesting_account"
+	TypeMsgCreatePeriodicVestingAccount = "create_periodic_vesting_account"
+	TypeMsgCreateClawbackVestingAccount = "create_clawback_vesting_account"
+	TypeMsgClawback                     = "clawback"
+)
+
+var (
+	_ sdk.Msg = MsgCreateVestingAccount{}
+	_ sdk.Msg = MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = MsgClawback{}
+)
+
+// MsgCreateVestingAccount defines a message that moves coins from the signer
+// to a new continuous or delayed vesting account. A continuous vesting
+// account starts vesting at the block time of its creation.
+type MsgCreateVestingAccount struct {
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	EndTime     int64          `json:"end_time" yaml:"end_time"`
+	Delayed     bool           `json:"delayed" yaml:"delayed"`
+}
+
+// NewMsgCreateVestingAccount returns a new MsgCreateVestingAccount instance.
+func NewMsgCreateVestingAccount(
+	fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, endTime int64, delayed bool,
+) MsgCreateVestingAccount {
+	return MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateVestingAccount) Type() string { return TypeMsgCreateVestingAccount }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("vesting amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("vesting amount must be positive")
+	}
+	if msg.EndTime <= 0 {
+		return sdk.ErrUnknownRequest("invalid end time")
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(VestingCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgCreatePeriodicVestingAccount defines a message that moves the coins of
+// all the vesting periods from the signer to a new periodic vesting account.
+type MsgCreatePeriodicVestingAccount struct {
+	FromAddress    sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress      sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	StartTime      int64          `json:"start_time" yaml:"start_time"`
+	VestingPeriods Periods        `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+// NewMsgCreatePeriodicVestingAccount returns a new
+// MsgCreatePeriodicVestingAccount instance.
+func NewMsgCreatePeriodicVestingAccount(
+	fromAddr, toAddr sdk.AccAddress, startTime int64, periods Periods,
+) MsgCreatePeriodicVestingAccount {
+	return MsgCreatePeriodicVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreatePeriodicVestingAccount) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreatePeriodicVestingAccount) Type() string {
+	return TypeMsgCreatePeriodicVestingAccount
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	return validateVestingPeriods(msg.StartTime, msg.VestingPeriods)
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreatePeriodicVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(VestingCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreatePeriodicVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgCreateClawbackVestingAccount defines a message that moves the coins of all
+// the vesting periods from the signer to a new clawback vesting account, whose
+// unvested coins can be clawed back by the signer.
+type MsgCreateClawbackVestingAccount struct {
+	FromAddress    sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress      sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	StartTime      int64          `json:"start_time" yaml:"start_time"`
+	VestingPeriods Periods        `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+// NewMsgCreateClawbackVestingAccount returns a new
+// MsgCreateClawbackVestingAccount instance.
+func NewMsgCreateClawbackVestingAccount(
+	fromAddr, toAddr sdk.AccAddress, startTime int64, periods Periods,
+) MsgCreateClawbackVestingAccount {
+	return MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateClawbackVestingAccount) Type() string {
+	return TypeMsgCreateClawbackVestingAccount
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing funder address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	return validateVestingPeriods(msg.StartTime, msg.VestingPeriods)
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(VestingCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgClawback defines a message that returns the unvested coins of a clawback
+// vesting account to its funder, including the delegated ones which are
+// unbonded to the funder.
+type MsgClawback struct {
+	FunderAddress sdk.AccAddress `json:"funder_address" yaml:"funder_address"`
+	Address       sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewMsgClawback returns a new MsgClawback instance.
+func NewMsgClawback(funder, addr sdk.AccAddress) MsgClawback {
+	return MsgClawback{
+		FunderAddress: funder,
+		Address:       addr,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgClawback) ValidateBasic() sdk.Error {
+	if msg.FunderAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing funder address")
+	}
+	if msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing vesting account address")
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(VestingCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FunderAddress}
+}
+
+// validateVestingPeriods returns an error if the vesting schedule starting at
+// the given time is invalid.
+func validateVestingPeriods(startTime int64, periods Periods) sdk.Error {
+	if startTime < 0 {
+		return sdk.ErrUnknownRequest("invalid start time")
+	}
+	if len(periods) == 0 {
+		return sdk.ErrUnknownRequest("missing vesting periods")
+	}
+
+	for i, period := range periods {
+		if period.Length < 1 {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("invalid length of vesting period %d", i))
+		}
+
+		amount := sdk.Coins(period.Amount)
+		if !amount.IsValid() || !amount.IsAllPositive() {
+			return sdk.ErrInvalidCoins(fmt.Sprintf("invalid amount of vesting period %d: %s", i, amount))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgCreateVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.Coins{sdk.NewInt64Coin("atom", 0)}
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		msg   MsgCreateVestingAccount
+	}{
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 100, false)},     // valid continuous
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 100, true)},      // valid delayed
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom0, 100, false)},      // non positive coin
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom123, 0, false)},      // invalid end time
+		{false, NewMsgCreateVestingAccount(emptyAddr, addr2, atom123, 100, true)}, // empty from addr
+		{false, NewMsgCreateVestingAccount(addr1, emptyAddr, atom123, 100, true)}, // empty to addr
+	}
+
+	for i, tc := range cases {
+		require.Equal(t, RouterKey, tc.msg.Route())
+		require.Equal(t, TypeMsgCreateVestingAccount, tc.msg.Type())
+
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+}
+
+func TestMsgCreatePeriodicVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.Coins{sdk.NewInt64Coin("atom", 0)}
+
+	var emptyAddr sdk.AccAddress
+
+	periods := Periods{{Length: 10, Amount: atom123}, {Length: 20, Amount: atom123}}
+
+	cases := []struct {
+		valid bool
+		msg   MsgCreatePeriodicVestingAccount
+	}{
+		{true, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 100, periods)},
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 100, nil)},                                   // no periods
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, -1, periods)},                                // invalid start time
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 100, Periods{{Length: 0, Amount: atom123}})}, // invalid length
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 100, Periods{{Length: 10, Amount: atom0}})},  // non positive coin
+		{false, NewMsgCreatePeriodicVestingAccount(emptyAddr, addr2, 100, periods)},                           // empty from addr
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, emptyAddr, 100, periods)},                           // empty to addr
+	}
+
+	for i, tc := range cases {
+		require.Equal(t, RouterKey, tc.msg.Route())
+		require.Equal(t, TypeMsgCreatePeriodicVestingAccount, tc.msg.Type())
+
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+
+	require.Equal(t, int64(30), periods.TotalLength())
+	require.Equal(t, atom123.Add(atom123), periods.TotalAmount())
+}
+
+func TestMsgCreateClawbackVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+
+	var emptyAddr sdk.AccAddress
+
+	periods := Periods{{Length: 10, Amount: atom123}}
+
+	cases := []struct {
+		valid bool
+		msg   MsgCreateClawbackVestingAccount
+	}{
+		{true, NewMsgCreateClawbackVestingAccount(addr1, addr2, 100, periods)},
+		{false, NewMsgCreateClawbackVestingAccount(addr1, addr2, 100, nil)},         // no periods
+		{false, NewMsgCreateClawbackVestingAccount(emptyAddr, addr2, 100, periods)}, // empty funder addr
+		{false, NewMsgCreateClawbackVestingAccount(addr1, emptyAddr, 100, periods)}, // empty to addr
+	}
+
+	for i, tc := range cases {
+		require.Equal(t, TypeMsgCreateClawbackVestingAccount, tc.msg.Type())
+
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+}
+
+func TestMsgClawbackValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("funder"))
+	addr2 := sdk.AccAddress([]byte("account"))
+
+	var emptyAddr sdk.AccAddress
+
+	require.Nil(t, NewMsgClawback(addr1, addr2).ValidateBasic())
+	require.NotNil(t, NewMsgClawback(emptyAddr, addr2).ValidateBasic())
+	require.NotNil(t, NewMsgClawback(addr1, emptyAddr).ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, NewMsgClawback(addr1, addr2).GetSigners())
+}
+
+func TestMsgCreateVestingAccountGetSignBytes(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+	addr2 := sdk.AccAddress([]byte("output"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	msg := NewMsgCreateVestingAccount(addr1, addr2, coins, 100, true)
+
+	expected := `{"type":"cosmos-sdk/MsgCreateVestingAccount","value":{"amount":[{"amount":"10","denom":"atom"}],"delayed":true,"end_time":"100","from_address":"cosmos1d9h8qat57ljhcm","to_address":"cosmos1da6hgur4wsmpnjyg"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
@@ -7,12 +7,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Period defines a length of time and amount of coins that will vest
-type Period struct {
-	Length int64     `json:"length" yaml:"length"` // length of the period, in seconds
-	Amount sdk.Coins `json:"amount" yaml:"amount"` // amount of coins vesting during this period
-}
-
 // Periods stores all vesting periods passed as part of a PeriodicVestingAccount
 type Periods []Period
 
@@ -31,3 +25,21 @@ func (vp Periods) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
 }
+
+// TotalLength returns the total length in seconds of all the vesting periods.
+func (vp Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range vp {
+		total += period.Length
+	}
+	return total
+}
+
+// TotalAmount returns the sum of the coins vesting in all the vesting periods.
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range vp {
+		total = total.Add(period.Amount)
+	}
+	return total
+}
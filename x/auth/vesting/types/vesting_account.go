@@ -20,6 +20,9 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*PermanentLockedAccount)(nil)
+	_ vestexported.VestingAccount = (*CliffVestingAccount)(nil)
 )
 
 // Register the vesting account types on the auth module codec
@@ -28,22 +31,14 @@ func init() {
 	authtypes.RegisterAccountTypeCodec(&ContinuousVestingAccount{}, "cosmos-sdk/ContinuousVestingAccount")
 	authtypes.RegisterAccountTypeCodec(&DelayedVestingAccount{}, "cosmos-sdk/DelayedVestingAccount")
 	authtypes.RegisterAccountTypeCodec(&PeriodicVestingAccount{}, "cosmos-sdk/PeriodicVestingAccount")
-}
-
-// BaseVestingAccount implements the VestingAccount interface. It contains all
-// the necessary fields needed for any vesting account implementation.
-type BaseVestingAccount struct {
-	*authtypes.BaseAccount
-
-	OriginalVesting  sdk.Coins `json:"original_vesting" yaml:"original_vesting"`   // coins in account upon initialization
-	DelegatedFree    sdk.Coins `json:"delegated_free" yaml:"delegated_free"`       // coins that are vested and delegated
-	DelegatedVesting sdk.Coins `json:"delegated_vesting" yaml:"delegated_vesting"` // coins that vesting and delegated
-	EndTime          int64     `json:"end_time" yaml:"end_time"`                   // when the coins become unlocked
+	authtypes.RegisterAccountTypeCodec(&ClawbackVestingAccount{}, "cosmos-sdk/ClawbackVestingAccount")
+	authtypes.RegisterAccountTypeCodec(&PermanentLockedAccount{}, "cosmos-sdk/PermanentLockedAccount")
+	authtypes.RegisterAccountTypeCodec(&CliffVestingAccount{}, "cosmos-sdk/CliffVestingAccount")
 }
 
 // NewBaseVestingAccount creates a new BaseVestingAccount object
 func NewBaseVestingAccount(baseAccount *authtypes.BaseAccount, originalVesting sdk.Coins, endTime int64) (*BaseVestingAccount, error) {
-	if (baseAccount.Coins.IsZero() && !originalVesting.IsZero()) || originalVesting.IsAnyGT(baseAccount.Coins) {
+	if (baseAccount.GetCoins().IsZero() && !originalVesting.IsZero()) || originalVesting.IsAnyGT(baseAccount.Coins) {
 		return &BaseVestingAccount{}, errors.New("vesting amount cannot be greater than total amount")
 	}
 	return &BaseVestingAccount{
@@ -67,7 +62,7 @@ func (bva BaseVestingAccount) SpendableCoinsVestingAccount(vestingCoins sdk.Coin
 	for _, coin := range bc {
 		baseAmt := coin.Amount
 		vestingAmt := vestingCoins.AmountOf(coin.Denom)
-		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+		delVestingAmt := bva.GetDelegatedVesting().AmountOf(coin.Denom)
 
 		// compute min((BC + DV) - V, BC) per the specification
 		min := sdk.MinInt(baseAmt.Add(delVestingAmt).Sub(vestingAmt), baseAmt)
@@ -92,7 +87,7 @@ func (bva *BaseVestingAccount) TrackDelegation(vestingCoins, amount sdk.Coins) {
 	for _, coin := range amount {
 		baseAmt := bc.AmountOf(coin.Denom)
 		vestingAmt := vestingCoins.AmountOf(coin.Denom)
-		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+		delVestingAmt := bva.GetDelegatedVesting().AmountOf(coin.Denom)
 
 		// Panic if the delegation amount is zero or if the base coins does not
 		// exceed the desired delegation amount.
@@ -108,12 +103,12 @@ func (bva *BaseVestingAccount) TrackDelegation(vestingCoins, amount sdk.Coins) {
 
 		if !x.IsZero() {
 			xCoin := sdk.NewCoin(coin.Denom, x)
-			bva.DelegatedVesting = bva.DelegatedVesting.Add(sdk.Coins{xCoin})
+			bva.DelegatedVesting = bva.GetDelegatedVesting().Add(sdk.Coins{xCoin})
 		}
 
 		if !y.IsZero() {
 			yCoin := sdk.NewCoin(coin.Denom, y)
-			bva.DelegatedFree = bva.DelegatedFree.Add(sdk.Coins{yCoin})
+			bva.DelegatedFree = bva.GetDelegatedFree().Add(sdk.Coins{yCoin})
 		}
 	}
 }
@@ -134,8 +129,8 @@ func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
 		if coin.Amount.IsZero() {
 			panic("undelegation attempt with zero coins")
 		}
-		delegatedFree := bva.DelegatedFree.AmountOf(coin.Denom)
-		delegatedVesting := bva.DelegatedVesting.AmountOf(coin.Denom)
+		delegatedFree := bva.GetDelegatedFree().AmountOf(coin.Denom)
+		delegatedVesting := bva.GetDelegatedVesting().AmountOf(coin.Denom)
 
 		// compute x and y per the specification, where:
 		// X := min(DF, D)
@@ -145,12 +140,12 @@ func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
 
 		if !x.IsZero() {
 			xCoin := sdk.NewCoin(coin.Denom, x)
-			bva.DelegatedFree = bva.DelegatedFree.Sub(sdk.Coins{xCoin})
+			bva.DelegatedFree = bva.GetDelegatedFree().Sub(sdk.Coins{xCoin})
 		}
 
 		if !y.IsZero() {
 			yCoin := sdk.NewCoin(coin.Denom, y)
-			bva.DelegatedVesting = bva.DelegatedVesting.Sub(sdk.Coins{yCoin})
+			bva.DelegatedVesting = bva.GetDelegatedVesting().Sub(sdk.Coins{yCoin})
 		}
 	}
 }
@@ -179,7 +174,7 @@ func (bva BaseVestingAccount) GetEndTime() int64 {
 
 // Validate checks for errors on the account fields
 func (bva BaseVestingAccount) Validate() error {
-	if !(bva.DelegatedVesting.IsAllLTE(bva.OriginalVesting)) {
+	if !(bva.GetDelegatedVesting().IsAllLTE(bva.OriginalVesting)) {
 		return errors.New("delegated vesting amount cannot be greater than original vesting amount")
 	}
 	return bva.BaseAccount.Validate()
@@ -197,8 +192,10 @@ type vestingAccountPretty struct {
 	EndTime          int64          `json:"end_time" yaml:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	StartTime      int64          `json:"start_time,omitempty" yaml:"start_time,omitempty"`
+	VestingPeriods Periods        `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  sdk.AccAddress `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
+	CliffTime      int64          `json:"cliff_time,omitempty" yaml:"cliff_time,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -219,8 +216,8 @@ func (bva BaseVestingAccount) MarshalYAML() (interface{}, error) {
 		EndTime:          bva.EndTime,
 	}
 
-	if bva.PubKey != nil {
-		pks, err := sdk.Bech32ifyAccPub(bva.PubKey)
+	if len(bva.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(bva.GetPubKey())
 		if err != nil {
 			return nil, err
 		}
@@ -249,8 +246,8 @@ func (bva BaseVestingAccount) MarshalJSON() ([]byte, error) {
 		EndTime:          bva.EndTime,
 	}
 
-	if bva.PubKey != nil {
-		pks, err := sdk.Bech32ifyAccPub(bva.PubKey)
+	if len(bva.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(bva.GetPubKey())
 		if err != nil {
 			return nil, err
 		}
@@ -295,14 +292,6 @@ func (bva *BaseVestingAccount) UnmarshalJSON(bz []byte) error {
 var _ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 var _ authexported.GenesisAccount = (*ContinuousVestingAccount)(nil)
 
-// ContinuousVestingAccount implements the VestingAccount interface. It
-// continuously vests by unlocking coins linearly with respect to time.
-type ContinuousVestingAccount struct {
-	*BaseVestingAccount
-
-	StartTime int64 `json:"start_time" yaml:"start_time"` // when the coins start to vest
-}
-
 // NewContinuousVestingAccountRaw creates a new ContinuousVestingAccount object from BaseVestingAccount
 func NewContinuousVestingAccountRaw(bva *BaseVestingAccount, startTime int64) *ContinuousVestingAccount {
 	return &ContinuousVestingAccount{
@@ -355,7 +344,7 @@ func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coin
 // GetVestingCoins returns the total number of vesting coins. If no coins are
 // vesting, nil is returned.
 func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
-	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime))
+	return cva.GetOriginalVesting().Sub(cva.GetVestedCoins(blockTime))
 }
 
 // SpendableCoins returns the total number of spendable coins per denom for a
@@ -405,8 +394,8 @@ func (cva ContinuousVestingAccount) MarshalYAML() (interface{}, error) {
 		StartTime:        cva.StartTime,
 	}
 
-	if cva.PubKey != nil {
-		pks, err := sdk.Bech32ifyAccPub(cva.PubKey)
+	if len(cva.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(cva.GetPubKey())
 		if err != nil {
 			return nil, err
 		}
@@ -436,8 +425,8 @@ func (cva ContinuousVestingAccount) MarshalJSON() ([]byte, error) {
 		StartTime:        cva.StartTime,
 	}
 
-	if cva.PubKey != nil {
-		pks, err := sdk.Bech32ifyAccPub(cva.PubKey)
+	if len(cva.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(cva.GetPubKey())
 		if err != nil {
 			return nil, err
 		}
@@ -485,14 +474,6 @@ func (cva *ContinuousVestingAccount) UnmarshalJSON(bz []byte) error {
 var _ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 var _ authexported.GenesisAccount = (*PeriodicVestingAccount)(nil)
 
-// PeriodicVestingAccount implements the VestingAccount interface. It
-// periodically vests by unlocking coins during each specified period
-type PeriodicVestingAccount struct {
-	*BaseVestingAccount
-	StartTime      int64   `json:"start_time" yaml:"start_time"`           // when the coins start to vest
-	VestingPeriods Periods `json:"vesting_periods" yaml:"vesting_periods"` // the vesting schedule
-}
-
 // NewPeriodicVestingAccountRaw creates a new PeriodicVestingAccount object from BaseVestingAccount
 func NewPeriodicVestingAccountRaw(bva *BaseVestingAccount, startTime int64, periods Periods) *PeriodicVestingAccount {
 	return &PeriodicVestingAccount{
@@ -553,7 +534,7 @@ func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins
 // GetVestingCoins returns the total number of vesting coins. If no coins are
 // vesting, nil is returned.
 func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
-	return pva.OriginalVesting.Sub(pva.GetVestedCoins(blockTime))
+	return pva.GetOriginalVesting().Sub(pva.GetVestedCoins(blockTime))
 }
 
 // SpendableCoins returns the total number of spendable coins per denom for a
@@ -621,8 +602,8 @@ func (pva PeriodicVestingAccount) MarshalYAML() (interface{}, error) {
 		VestingPeriods:   pva.VestingPeriods,
 	}
 
-	if pva.PubKey != nil {
-		pks, err := sdk.Bech32ifyAccPub(pva.PubKey)
+	if len(pva.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(pva.GetPubKey())
 		if err != nil {
 			return nil, err
 		}
@@ -653,8 +634,8 @@ func (pva PeriodicVestingAccount) MarshalJSON() ([]byte, error) {
 		VestingPeriods:   pva.VestingPeriods,
 	}
 
-	if pva.PubKey != nil {
-		pks, err := sdk.Bech32ifyAccPub(pva.PubKey)
+	if len(pva.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(pva.GetPubKey())
 		if err != nil {
 			return nil, err
 		}
@@ -703,13 +684,6 @@ func (pva *PeriodicVestingAccount) UnmarshalJSON(bz []byte) error {
 var _ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
 var _ authexported.GenesisAccount = (*DelayedVestingAccount)(nil)
 
-// DelayedVestingAccount implements the VestingAccount interface. It vests all
-// coins after a specific time, but non prior. In other words, it keeps them
-// locked until a specified time.
-type DelayedVestingAccount struct {
-	*BaseVestingAccount
-}
-
 // NewDelayedVestingAccountRaw creates a new DelayedVestingAccount object from BaseVestingAccount
 func NewDelayedVestingAccountRaw(bva *BaseVestingAccount) *DelayedVestingAccount {
 	return &DelayedVestingAccount{
@@ -741,7 +715,7 @@ func (dva DelayedVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
 // GetVestingCoins returns the total number of vesting coins for a delayed
 // vesting account.
 func (dva DelayedVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
-	return dva.OriginalVesting.Sub(dva.GetVestedCoins(blockTime))
+	return dva.GetOriginalVesting().Sub(dva.GetVestedCoins(blockTime))
 }
 
 // SpendableCoins returns the total number of spendable coins for a delayed
@@ -780,8 +754,8 @@ func (dva DelayedVestingAccount) MarshalJSON() ([]byte, error) {
 		EndTime:          dva.EndTime,
 	}
 
-	if dva.PubKey != nil {
-		pks, err := sdk.Bech32ifyAccPub(dva.PubKey)
+	if len(dva.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(dva.GetPubKey())
 		if err != nil {
 			return nil, err
 		}
@@ -821,3 +795,570 @@ func (dva *DelayedVestingAccount) UnmarshalJSON(bz []byte) error {
 
 	return nil
 }
+
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authexported.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccountRaw creates a new ClawbackVestingAccount object from BaseVestingAccount
+func NewClawbackVestingAccountRaw(
+	bva *BaseVestingAccount, funder sdk.AccAddress, startTime int64, periods Periods,
+) *ClawbackVestingAccount {
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: bva,
+		FunderAddress:      funder,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount
+func NewClawbackVestingAccount(
+	baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, startTime int64, periods Periods,
+) *ClawbackVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: baseAcc.Coins,
+		EndTime:         startTime + periods.TotalLength(),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return cva.periodic().GetVestedCoins(blockTime)
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.GetOriginalVesting().Sub(cva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// clawback vesting account.
+func (cva ClawbackVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.SpendableCoinsVestingAccount(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetVestingPeriods returns vesting periods associated with clawback vesting account.
+func (cva ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return cva.VestingPeriods
+}
+
+// GetFunder returns the address of the account which funded the clawback
+// vesting account and may claw back its unvested coins.
+func (cva ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	return cva.FunderAddress
+}
+
+// Clawback removes the vesting periods which have not elapsed at the given
+// block time, so that all the coins left in the account are vested. It returns
+// the unvested coins, split between the ones held by the account and the ones
+// delegated by it, which must be transferred to the funder by the caller.
+//
+// The delegated vesting coins which are not clawed back are now vested and
+// tracked as delegated free coins, so that undelegating them later on is
+// accounted for by TrackUndelegation.
+func (cva *ClawbackVestingAccount) Clawback(blockTime time.Time) (held, delegated sdk.Coins) {
+	unvested := cva.GetVestingCoins(blockTime)
+
+	// keep the periods which have elapsed, like GetVestedCoins does
+	var periods Periods
+	endTime := cva.StartTime
+	for _, period := range cva.VestingPeriods {
+		if blockTime.Unix()-endTime < period.Length {
+			break
+		}
+		periods = append(periods, period)
+		endTime += period.Length
+	}
+
+	// The delegated vesting coins are the last to vest, hence the unvested
+	// coins are delegated first.
+	delegated = coinsMin(unvested, cva.GetDelegatedVesting())
+	held = coinsMin(unvested.Sub(delegated), cva.GetCoins())
+
+	cva.OriginalVesting = cva.GetOriginalVesting().Sub(unvested)
+	cva.DelegatedFree = cva.GetDelegatedFree().Add(cva.GetDelegatedVesting().Sub(delegated))
+	cva.DelegatedVesting = sdk.NewCoins()
+	cva.VestingPeriods = periods
+	cva.EndTime = endTime
+
+	return held, delegated
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if cva.FunderAddress.Empty() {
+		return errors.New("vesting funder address cannot be empty")
+	}
+	if cva.GetStartTime() > cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	if cva.StartTime+cva.GetVestingPeriods().TotalLength() != cva.EndTime {
+		return errors.New("vesting end time does not match length of all vesting periods")
+	}
+	if !cva.GetVestingPeriods().TotalAmount().IsEqual(cva.GetOriginalVesting()) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+// periodic returns the PeriodicVestingAccount sharing the vesting schedule of
+// the clawback vesting account.
+func (cva ClawbackVestingAccount) periodic() PeriodicVestingAccount {
+	return PeriodicVestingAccount{
+		BaseVestingAccount: cva.BaseVestingAccount,
+		StartTime:          cva.StartTime,
+		VestingPeriods:     cva.VestingPeriods,
+	}
+}
+
+func (cva ClawbackVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (cva ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	alias := vestingAccountPretty{
+		Address:          cva.Address,
+		Coins:            cva.Coins,
+		AccountNumber:    cva.AccountNumber,
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		VestingPeriods:   cva.VestingPeriods,
+		FunderAddress:    cva.FunderAddress,
+	}
+
+	if len(cva.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(cva.GetPubKey())
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	bz, err := yaml.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), err
+}
+
+// MarshalJSON returns the JSON representation of a ClawbackVestingAccount.
+func (cva ClawbackVestingAccount) MarshalJSON() ([]byte, error) {
+	alias := vestingAccountPretty{
+		Address:          cva.Address,
+		Coins:            cva.Coins,
+		AccountNumber:    cva.AccountNumber,
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		VestingPeriods:   cva.VestingPeriods,
+		FunderAddress:    cva.FunderAddress,
+	}
+
+	if len(cva.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(cva.GetPubKey())
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	return json.Marshal(alias)
+}
+
+// UnmarshalJSON unmarshals raw JSON bytes into a ClawbackVestingAccount.
+func (cva *ClawbackVestingAccount) UnmarshalJSON(bz []byte) error {
+	var alias vestingAccountPretty
+	if err := json.Unmarshal(bz, &alias); err != nil {
+		return err
+	}
+
+	var (
+		pk  crypto.PubKey
+		err error
+	)
+
+	if alias.PubKey != "" {
+		pk, err = sdk.GetAccPubKeyBech32(alias.PubKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	cva.BaseVestingAccount = &BaseVestingAccount{
+		BaseAccount:      authtypes.NewBaseAccount(alias.Address, alias.Coins, pk, alias.AccountNumber, alias.Sequence),
+		OriginalVesting:  alias.OriginalVesting,
+		DelegatedFree:    alias.DelegatedFree,
+		DelegatedVesting: alias.DelegatedVesting,
+		EndTime:          alias.EndTime,
+	}
+	cva.FunderAddress = alias.FunderAddress
+	cva.StartTime = alias.StartTime
+	cva.VestingPeriods = alias.VestingPeriods
+
+	return nil
+}
+
+//-----------------------------------------------------------------------------
+// Permanent Locked Vesting Account
+
+var _ vestexported.VestingAccount = (*PermanentLockedAccount)(nil)
+var _ authexported.GenesisAccount = (*PermanentLockedAccount)(nil)
+
+// NewPermanentLockedAccountRaw creates a new PermanentLockedAccount object from BaseVestingAccount
+func NewPermanentLockedAccountRaw(bva *BaseVestingAccount) *PermanentLockedAccount {
+	return &PermanentLockedAccount{
+		BaseVestingAccount: bva,
+	}
+}
+
+// NewPermanentLockedAccount returns a PermanentLockedAccount
+func NewPermanentLockedAccount(baseAcc *authtypes.BaseAccount) *PermanentLockedAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: baseAcc.Coins,
+		EndTime:         0, // ensure EndTime is set to 0, as PermanentLockedAccount's do not have an EndTime
+	}
+
+	return &PermanentLockedAccount{baseVestingAcc}
+}
+
+// GetVestedCoins returns the total amount of vested coins for a permanent
+// locked vesting account. All coins are only vested once the schedule has
+// elapsed, which never happens.
+func (plva PermanentLockedAccount) GetVestedCoins(_ time.Time) sdk.Coins {
+	return nil
+}
+
+// GetVestingCoins returns the total number of vesting coins for a permanent
+// locked vesting account, which is always its original vesting coins.
+func (plva PermanentLockedAccount) GetVestingCoins(_ time.Time) sdk.Coins {
+	return plva.GetOriginalVesting()
+}
+
+// SpendableCoins returns the total number of spendable coins for a permanent
+// locked vesting account. Coins received by the account after its creation,
+// such as staking rewards, are spendable.
+func (plva PermanentLockedAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return plva.BaseVestingAccount.SpendableCoinsVestingAccount(plva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (plva *PermanentLockedAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	plva.BaseVestingAccount.TrackDelegation(plva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns zero since a permanent locked vesting account has no start time.
+func (plva PermanentLockedAccount) GetStartTime() int64 {
+	return 0
+}
+
+// GetEndTime returns zero since a permanent locked vesting account has no end time.
+func (plva PermanentLockedAccount) GetEndTime() int64 {
+	return 0
+}
+
+// Validate checks for errors on the account fields
+func (plva PermanentLockedAccount) Validate() error {
+	if plva.EndTime > 0 {
+		return errors.New("permanently vested accounts cannot have an end-time")
+	}
+
+	return plva.BaseVestingAccount.Validate()
+}
+
+// MarshalJSON returns the JSON representation of a PermanentLockedAccount.
+func (plva PermanentLockedAccount) MarshalJSON() ([]byte, error) {
+	alias := vestingAccountPretty{
+		Address:          plva.Address,
+		Coins:            plva.Coins,
+		AccountNumber:    plva.AccountNumber,
+		Sequence:         plva.Sequence,
+		OriginalVesting:  plva.OriginalVesting,
+		DelegatedFree:    plva.DelegatedFree,
+		DelegatedVesting: plva.DelegatedVesting,
+		EndTime:          plva.EndTime,
+	}
+
+	if len(plva.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(plva.GetPubKey())
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	return json.Marshal(alias)
+}
+
+// UnmarshalJSON unmarshals raw JSON bytes into a PermanentLockedAccount.
+func (plva *PermanentLockedAccount) UnmarshalJSON(bz []byte) error {
+	var alias vestingAccountPretty
+	if err := json.Unmarshal(bz, &alias); err != nil {
+		return err
+	}
+
+	var (
+		pk  crypto.PubKey
+		err error
+	)
+
+	if alias.PubKey != "" {
+		pk, err = sdk.GetAccPubKeyBech32(alias.PubKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	plva.BaseVestingAccount = &BaseVestingAccount{
+		BaseAccount:      authtypes.NewBaseAccount(alias.Address, alias.Coins, pk, alias.AccountNumber, alias.Sequence),
+		OriginalVesting:  alias.OriginalVesting,
+		DelegatedFree:    alias.DelegatedFree,
+		DelegatedVesting: alias.DelegatedVesting,
+		EndTime:          alias.EndTime,
+	}
+
+	return nil
+}
+
+//-----------------------------------------------------------------------------
+// Cliff Vesting Account
+
+var _ vestexported.VestingAccount = (*CliffVestingAccount)(nil)
+var _ authexported.GenesisAccount = (*CliffVestingAccount)(nil)
+
+// NewCliffVestingAccountRaw creates a new CliffVestingAccount object from BaseVestingAccount
+func NewCliffVestingAccountRaw(bva *BaseVestingAccount, startTime, cliffTime int64) *CliffVestingAccount {
+	return &CliffVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		CliffTime:          cliffTime,
+	}
+}
+
+// NewCliffVestingAccount returns a new CliffVestingAccount
+func NewCliffVestingAccount(baseAcc *authtypes.BaseAccount, startTime, cliffTime, endTime int64) *CliffVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: baseAcc.Coins,
+		EndTime:         endTime,
+	}
+
+	return &CliffVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          startTime,
+		CliffTime:          cliffTime,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins. No coins are vested
+// before the cliff time, after which the coins vested continuously since the
+// start time are vested at once. If no coins are vested, nil is returned.
+func (cva CliffVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() < cva.CliffTime {
+		return nil
+	}
+
+	return cva.continuous().GetVestedCoins(blockTime)
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva CliffVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.GetOriginalVesting().Sub(cva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// cliff vesting account.
+func (cva CliffVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.SpendableCoinsVestingAccount(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *CliffVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a cliff vesting
+// account.
+func (cva CliffVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetCliffTime returns the time before which no coins are vested for a cliff
+// vesting account.
+func (cva CliffVestingAccount) GetCliffTime() int64 {
+	return cva.CliffTime
+}
+
+// Validate checks for errors on the account fields
+func (cva CliffVestingAccount) Validate() error {
+	if cva.GetStartTime() >= cva.GetEndTime() {
+		return errors.New("vesting start-time must be before end-time")
+	}
+	if cva.GetCliffTime() < cva.GetStartTime() || cva.GetCliffTime() > cva.GetEndTime() {
+		return errors.New("vesting cliff-time must be between start-time and end-time")
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+// continuous returns the ContinuousVestingAccount sharing the vesting schedule
+// of the cliff vesting account, ignoring its cliff.
+func (cva CliffVestingAccount) continuous() ContinuousVestingAccount {
+	return ContinuousVestingAccount{
+		BaseVestingAccount: cva.BaseVestingAccount,
+		StartTime:          cva.StartTime,
+	}
+}
+
+func (cva CliffVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a CliffVestingAccount.
+func (cva CliffVestingAccount) MarshalYAML() (interface{}, error) {
+	alias := vestingAccountPretty{
+		Address:          cva.Address,
+		Coins:            cva.Coins,
+		AccountNumber:    cva.AccountNumber,
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		CliffTime:        cva.CliffTime,
+	}
+
+	if len(cva.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(cva.GetPubKey())
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	bz, err := yaml.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), err
+}
+
+// MarshalJSON returns the JSON representation of a CliffVestingAccount.
+func (cva CliffVestingAccount) MarshalJSON() ([]byte, error) {
+	alias := vestingAccountPretty{
+		Address:          cva.Address,
+		Coins:            cva.Coins,
+		AccountNumber:    cva.AccountNumber,
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		CliffTime:        cva.CliffTime,
+	}
+
+	if len(cva.PubKey) != 0 {
+		pks, err := sdk.Bech32ifyAccPub(cva.GetPubKey())
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	return json.Marshal(alias)
+}
+
+// UnmarshalJSON unmarshals raw JSON bytes into a CliffVestingAccount.
+func (cva *CliffVestingAccount) UnmarshalJSON(bz []byte) error {
+	var alias vestingAccountPretty
+	if err := json.Unmarshal(bz, &alias); err != nil {
+		return err
+	}
+
+	var (
+		pk  crypto.PubKey
+		err error
+	)
+
+	if alias.PubKey != "" {
+		pk, err = sdk.GetAccPubKeyBech32(alias.PubKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	cva.BaseVestingAccount = &BaseVestingAccount{
+		BaseAccount:      authtypes.NewBaseAccount(alias.Address, alias.Coins, pk, alias.AccountNumber, alias.Sequence),
+		OriginalVesting:  alias.OriginalVesting,
+		DelegatedFree:    alias.DelegatedFree,
+		DelegatedVesting: alias.DelegatedVesting,
+		EndTime:          alias.EndTime,
+	}
+	cva.StartTime = alias.StartTime
+	cva.CliffTime = alias.CliffTime
+
+	return nil
+}
+
+// coinsMin returns the minimum of each denom of the given coins.
+func coinsMin(a, b sdk.Coins) sdk.Coins {
+	min := sdk.NewCoins()
+	for _, coin := range a {
+		amount := sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			min = min.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)))
+		}
+	}
+	return min
+}
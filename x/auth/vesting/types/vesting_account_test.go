@@ -122,7 +122,7 @@ func TestTrackDelegationContVestingAcc(t *testing.T) {
 	// require the ability to delegate all vesting coins
 	cva := NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
 	cva.TrackDelegation(now, origCoins)
-	require.Equal(t, origCoins, cva.DelegatedVesting)
+	require.Equal(t, origCoins, cva.GetDelegatedVesting())
 	require.Nil(t, cva.DelegatedFree)
 
 	// require the ability to delegate all vested coins
@@ -130,18 +130,18 @@ func TestTrackDelegationContVestingAcc(t *testing.T) {
 	cva = NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
 	cva.TrackDelegation(endTime, origCoins)
 	require.Nil(t, cva.DelegatedVesting)
-	require.Equal(t, origCoins, cva.DelegatedFree)
+	require.Equal(t, origCoins, cva.GetDelegatedFree())
 
 	// require the ability to delegate all vesting coins (50%) and all vested coins (50%)
 	bacc.SetCoins(origCoins)
 	cva = NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
 	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetDelegatedVesting())
 	require.Nil(t, cva.DelegatedFree)
 
 	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetDelegatedVesting())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetDelegatedFree())
 
 	// require no modifications when delegation amount is zero or not enough funds
 	bacc.SetCoins(origCoins)
@@ -195,13 +195,13 @@ func TestTrackUndelegationContVestingAcc(t *testing.T) {
 
 	// undelegate from one validator that got slashed 50%
 	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedFree)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.GetDelegatedFree())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetDelegatedVesting())
 
 	// undelegate from the other validator that did not get slashed
 	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
 	require.Nil(t, cva.DelegatedFree)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.GetDelegatedVesting())
 }
 
 func TestGetVestedCoinsDelVestingAcc(t *testing.T) {
@@ -296,7 +296,7 @@ func TestTrackDelegationDelVestingAcc(t *testing.T) {
 	bacc.SetCoins(origCoins)
 	dva := NewDelayedVestingAccount(&bacc, endTime.Unix())
 	dva.TrackDelegation(now, origCoins)
-	require.Equal(t, origCoins, dva.DelegatedVesting)
+	require.Equal(t, origCoins, dva.GetDelegatedVesting())
 	require.Nil(t, dva.DelegatedFree)
 
 	// require the ability to delegate all vested coins
@@ -304,14 +304,14 @@ func TestTrackDelegationDelVestingAcc(t *testing.T) {
 	dva = NewDelayedVestingAccount(&bacc, endTime.Unix())
 	dva.TrackDelegation(endTime, origCoins)
 	require.Nil(t, dva.DelegatedVesting)
-	require.Equal(t, origCoins, dva.DelegatedFree)
+	require.Equal(t, origCoins, dva.GetDelegatedFree())
 
 	// require the ability to delegate all coins half way through the vesting
 	// schedule
 	bacc.SetCoins(origCoins)
 	dva = NewDelayedVestingAccount(&bacc, endTime.Unix())
 	dva.TrackDelegation(now.Add(12*time.Hour), origCoins)
-	require.Equal(t, origCoins, dva.DelegatedVesting)
+	require.Equal(t, origCoins, dva.GetDelegatedVesting())
 	require.Nil(t, dva.DelegatedFree)
 
 	// require no modifications when delegation amount is zero or not enough funds
@@ -370,12 +370,12 @@ func TestTrackUndelegationDelVestingAcc(t *testing.T) {
 	dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
 
 	require.Nil(t, dva.DelegatedFree)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)}, dva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)}, dva.GetDelegatedVesting())
 
 	// undelegate from the other validator that did not get slashed
 	dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
 	require.Nil(t, dva.DelegatedFree)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, dva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, dva.GetDelegatedVesting())
 }
 
 func TestGetVestedCoinsPeriodicVestingAcc(t *testing.T) {
@@ -528,7 +528,7 @@ func TestTrackDelegationPeriodicVestingAcc(t *testing.T) {
 	// require the ability to delegate all vesting coins
 	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
 	pva.TrackDelegation(now, origCoins)
-	require.Equal(t, origCoins, pva.DelegatedVesting)
+	require.Equal(t, origCoins, pva.GetDelegatedVesting())
 	require.Nil(t, pva.DelegatedFree)
 
 	// require the ability to delegate all vested coins
@@ -536,7 +536,7 @@ func TestTrackDelegationPeriodicVestingAcc(t *testing.T) {
 	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
 	pva.TrackDelegation(endTime, origCoins)
 	require.Nil(t, pva.DelegatedVesting)
-	require.Equal(t, origCoins, pva.DelegatedFree)
+	require.Equal(t, origCoins, pva.GetDelegatedFree())
 
 	// delegate half of vesting coins
 	bacc.SetCoins(origCoins)
@@ -549,7 +549,7 @@ func TestTrackDelegationPeriodicVestingAcc(t *testing.T) {
 	// delegate 75% of coins, split between vested and vesting
 	bacc.SetCoins(origCoins)
 	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
-	pva.TrackDelegation(now.Add(12*time.Hour), periods[0].Amount.Add(periods[1].Amount))
+	pva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins(periods[0].Amount).Add(periods[1].Amount))
 	// require that the maximum possible amount of vesting coins are chosen for delegation.
 	require.Equal(t, pva.DelegatedFree, periods[1].Amount)
 	require.Equal(t, pva.DelegatedVesting, periods[0].Amount)
@@ -558,12 +558,12 @@ func TestTrackDelegationPeriodicVestingAcc(t *testing.T) {
 	bacc.SetCoins(origCoins)
 	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
 	pva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.GetDelegatedVesting())
 	require.Nil(t, pva.DelegatedFree)
 
 	pva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.GetDelegatedVesting())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.GetDelegatedFree())
 
 	// require no modifications when delegation amount is zero or not enough funds
 	bacc.SetCoins(origCoins)
@@ -630,13 +630,221 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 
 	// undelegate from one validator that got slashed 50%
 	pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedFree)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.GetDelegatedFree())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.GetDelegatedVesting())
 
 	// undelegate from the other validator that did not get slashed
 	pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
 	require.Nil(t, pva.DelegatedFree)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.GetDelegatedVesting())
+}
+
+func TestClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := Periods{
+		Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := KeyTestPubAddr()
+	_, _, funder := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	cva := NewClawbackVestingAccount(&bacc, funder, now.Unix(), periods)
+	require.NoError(t, cva.Validate())
+
+	// delegate vesting coins
+	delegation := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 60)}
+	cva.TrackDelegation(now, delegation)
+	require.NoError(t, cva.SetCoins(origCoins.Sub(delegation)))
+	require.Equal(t, delegation, cva.GetDelegatedVesting())
+
+	// require the unvested coins to be clawed back after period 1, the
+	// delegated vesting coins first
+	held, delegated := cva.Clawback(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500)}, held)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, delegated)
+
+	// require the vesting schedule to end after period 1
+	require.Equal(t, Periods{periods[0]}, cva.GetVestingPeriods())
+	require.Equal(t, now.Add(12*time.Hour).Unix(), cva.GetEndTime())
+	require.Equal(t, sdk.Coins(periods[0].Amount), cva.GetOriginalVesting())
+	require.NoError(t, cva.Validate())
+
+	// require the delegated coins left to be tracked as delegated free
+	require.Empty(t, cva.GetDelegatedVesting())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}, cva.GetDelegatedFree())
+	require.Equal(t, cva.GetCoins(), cva.SpendableCoins(now.Add(12*time.Hour)))
+
+	// require nothing to be clawed back once all coins have vested
+	held, delegated = cva.Clawback(now.Add(12 * time.Hour))
+	require.Empty(t, held)
+	require.Empty(t, delegated)
+
+	// require all the coins to be clawed back before the start time
+	bacc.SetCoins(origCoins)
+	cva = NewClawbackVestingAccount(&bacc, funder, now.Unix(), periods)
+	held, delegated = cva.Clawback(now)
+	require.Equal(t, origCoins, held)
+	require.Empty(t, delegated)
+	require.Empty(t, cva.GetVestingPeriods())
+	require.Empty(t, cva.GetOriginalVesting())
+	require.NoError(t, cva.Validate())
+}
+
+func TestGetVestedCoinsPermLockedVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(1000 * 24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+
+	// require no coins are vested until schedule maturation
+	plva := NewPermanentLockedAccount(&bacc)
+	vestedCoins := plva.GetVestedCoins(now)
+	require.Nil(t, vestedCoins)
+
+	// require no coins be vested at end time
+	vestedCoins = plva.GetVestedCoins(endTime)
+	require.Nil(t, vestedCoins)
+	require.Equal(t, origCoins, plva.GetVestingCoins(endTime))
+}
+
+func TestSpendableCoinsPermLockedVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(1000 * 24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+
+	// require that no coins are spendable, however far in the future
+	plva := NewPermanentLockedAccount(&bacc)
+	require.Nil(t, plva.SpendableCoins(now))
+	require.Nil(t, plva.SpendableCoins(endTime))
+
+	// receive some coins, e.g. staking rewards
+	recvAmt := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
+	plva.SetCoins(plva.GetCoins().Add(recvAmt))
+
+	// require that only received coins are spendable
+	spendableCoins := plva.SpendableCoins(endTime)
+	require.Equal(t, recvAmt, spendableCoins)
+
+	// spend all spendable coins
+	plva.SetCoins(plva.GetCoins().Sub(spendableCoins))
+
+	// require that no more coins are spendable
+	require.Nil(t, plva.SpendableCoins(endTime))
+}
+
+func TestTrackDelegationPermLockedVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(1000 * 24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+
+	// require the ability to delegate all locked coins
+	bacc.SetCoins(origCoins)
+	plva := NewPermanentLockedAccount(&bacc)
+	plva.TrackDelegation(endTime, origCoins)
+	require.Equal(t, origCoins, plva.GetDelegatedVesting())
+	require.Nil(t, plva.DelegatedFree)
+
+	// require the ability to undelegate all coins back to the locked balance
+	plva.TrackUndelegation(origCoins)
+	require.Nil(t, plva.DelegatedVesting)
+	require.Equal(t, origCoins, plva.GetCoins())
+	require.Nil(t, plva.SpendableCoins(endTime))
+}
+
+func TestGetVestedCoinsCliffVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	cliffTime := now.Add(6 * time.Hour)
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	cva := NewCliffVestingAccount(&bacc, now.Unix(), cliffTime.Unix(), endTime.Unix())
+
+	// require no coins vested in the very beginning of the vesting schedule
+	vestedCoins := cva.GetVestedCoins(now)
+	require.Nil(t, vestedCoins)
+
+	// require no coins vested before the cliff
+	vestedCoins = cva.GetVestedCoins(now.Add(5 * time.Hour))
+	require.Nil(t, vestedCoins)
+
+	// require the linearly vested coins be vested at once at the cliff
+	vestedCoins = cva.GetVestedCoins(cliffTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}, vestedCoins)
+
+	// require 50% of coins vested
+	vestedCoins = cva.GetVestedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+
+	// require all coins vested at the end of the vesting schedule
+	vestedCoins = cva.GetVestedCoins(endTime)
+	require.Equal(t, origCoins, vestedCoins)
+}
+
+func TestSpendableCoinsCliffVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	cliffTime := now.Add(6 * time.Hour)
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	cva := NewCliffVestingAccount(&bacc, now.Unix(), cliffTime.Unix(), endTime.Unix())
+
+	// require that no coins are spendable before the cliff, except received ones
+	require.Nil(t, cva.SpendableCoins(now.Add(5*time.Hour)))
+
+	recvAmt := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
+	cva.SetCoins(cva.GetCoins().Add(recvAmt))
+	require.Equal(t, recvAmt, cva.SpendableCoins(now.Add(5*time.Hour)))
+
+	// require that vested and received coins are spendable after the cliff
+	spendableCoins := cva.SpendableCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 100)}, spendableCoins)
+
+	// require that all coins are spendable after the vesting schedule
+	require.Equal(t, origCoins.Add(recvAmt), cva.SpendableCoins(endTime))
+}
+
+func TestTrackDelegationCliffVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	cliffTime := now.Add(6 * time.Hour)
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+
+	// require the ability to delegate all vesting coins before the cliff
+	bacc.SetCoins(origCoins)
+	cva := NewCliffVestingAccount(&bacc, now.Unix(), cliffTime.Unix(), endTime.Unix())
+	cva.TrackDelegation(now.Add(5*time.Hour), origCoins)
+	require.Equal(t, origCoins, cva.GetDelegatedVesting())
+	require.Nil(t, cva.DelegatedFree)
+
+	// require the ability to delegate vested coins after the cliff
+	bacc.SetCoins(origCoins)
+	cva = NewCliffVestingAccount(&bacc, now.Unix(), cliffTime.Unix(), endTime.Unix())
+	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetDelegatedVesting())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.GetDelegatedFree())
 }
 
 func TestNewBaseVestingAccount(t *testing.T) {
@@ -724,6 +932,31 @@ func TestGenesisAccountValidate(t *testing.T) {
 				0, Periods{Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
 			errors.New("original vesting coins does not match the sum of all coins in vesting periods"),
 		},
+		{
+			"valid permanent locked vesting account",
+			NewPermanentLockedAccount(baseAccWithCoins),
+			nil,
+		},
+		{
+			"invalid permanent locked vesting end time",
+			NewPermanentLockedAccountRaw(baseVestingWithCoins),
+			errors.New("permanently vested accounts cannot have an end-time"),
+		},
+		{
+			"valid cliff vesting account",
+			NewCliffVestingAccount(baseAcc, 100, 150, 200),
+			nil,
+		},
+		{
+			"invalid cliff vesting times",
+			NewCliffVestingAccount(baseAcc, 200, 200, 200),
+			errors.New("vesting start-time must be before end-time"),
+		},
+		{
+			"invalid cliff vesting cliff time",
+			NewCliffVestingAccount(baseAcc, 100, 250, 200),
+			errors.New("vesting cliff-time must be between start-time and end-time"),
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -797,6 +1030,28 @@ func TestPeriodicVestingAccountJSON(t *testing.T) {
 	require.Equal(t, acc.String(), a.String())
 }
 
+func TestClawbackVestingAccountJSON(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, coins, pubkey, 10, 50)
+	funder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	acc := NewClawbackVestingAccount(baseAcc, funder, time.Now().Unix(), Periods{Period{3600, coins}})
+
+	bz, err := json.Marshal(acc)
+	require.NoError(t, err)
+
+	bz1, err := acc.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, string(bz1), string(bz))
+
+	var a ClawbackVestingAccount
+	require.NoError(t, json.Unmarshal(bz, &a))
+	require.Equal(t, acc.String(), a.String())
+	require.Equal(t, funder, a.GetFunder())
+}
+
 func TestDelayedVestingAccountJSON(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -816,3 +1071,45 @@ func TestDelayedVestingAccountJSON(t *testing.T) {
 	require.NoError(t, json.Unmarshal(bz, &a))
 	require.Equal(t, acc.String(), a.String())
 }
+
+func TestPermanentLockedAccountJSON(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, coins, pubkey, 10, 50)
+
+	acc := NewPermanentLockedAccount(baseAcc)
+
+	bz, err := json.Marshal(acc)
+	require.NoError(t, err)
+
+	bz1, err := acc.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, string(bz1), string(bz))
+
+	var a PermanentLockedAccount
+	require.NoError(t, json.Unmarshal(bz, &a))
+	require.Equal(t, acc.String(), a.String())
+}
+
+func TestCliffVestingAccountJSON(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, coins, pubkey, 10, 50)
+
+	now := time.Now().Unix()
+	acc := NewCliffVestingAccount(baseAcc, now, now+1800, now+3600)
+
+	bz, err := json.Marshal(acc)
+	require.NoError(t, err)
+
+	bz1, err := acc.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, string(bz1), string(bz))
+
+	var a CliffVestingAccount
+	require.NoError(t, json.Unmarshal(bz, &a))
+	require.Equal(t, acc.String(), a.String())
+	require.Equal(t, now+1800, a.GetCliffTime())
+}
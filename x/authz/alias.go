@@ -0,0 +1,75 @@
+package authz
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// nolint
+
+const (
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	QueryAuthorization           = types.QueryAuthorization
+	QueryAuthorizations          = types.QueryAuthorizations
+	DefaultCodespace             = types.DefaultCodespace
+	CodeNoAuthorization          = types.CodeNoAuthorization
+	CodeInvalidAuthorization     = types.CodeInvalidAuthorization
+	CodeInvalidExpiration        = types.CodeInvalidExpiration
+	CodeInvalidSigners           = types.CodeInvalidSigners
+	TypeMsgGrantAuthorization    = types.TypeMsgGrantAuthorization
+	TypeMsgRevokeAuthorization   = types.TypeMsgRevokeAuthorization
+	TypeMsgExecAuthorized        = types.TypeMsgExecAuthorized
+	EventTypeGrantAuthorization  = types.EventTypeGrantAuthorization
+	EventTypeRevokeAuthorization = types.EventTypeRevokeAuthorization
+	EventTypeExecAuthorized      = types.EventTypeExecAuthorized
+	AttributeValueCategory       = types.AttributeValueCategory
+	AttributeKeyGranter          = types.AttributeKeyGranter
+	AttributeKeyGrantee          = types.AttributeKeyGrantee
+	AttributeKeyMsgType          = types.AttributeKeyMsgType
+)
+
+var (
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	MsgType                      = types.MsgType
+	NewSendAuthorization         = types.NewSendAuthorization
+	NewGenericAuthorization      = types.NewGenericAuthorization
+	NewStakeAuthorization        = types.NewStakeAuthorization
+	NewAuthorizationGrant        = types.NewAuthorizationGrant
+	NewMsgGrantAuthorization     = types.NewMsgGrantAuthorization
+	NewMsgRevokeAuthorization    = types.NewMsgRevokeAuthorization
+	NewMsgExecAuthorized         = types.NewMsgExecAuthorized
+	NewQueryAuthorizationParams  = types.NewQueryAuthorizationParams
+	NewQueryAuthorizationsParams = types.NewQueryAuthorizationsParams
+	GrantKey                     = types.GrantKey
+	GrantPrefixByGranterGrantee  = types.GrantPrefixByGranterGrantee
+	RegisterCodec                = types.RegisterCodec
+	ModuleCdc                    = types.ModuleCdc
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	ErrNoAuthorization           = types.ErrNoAuthorization
+	ErrInvalidAuthorization      = types.ErrInvalidAuthorization
+	ErrInvalidExpiration         = types.ErrInvalidExpiration
+	ErrInvalidSigners            = types.ErrInvalidSigners
+
+	GrantKeyPrefix = types.GrantKeyPrefix
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	GenesisState              = types.GenesisState
+	SendAuthorization         = types.SendAuthorization
+	GenericAuthorization      = types.GenericAuthorization
+	StakeAuthorization        = types.StakeAuthorization
+	AuthorizationGrant        = types.AuthorizationGrant
+	MsgGrantAuthorization     = types.MsgGrantAuthorization
+	MsgRevokeAuthorization    = types.MsgRevokeAuthorization
+	MsgExecAuthorized         = types.MsgExecAuthorized
+	QueryAuthorizationParams  = types.QueryAuthorizationParams
+	QueryAuthorizationsParams = types.QueryAuthorizationsParams
+)
@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	authzQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryAuthorization(queryRoute, cdc),
+		GetCmdQueryAuthorizations(queryRoute, cdc),
+	)...)
+
+	return authzQueryCmd
+}
+
+// GetCmdQueryAuthorization implements the command to query the authorization
+// for a message type from a granter to a grantee.
+func GetCmdQueryAuthorization(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorization [granter] [grantee] [msg-type]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query the authorization for a message type granted to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the authorization for a message type granted by an address to another.
+
+Example:
+$ %s query %s authorization cosmos1gran... cosmos1skjw... bank/send
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAuthorizationParams(granter, grantee, args[2]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAuthorization)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grant types.AuthorizationGrant
+			if err := cdc.UnmarshalJSON(res, &grant); err != nil {
+				return fmt.Errorf("failed to unmarshal authorization: %w", err)
+			}
+
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryAuthorizations implements the command to query all the
+// authorizations from a granter to a grantee.
+func GetCmdQueryAuthorizations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorizations [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query all the authorizations granted to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the authorizations granted by an address to another.
+
+Example:
+$ %s query %s authorizations cosmos1gran... cosmos1skjw...
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAuthorizationsParams(granter, grantee))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAuthorizations)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grants []types.AuthorizationGrant
+			if err := cdc.UnmarshalJSON(res, &grants); err != nil {
+				return fmt.Errorf("failed to unmarshal authorizations: %w", err)
+			}
+
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
@@ -0,0 +1,236 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// flags for the authz tx commands
+const (
+	FlagSpendLimit        = "spend-limit"
+	FlagMsgType           = "msg-type"
+	FlagAllowedValidators = "allowed-validators"
+	FlagExpiration        = "expiration"
+)
+
+// authorization types accepted by the grant command
+const (
+	authorizationTypeSend     = "send"
+	authorizationTypeGeneric  = "generic"
+	authorizationTypeDelegate = "delegate"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	authzTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzTxCmd.AddCommand(client.PostCommands(
+		GetCmdGrantAuthorization(cdc),
+		GetCmdRevokeAuthorization(cdc),
+		GetCmdExecAuthorized(cdc),
+	)...)
+
+	return authzTxCmd
+}
+
+// GetCmdGrantAuthorization implements the command to grant an authorization.
+func GetCmdGrantAuthorization(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [send|delegate|generic]",
+		Short: "Grant authorization to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to an address to execute messages on your behalf.
+
+A send authorization allows sending up to the spend limit with MsgSend. A delegate
+authorization allows delegating to the allowed validators with MsgDelegate, up to
+the spend limit if one is given. A generic authorization allows executing any
+message of the given type, which is the message route and type joined by a slash.
+
+Example:
+$ %s tx %s grant cosmos1skjw... send --spend-limit=1000stake --from=mykey
+$ %s tx %s grant cosmos1skjw... delegate --allowed-validators=cosmosvaloper1gghj...,cosmosvaloper1s8f3... --spend-limit=1000stake --from=mykey
+$ %s tx %s grant cosmos1skjw... generic --msg-type=distr/withdraw_delegator_reward --expiration=2021-01-01T00:00:00Z --from=mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			authorization, err := authorizationFromFlags(args[1])
+			if err != nil {
+				return err
+			}
+
+			expiration := time.Now().AddDate(1, 0, 0)
+			if exp := viper.GetString(FlagExpiration); exp != "" {
+				expiration, err = time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return fmt.Errorf("invalid expiration %q: %w", exp, err)
+				}
+			}
+
+			msg := types.NewMsgGrantAuthorization(cliCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "The maximum amount of coins the grantee can send or delegate")
+	cmd.Flags().String(FlagMsgType, "", "The message type a generic authorization is granted for, e.g. gov/vote")
+	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "The validators the grantee can delegate to")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 time at which the authorization expires, one year from now if empty")
+
+	return cmd
+}
+
+// authorizationFromFlags builds an authorization of the given type from the
+// command flags.
+func authorizationFromFlags(authorizationType string) (exported.Authorization, error) {
+	switch authorizationType {
+	case authorizationTypeSend:
+		spendLimit, err := sdk.ParseCoins(viper.GetString(FlagSpendLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewSendAuthorization(spendLimit), nil
+
+	case authorizationTypeDelegate:
+		var allowList []sdk.ValAddress
+		for _, v := range viper.GetStringSlice(FlagAllowedValidators) {
+			valAddr, err := sdk.ValAddressFromBech32(v)
+			if err != nil {
+				return nil, err
+			}
+			allowList = append(allowList, valAddr)
+		}
+
+		var maxTokens *sdk.Coin
+		if limit := viper.GetString(FlagSpendLimit); limit != "" {
+			coin, err := sdk.ParseCoin(limit)
+			if err != nil {
+				return nil, err
+			}
+			maxTokens = &coin
+		}
+
+		return types.NewStakeAuthorization(allowList, maxTokens), nil
+
+	case authorizationTypeGeneric:
+		return types.NewGenericAuthorization(viper.GetString(FlagMsgType)), nil
+
+	default:
+		return nil, fmt.Errorf(
+			"invalid authorization type %q, expected one of %s, %s or %s",
+			authorizationType, authorizationTypeSend, authorizationTypeDelegate, authorizationTypeGeneric,
+		)
+	}
+}
+
+// GetCmdRevokeAuthorization implements the command to revoke an authorization.
+func GetCmdRevokeAuthorization(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee] [msg-type]",
+		Short: "Revoke an authorization granted to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization for a message type you granted to an address.
+
+Example:
+$ %s tx %s revoke cosmos1skjw... bank/send --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAuthorization(cliCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdExecAuthorized implements the command to execute messages on behalf of
+// a granter.
+func GetCmdExecAuthorized(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [tx-json-file]",
+		Short: "Execute the messages of a transaction on behalf of their granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of an unsigned transaction on behalf of their signer,
+who must have granted you an authorization for each of them. The transaction can
+be created with the --generate-only flag, using the granter as --from.
+
+Example:
+$ %s tx send cosmos1gran... cosmos1reci... 10stake --generate-only > tx.json
+$ %s tx %s exec tx.json --from=mykey
+`,
+				version.ClientName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecAuthorized(cliCtx.GetFromAddress(), stdTx.Msgs)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
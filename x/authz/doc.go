@@ -0,0 +1,34 @@
+/*
+Package authz provides functionality for granting an account (grantee) the
+permission to execute messages on behalf of another account (granter).
+
+A granter authorizes a grantee with a MsgGrantAuthorization, which stores an
+Authorization for a single message type until the given expiration time. The
+grantee then submits messages whose signer is the granter, wrapped in a
+MsgExecAuthorized signed by the grantee alone. Each message is checked
+against the stored Authorization and routed to its module handler through the
+baseapp Router as if the granter had sent it.
+
+The following authorizations are available:
+
+	SendAuthorization: allows sending up to a spend limit of coins with a bank
+	MsgSend.
+
+	StakeAuthorization: allows delegating to an allow-list of validators with a
+	staking MsgDelegate, optionally up to a maximum amount of tokens.
+
+	GenericAuthorization: allows executing any message of the given type
+	without restriction.
+
+A full setup of the authz module may look something as follows:
+
+	ModuleBasics = module.NewBasicManager(
+	  // ...,
+	  authz.AppModuleBasic{},
+	)
+
+	app.AuthzKeeper = authz.NewKeeper(
+	  app.cdc, keys[authz.StoreKey], app.Router(), authz.DefaultCodespace,
+	)
+*/
+package authz
@@ -0,0 +1,25 @@
+package exported
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization represents the interface of various authorizations a granter
+// can give to a grantee to execute messages on their behalf.
+type Authorization interface {
+	// MsgType returns the type of message this authorization applies to. It is
+	// the message route and type joined by a slash, e.g. "bank/send".
+	MsgType() string
+
+	// Accept determines whether this authorization permits the provided sdk.Msg
+	// to be executed in the given block. If it returns an error, the message is
+	// rejected. Otherwise, updated holds the authorization to store in place of
+	// the current one, which is kept as is if updated is nil. If delete is true,
+	// the authorization has been used up and is removed from storage.
+	Accept(msg sdk.Msg, block abci.Header) (updated Authorization, delete bool, err error)
+
+	// ValidateBasic performs stateless validation of the authorization.
+	ValidateBasic() error
+}
@@ -0,0 +1,33 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the authz module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	for _, g := range gs.Authorizations {
+		k.Grant(ctx, g)
+	}
+}
+
+// ExportGenesis returns the authz module's exported genesis. Expired grants
+// are not exported.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	grants := []AuthorizationGrant{}
+	k.IterateAllGrants(ctx, func(grant AuthorizationGrant) bool {
+		if !grant.IsExpired(ctx.BlockTime()) {
+			grants = append(grants, grant)
+		}
+		return false
+	})
+
+	return NewGenesisState(grants)
+}
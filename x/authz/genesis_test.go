@@ -0,0 +1,69 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper authz.Keeper
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 1, Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	suite.keeper = app.AuthzKeeper
+}
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+
+	authorization := authz.NewGenericAuthorization("gov/vote")
+	suite.keeper.Grant(suite.ctx, authz.NewAuthorizationGrant(granter, grantee, authorization, expiration))
+
+	// expired grants are not exported
+	expired := authz.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("foo", 1)))
+	suite.keeper.Grant(suite.ctx, authz.NewAuthorizationGrant(granter, grantee, expired, suite.ctx.BlockTime()))
+
+	genesis := authz.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Len(genesis.Authorizations, 1)
+
+	// revoke the grant and import it again from the exported genesis
+	suite.Require().NoError(suite.keeper.Revoke(suite.ctx, granter, grantee, "gov/vote"))
+	suite.Require().Nil(suite.keeper.GetAuthorization(suite.ctx, granter, grantee, "gov/vote"))
+
+	authz.InitGenesis(suite.ctx, suite.keeper, genesis)
+	suite.Require().Equal(authorization, suite.keeper.GetAuthorization(suite.ctx, granter, grantee, "gov/vote"))
+	suite.Require().Equal(genesis, authz.ExportGenesis(suite.ctx, suite.keeper))
+}
+
+func (suite *GenesisTestSuite) TestInitGenesis_Invalid() {
+	granter := sdk.AccAddress("granter_____________")
+
+	// self grants are invalid
+	genesis := authz.NewGenesisState([]authz.AuthorizationGrant{
+		authz.NewAuthorizationGrant(granter, granter, authz.NewGenericAuthorization("gov/vote"), suite.ctx.BlockTime()),
+	})
+
+	suite.Require().Panics(func() {
+		authz.InitGenesis(suite.ctx, suite.keeper, genesis)
+	})
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
@@ -0,0 +1,48 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: now})
+	handler := authz.NewHandler(app.AuthzKeeper)
+
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	require.NoError(t, app.BankKeeper.SetCoins(ctx, granter, coins))
+
+	send := bank.NewMsgSend(granter, grantee, coins)
+	exec := authz.NewMsgExecAuthorized(grantee, []sdk.Msg{send})
+
+	// the grant must expire in the future
+	res := handler(ctx, authz.NewMsgGrantAuthorization(granter, grantee, authz.NewSendAuthorization(coins), now))
+	require.False(t, res.IsOK())
+
+	res = handler(ctx, exec)
+	require.False(t, res.IsOK())
+
+	res = handler(ctx, authz.NewMsgGrantAuthorization(granter, grantee, authz.NewSendAuthorization(coins), now.Add(time.Hour)))
+	require.True(t, res.IsOK(), res.Log)
+
+	res = handler(ctx, exec)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, coins, app.BankKeeper.GetCoins(ctx, grantee))
+	require.True(t, app.BankKeeper.GetCoins(ctx, granter).Empty())
+
+	// the authorization has been used up
+	res = handler(ctx, authz.NewMsgRevokeAuthorization(granter, grantee, authz.MsgType(send)))
+	require.False(t, res.IsOK())
+}
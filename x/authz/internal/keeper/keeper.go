@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// Keeper manages the authorizations granted between accounts and executes
+// messages on behalf of their granters. It must have a codec with all
+// available authorizations registered.
+type Keeper struct {
+	cdc       *codec.Codec
+	storeKey  sdk.StoreKey
+	router    sdk.Router
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates an authz Keeper. The router is used to dispatch the
+// messages executed on behalf of granters.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router sdk.Router, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		router:    router,
+		codespace: codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Grant stores a new authorization grant, overwriting any existing grant for
+// the same message type from the same granter to the same grantee.
+func (k Keeper) Grant(ctx sdk.Context, grant types.AuthorizationGrant) {
+	msgType := grant.Authorization.MsgType()
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GrantKey(grant.Granter, grant.Grantee, msgType)
+	bz := k.cdc.MustMarshalBinaryBare(grant)
+	store.Set(key, bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+}
+
+// Revoke removes an existing authorization grant. It returns an error if no
+// grant exists for the message type from the granter to the grantee.
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GrantKey(granter, grantee, msgType)
+	if !store.Has(key) {
+		return types.ErrNoAuthorization(k.codespace, granter, grantee, msgType)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthorization returns the authorization for the message type from the
+// granter to the grantee. If there is none or it has expired, it returns nil.
+func (k Keeper) GetAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) exported.Authorization {
+	grant, found := k.GetAuthorizationGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockTime()) {
+		return nil
+	}
+
+	return grant.Authorization
+}
+
+// GetAuthorizationGrant returns the full AuthorizationGrant for the message
+// type from the granter to the grantee, if it exists. The returned grant may
+// have expired.
+func (k Keeper) GetAuthorizationGrant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) (grant types.AuthorizationGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GrantKey(granter, grantee, msgType))
+	if len(bz) == 0 {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// IterateGrants iterates over all the grants from the granter to the grantee.
+// Callback to get all data, returns true to stop, false to keep reading.
+func (k Keeper) IterateGrants(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, cb func(types.AuthorizationGrant) bool,
+) {
+	k.iterate(ctx, types.GrantPrefixByGranterGrantee(granter, grantee), cb)
+}
+
+// IterateAllGrants iterates over all the grants in the store. Callback to get
+// all data, returns true to stop, false to keep reading.
+func (k Keeper) IterateAllGrants(ctx sdk.Context, cb func(types.AuthorizationGrant) bool) {
+	k.iterate(ctx, types.GrantKeyPrefix, cb)
+}
+
+func (k Keeper) iterate(ctx sdk.Context, prefix []byte, cb func(types.AuthorizationGrant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// GetAllGrants returns all stored authorization grants.
+func (k Keeper) GetAllGrants(ctx sdk.Context) (grants []types.AuthorizationGrant) {
+	k.IterateAllGrants(ctx, func(grant types.AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	return grants
+}
+
+// DispatchActions executes the given messages on behalf of their signers as
+// requested by the grantee. Every message must have a single signer, which is
+// either the grantee itself or a granter who authorized the grantee to execute
+// the message. Authorizations are updated or removed as they are used, and the
+// messages are routed to their handlers through the router. Execution stops at
+// the first failing message.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) (sdk.Result, error) {
+	var data []byte
+	events := sdk.EmptyEvents()
+
+	for _, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return sdk.Result{}, types.ErrInvalidSigners(k.codespace, types.MsgType(msg), len(signers))
+		}
+
+		granter := signers[0]
+		if !granter.Equals(grantee) {
+			if err := k.useAuthorization(ctx, granter, grantee, msg); err != nil {
+				return sdk.Result{}, err
+			}
+		}
+
+		handler := k.router.Route(msg.Route())
+		if handler == nil {
+			return sdk.Result{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+		}
+
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			return sdk.Result{}, sdkerrors.New(string(res.Codespace), uint32(res.Code), res.Log)
+		}
+
+		data = append(data, res.Data...)
+		events = events.AppendEvents(res.Events)
+	}
+
+	return sdk.Result{Data: data, Events: events}, nil
+}
+
+// useAuthorization checks that the grantee holds an unexpired authorization
+// from the granter which accepts the message, and stores the updated
+// authorization. Used up authorizations are removed.
+func (k Keeper) useAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	msgType := types.MsgType(msg)
+
+	grant, found := k.GetAuthorizationGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockTime()) {
+		return types.ErrNoAuthorization(k.codespace, granter, grantee, msgType)
+	}
+
+	updated, del, err := grant.Authorization.Accept(msg, ctx.BlockHeader())
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GrantKey(granter, grantee, msgType)
+
+	switch {
+	case del:
+		store.Delete(key)
+
+	case updated != nil:
+		grant.Authorization = updated
+		store.Set(key, k.cdc.MustMarshalBinaryBare(grant))
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	keeper  keeper.Keeper
+	querier sdk.Querier
+
+	granter   sdk.AccAddress
+	grantee   sdk.AccAddress
+	recipient sdk.AccAddress
+	now       time.Time
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.now = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 1, Time: suite.now})
+	suite.keeper = app.AuthzKeeper
+	suite.querier = keeper.NewQuerier(app.AuthzKeeper)
+
+	suite.granter = sdk.AccAddress("granter_____________")
+	suite.grantee = sdk.AccAddress("grantee_____________")
+	suite.recipient = sdk.AccAddress("recipient___________")
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	ctx := suite.ctx
+	k := suite.keeper
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	send := types.NewSendAuthorization(atom)
+	generic := types.NewGenericAuthorization("gov/vote")
+	sendType := send.MsgType()
+
+	suite.Nil(k.GetAuthorization(ctx, suite.granter, suite.grantee, sendType))
+
+	k.Grant(ctx, types.NewAuthorizationGrant(suite.granter, suite.grantee, send, suite.now.Add(time.Hour)))
+	k.Grant(ctx, types.NewAuthorizationGrant(suite.granter, suite.grantee, generic, suite.now.Add(time.Hour)))
+	k.Grant(ctx, types.NewAuthorizationGrant(suite.grantee, suite.granter, generic, suite.now.Add(time.Hour)))
+
+	suite.Equal(send, k.GetAuthorization(ctx, suite.granter, suite.grantee, sendType))
+	suite.Equal(generic, k.GetAuthorization(ctx, suite.granter, suite.grantee, "gov/vote"))
+	suite.Nil(k.GetAuthorization(ctx, suite.grantee, suite.granter, sendType))
+
+	var grants []types.AuthorizationGrant
+	k.IterateGrants(ctx, suite.granter, suite.grantee, func(grant types.AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	suite.Len(grants, 2)
+	suite.Len(k.GetAllGrants(ctx), 3)
+
+	// expired authorizations are not returned
+	suite.Nil(k.GetAuthorization(ctx.WithBlockTime(suite.now.Add(time.Hour)), suite.granter, suite.grantee, sendType))
+
+	suite.Require().NoError(k.Revoke(ctx, suite.granter, suite.grantee, sendType))
+	suite.Require().Error(k.Revoke(ctx, suite.granter, suite.grantee, sendType))
+	suite.Nil(k.GetAuthorization(ctx, suite.granter, suite.grantee, sendType))
+	suite.Len(k.GetAllGrants(ctx), 2)
+}
+
+func (suite *KeeperTestSuite) TestDispatchActions() {
+	ctx := suite.ctx
+	k := suite.keeper
+
+	atom := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amt)) }
+	suite.Require().NoError(suite.app.BankKeeper.SetCoins(ctx, suite.granter, atom(1000)))
+
+	send := bank.NewMsgSend(suite.granter, suite.recipient, atom(60))
+	sendType := types.MsgType(send)
+
+	// no authorization
+	_, err := k.DispatchActions(ctx, suite.grantee, []sdk.Msg{send})
+	suite.Require().Error(err)
+
+	k.Grant(ctx, types.NewAuthorizationGrant(
+		suite.granter, suite.grantee, types.NewSendAuthorization(atom(100)), suite.now.Add(time.Hour),
+	))
+
+	// expired authorization
+	_, err = k.DispatchActions(ctx.WithBlockTime(suite.now.Add(time.Hour)), suite.grantee, []sdk.Msg{send})
+	suite.Require().Error(err)
+
+	// the spend limit is deducted
+	res, err := k.DispatchActions(ctx, suite.grantee, []sdk.Msg{send})
+	suite.Require().NoError(err)
+	suite.NotEmpty(res.Events)
+	suite.Equal(atom(60), suite.app.BankKeeper.GetCoins(ctx, suite.recipient))
+	suite.Equal(types.NewSendAuthorization(atom(40)), k.GetAuthorization(ctx, suite.granter, suite.grantee, sendType))
+
+	// the remaining limit is too low
+	_, err = k.DispatchActions(ctx, suite.grantee, []sdk.Msg{send})
+	suite.Require().Error(err)
+
+	// the authorization is removed once used up
+	_, err = k.DispatchActions(ctx, suite.grantee, []sdk.Msg{bank.NewMsgSend(suite.granter, suite.recipient, atom(40))})
+	suite.Require().NoError(err)
+	suite.Equal(atom(100), suite.app.BankKeeper.GetCoins(ctx, suite.recipient))
+	suite.Nil(k.GetAuthorization(ctx, suite.granter, suite.grantee, sendType))
+
+	// failing messages return their error
+	k.Grant(ctx, types.NewAuthorizationGrant(
+		suite.granter, suite.grantee, types.NewGenericAuthorization(sendType), suite.now.Add(time.Hour),
+	))
+	_, err = k.DispatchActions(ctx, suite.grantee, []sdk.Msg{bank.NewMsgSend(suite.granter, suite.recipient, atom(5000))})
+	suite.Require().Error(err)
+
+	// the grantee does not need an authorization to act on its own behalf
+	suite.Require().NoError(suite.app.BankKeeper.SetCoins(ctx, suite.grantee, atom(10)))
+	_, err = k.DispatchActions(ctx, suite.grantee, []sdk.Msg{bank.NewMsgSend(suite.grantee, suite.recipient, atom(10))})
+	suite.Require().NoError(err)
+	suite.Equal(atom(110), suite.app.BankKeeper.GetCoins(ctx, suite.recipient))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+const (
+	custom = "custom"
+)
+
+func (suite *KeeperTestSuite) TestQueryAuthorizations() {
+	ctx := suite.ctx
+	cdc := suite.app.Codec()
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	grant := types.NewAuthorizationGrant(suite.granter, suite.grantee, types.NewSendAuthorization(atom), suite.now.Add(time.Hour))
+	suite.keeper.Grant(ctx, grant)
+
+	queryOne := func(granter, grantee sdk.AccAddress, msgType string) (types.AuthorizationGrant, error) {
+		req := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryAuthorization}, "/"),
+			Data: cdc.MustMarshalJSON(types.NewQueryAuthorizationParams(granter, grantee, msgType)),
+		}
+
+		var res types.AuthorizationGrant
+		bz, err := suite.querier(ctx, []string{types.QueryAuthorization}, req)
+		if err != nil {
+			return res, err
+		}
+
+		suite.Require().NoError(cdc.UnmarshalJSON(bz, &res))
+		return res, nil
+	}
+
+	queryAll := func(granter, grantee sdk.AccAddress) []types.AuthorizationGrant {
+		req := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryAuthorizations}, "/"),
+			Data: cdc.MustMarshalJSON(types.NewQueryAuthorizationsParams(granter, grantee)),
+		}
+
+		bz, err := suite.querier(ctx, []string{types.QueryAuthorizations}, req)
+		suite.Require().NoError(err)
+
+		var grants []types.AuthorizationGrant
+		suite.Require().NoError(cdc.UnmarshalJSON(bz, &grants))
+		return grants
+	}
+
+	res, err := queryOne(suite.granter, suite.grantee, "bank/send")
+	suite.Require().NoError(err)
+	suite.Equal(grant, res)
+
+	_, err = queryOne(suite.granter, suite.grantee, "gov/vote")
+	suite.Error(err)
+
+	suite.Equal([]types.AuthorizationGrant{grant}, queryAll(suite.granter, suite.grantee))
+	suite.Empty(queryAll(suite.grantee, suite.granter))
+
+	_, err = suite.querier(ctx, []string{"foo"}, abci.RequestQuery{})
+	suite.Error(err)
+
+	_, err = suite.querier(ctx, []string{types.QueryAuthorizations}, abci.RequestQuery{Data: []byte("invalid")})
+	suite.Error(err)
+}
@@ -0,0 +1,182 @@
+package types
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	_ exported.Authorization = (*SendAuthorization)(nil)
+	_ exported.Authorization = (*GenericAuthorization)(nil)
+	_ exported.Authorization = (*StakeAuthorization)(nil)
+)
+
+// MsgType returns the key under which authorizations for the given message are
+// stored, which is the message route and type joined by a slash.
+func MsgType(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// SendAuthorization allows the grantee to send up to SpendLimit coins from the
+// granter's account with a bank MsgSend.
+type SendAuthorization struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+}
+
+// NewSendAuthorization creates a new SendAuthorization.
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{SpendLimit: spendLimit}
+}
+
+// MsgType implements Authorization.
+func (a SendAuthorization) MsgType() string {
+	return MsgType(bank.MsgSend{})
+}
+
+// Accept implements Authorization. The sent amount is deducted from the spend
+// limit, and the authorization is deleted once the limit is used up.
+func (a SendAuthorization) Accept(msg sdk.Msg, _ abci.Header) (exported.Authorization, bool, error) {
+	msgSend, ok := msg.(bank.MsgSend)
+	if !ok {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "expected %s, got %T", a.MsgType(), msg)
+	}
+
+	limitLeft, isNeg := a.SpendLimit.SafeSub(msgSend.Amount)
+	if isNeg {
+		return nil, false, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "spend limit %s is smaller than %s", a.SpendLimit, msgSend.Amount,
+		)
+	}
+	if limitLeft.IsZero() {
+		return nil, true, nil
+	}
+
+	return NewSendAuthorization(limitLeft), false, nil
+}
+
+// ValidateBasic implements Authorization.
+func (a SendAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() || !a.SpendLimit.IsAllPositive() {
+		return ErrInvalidAuthorization(DefaultCodespace, "spend limit must be positive: "+a.SpendLimit.String())
+	}
+
+	return nil
+}
+
+// GenericAuthorization gives the grantee unrestricted permission to execute
+// messages of the given type on behalf of the granter.
+type GenericAuthorization struct {
+	// Msg is the message type, as returned by MsgType, this authorization
+	// applies to.
+	Msg string `json:"msg" yaml:"msg"`
+}
+
+// NewGenericAuthorization creates a new GenericAuthorization.
+func NewGenericAuthorization(msgType string) *GenericAuthorization {
+	return &GenericAuthorization{Msg: msgType}
+}
+
+// MsgType implements Authorization.
+func (a GenericAuthorization) MsgType() string {
+	return a.Msg
+}
+
+// Accept implements Authorization. Any message of the authorized type is
+// accepted and the authorization is kept unchanged.
+func (a GenericAuthorization) Accept(_ sdk.Msg, _ abci.Header) (exported.Authorization, bool, error) {
+	return nil, false, nil
+}
+
+// ValidateBasic implements Authorization.
+func (a GenericAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "message type cannot be empty")
+	}
+
+	return nil
+}
+
+// StakeAuthorization allows the grantee to delegate the granter's tokens to
+// the validators in AllowList. If MaxTokens is set, it caps the total amount
+// of tokens which can be delegated.
+type StakeAuthorization struct {
+	AllowList []sdk.ValAddress `json:"allow_list" yaml:"allow_list"`
+	MaxTokens *sdk.Coin        `json:"max_tokens,omitempty" yaml:"max_tokens,omitempty"`
+}
+
+// NewStakeAuthorization creates a new StakeAuthorization. A nil maxTokens
+// does not limit the amount of tokens which can be delegated.
+func NewStakeAuthorization(allowList []sdk.ValAddress, maxTokens *sdk.Coin) *StakeAuthorization {
+	return &StakeAuthorization{AllowList: allowList, MaxTokens: maxTokens}
+}
+
+// MsgType implements Authorization.
+func (a StakeAuthorization) MsgType() string {
+	return MsgType(staking.MsgDelegate{})
+}
+
+// Accept implements Authorization. The delegation must target a validator in
+// the allow list and, if a token limit is set, is deducted from it.
+func (a StakeAuthorization) Accept(msg sdk.Msg, _ abci.Header) (exported.Authorization, bool, error) {
+	msgDelegate, ok := msg.(staking.MsgDelegate)
+	if !ok {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "expected %s, got %T", a.MsgType(), msg)
+	}
+
+	if !a.isAllowed(msgDelegate.ValidatorAddress) {
+		return nil, false, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "cannot delegate to validator %s", msgDelegate.ValidatorAddress,
+		)
+	}
+
+	if a.MaxTokens == nil {
+		return nil, false, nil
+	}
+
+	if msgDelegate.Amount.Denom != a.MaxTokens.Denom || a.MaxTokens.IsLT(msgDelegate.Amount) {
+		return nil, false, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "max tokens %s is smaller than %s", a.MaxTokens, msgDelegate.Amount,
+		)
+	}
+
+	limitLeft := a.MaxTokens.Sub(msgDelegate.Amount)
+	if limitLeft.IsZero() {
+		return nil, true, nil
+	}
+
+	return NewStakeAuthorization(a.AllowList, &limitLeft), false, nil
+}
+
+func (a StakeAuthorization) isAllowed(validator sdk.ValAddress) bool {
+	for _, v := range a.AllowList {
+		if v.Equals(validator) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateBasic implements Authorization.
+func (a StakeAuthorization) ValidateBasic() error {
+	if len(a.AllowList) == 0 {
+		return ErrInvalidAuthorization(DefaultCodespace, "validator allow list cannot be empty")
+	}
+	for _, v := range a.AllowList {
+		if v.Empty() {
+			return ErrInvalidAuthorization(DefaultCodespace, "validator address cannot be empty")
+		}
+	}
+	if a.MaxTokens != nil && (!a.MaxTokens.IsValid() || !a.MaxTokens.IsPositive()) {
+		return ErrInvalidAuthorization(DefaultCodespace, "max tokens must be positive: "+a.MaxTokens.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	fromAddr = sdk.AccAddress("from________________")
+	toAddr   = sdk.AccAddress("to__________________")
+	valAddr1 = sdk.ValAddress("val1________________")
+	valAddr2 = sdk.ValAddress("val2________________")
+)
+
+func TestSendAuthorization(t *testing.T) {
+	atom := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amt)) }
+
+	cases := map[string]struct {
+		limit   sdk.Coins
+		msg     sdk.Msg
+		accept  bool
+		delete  bool
+		updated exported.Authorization
+	}{
+		"spend part of the limit": {
+			limit:   atom(100),
+			msg:     bank.NewMsgSend(fromAddr, toAddr, atom(40)),
+			accept:  true,
+			updated: NewSendAuthorization(atom(60)),
+		},
+		"spend the entire limit": {
+			limit:  atom(100),
+			msg:    bank.NewMsgSend(fromAddr, toAddr, atom(100)),
+			accept: true,
+			delete: true,
+		},
+		"exceed the limit": {
+			limit: atom(100),
+			msg:   bank.NewMsgSend(fromAddr, toAddr, atom(101)),
+		},
+		"other denom": {
+			limit: atom(100),
+			msg:   bank.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("eth", 1))),
+		},
+		"other message": {
+			limit: atom(100),
+			msg:   staking.NewMsgDelegate(fromAddr, valAddr1, sdk.NewInt64Coin("atom", 1)),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			auth := NewSendAuthorization(tc.limit)
+			require.NoError(t, auth.ValidateBasic())
+			require.Equal(t, "bank/send", auth.MsgType())
+
+			updated, del, err := auth.Accept(tc.msg, abci.Header{})
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.delete, del)
+			require.Equal(t, tc.updated, updated)
+		})
+	}
+}
+
+func TestStakeAuthorization(t *testing.T) {
+	atom := func(amt int64) *sdk.Coin {
+		coin := sdk.NewInt64Coin("atom", amt)
+		return &coin
+	}
+	allowList := []sdk.ValAddress{valAddr1}
+
+	cases := map[string]struct {
+		maxTokens *sdk.Coin
+		msg       sdk.Msg
+		accept    bool
+		delete    bool
+		updated   exported.Authorization
+	}{
+		"unlimited": {
+			msg:    staking.NewMsgDelegate(fromAddr, valAddr1, *atom(1000)),
+			accept: true,
+		},
+		"spend part of the limit": {
+			maxTokens: atom(100),
+			msg:       staking.NewMsgDelegate(fromAddr, valAddr1, *atom(40)),
+			accept:    true,
+			updated:   NewStakeAuthorization(allowList, atom(60)),
+		},
+		"spend the entire limit": {
+			maxTokens: atom(100),
+			msg:       staking.NewMsgDelegate(fromAddr, valAddr1, *atom(100)),
+			accept:    true,
+			delete:    true,
+		},
+		"exceed the limit": {
+			maxTokens: atom(100),
+			msg:       staking.NewMsgDelegate(fromAddr, valAddr1, *atom(101)),
+		},
+		"other denom": {
+			maxTokens: atom(100),
+			msg:       staking.NewMsgDelegate(fromAddr, valAddr1, sdk.NewInt64Coin("eth", 1)),
+		},
+		"validator not allowed": {
+			msg: staking.NewMsgDelegate(fromAddr, valAddr2, *atom(1)),
+		},
+		"other message": {
+			msg: bank.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(*atom(1))),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			auth := NewStakeAuthorization(allowList, tc.maxTokens)
+			require.NoError(t, auth.ValidateBasic())
+			require.Equal(t, "staking/delegate", auth.MsgType())
+
+			updated, del, err := auth.Accept(tc.msg, abci.Header{})
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.delete, del)
+			require.Equal(t, tc.updated, updated)
+		})
+	}
+}
+
+func TestGenericAuthorization(t *testing.T) {
+	auth := NewGenericAuthorization("gov/vote")
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "gov/vote", auth.MsgType())
+
+	updated, del, err := auth.Accept(bank.NewMsgSend(fromAddr, toAddr, nil), abci.Header{})
+	require.NoError(t, err)
+	require.False(t, del)
+	require.Nil(t, updated)
+
+	require.Error(t, NewGenericAuthorization("").ValidateBasic())
+}
+
+func TestAuthorizationValidateBasic(t *testing.T) {
+	negative := sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}
+
+	cases := map[string]struct {
+		auth  exported.Authorization
+		valid bool
+	}{
+		"send": {
+			auth:  NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 1))),
+			valid: true,
+		},
+		"send without limit": {
+			auth: NewSendAuthorization(nil),
+		},
+		"stake": {
+			auth:  NewStakeAuthorization([]sdk.ValAddress{valAddr1}, nil),
+			valid: true,
+		},
+		"stake without validators": {
+			auth: NewStakeAuthorization(nil, nil),
+		},
+		"stake with empty validator": {
+			auth: NewStakeAuthorization([]sdk.ValAddress{{}}, nil),
+		},
+		"stake with negative max tokens": {
+			auth: NewStakeAuthorization([]sdk.ValAddress{valAddr1}, &negative),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
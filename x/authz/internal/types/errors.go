@@ -0,0 +1,59 @@
+// DONTCOVER
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Error codes specific to the authz module
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoAuthorization      sdk.CodeType = 1
+	CodeInvalidAuthorization sdk.CodeType = 2
+	CodeInvalidExpiration    sdk.CodeType = 3
+	CodeInvalidSigners       sdk.CodeType = 4
+)
+
+// ErrNoAuthorization returns a typed ABCI error when no authorization for the
+// given message type exists from the granter to the grantee.
+func ErrNoAuthorization(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress, msgType string) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeNoAuthorization),
+		fmt.Sprintf("no authorization for %s from %s to %s", msgType, granter, grantee),
+	)
+}
+
+// ErrInvalidAuthorization returns a typed ABCI error for a malformed
+// authorization.
+func ErrInvalidAuthorization(codespace sdk.CodespaceType, msg string) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeInvalidAuthorization),
+		fmt.Sprintf("invalid authorization: %s", msg),
+	)
+}
+
+// ErrInvalidExpiration returns a typed ABCI error for an authorization
+// expiration which is missing or already passed.
+func ErrInvalidExpiration(codespace sdk.CodespaceType, msg string) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeInvalidExpiration),
+		fmt.Sprintf("invalid expiration: %s", msg),
+	)
+}
+
+// ErrInvalidSigners returns a typed ABCI error for an executed message which
+// does not have exactly one signer.
+func ErrInvalidSigners(codespace sdk.CodespaceType, msgType string, count int) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeInvalidSigners),
+		fmt.Sprintf("authorized messages must have exactly one signer, %s has %d", msgType, count),
+	)
+}
@@ -0,0 +1,33 @@
+package types
+
+// GenesisState contains the set of authorization grants, persisted from the
+// store
+type GenesisState struct {
+	Authorizations []AuthorizationGrant `json:"authorizations" yaml:"authorizations"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(authorizations []AuthorizationGrant) GenesisState {
+	return GenesisState{
+		Authorizations: authorizations,
+	}
+}
+
+// DefaultGenesisState returns the authz module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Authorizations: []AuthorizationGrant{},
+	}
+}
+
+// Validate performs basic genesis state validation, returning an error upon
+// any failure.
+func (gs GenesisState) Validate() error {
+	for _, g := range gs.Authorizations {
+		if err := g.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
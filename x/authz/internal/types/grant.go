@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// AuthorizationGrant is stored in the KVStore to record an authorization from
+// Granter to Grantee, which is valid until Expiration.
+type AuthorizationGrant struct {
+	Granter       sdk.AccAddress         `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Authorization exported.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time              `json:"expiration" yaml:"expiration"`
+}
+
+// NewAuthorizationGrant creates a new AuthorizationGrant.
+func NewAuthorizationGrant(
+	granter, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time,
+) AuthorizationGrant {
+	return AuthorizationGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// ValidateBasic performs basic validation on AuthorizationGrant.
+func (g AuthorizationGrant) ValidateBasic() error {
+	if g.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if g.Grantee.Equals(g.Granter) {
+		return sdk.ErrInvalidAddress("cannot self-grant an authorization")
+	}
+	if g.Authorization == nil {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing authorization")
+	}
+	if g.Expiration.IsZero() {
+		return ErrInvalidExpiration(DefaultCodespace, "missing expiration time")
+	}
+
+	return g.Authorization.ValidateBasic()
+}
+
+// IsExpired returns true if the grant is no longer valid at the given block
+// time.
+func (g AuthorizationGrant) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(g.Expiration)
+}
+
+func (g AuthorizationGrant) String() string {
+	return fmt.Sprintf(`Granter:       %s
+Grantee:       %s
+Authorization: %s
+Expiration:    %s`, g.Granter, g.Grantee, g.Authorization.MsgType(), g.Expiration)
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "authz"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// GrantKeyPrefix is the prefix of the kvstore for authorization grants
+	GrantKeyPrefix = []byte{0x01}
+)
+
+// GrantKey is the key under which an authorization for the given message type
+// from granter to grantee is stored.
+func GrantKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GrantPrefixByGranterGrantee(granter, grantee), []byte(msgType)...)
+}
+
+// GrantPrefixByGranterGrantee returns a prefix to scan for all authorizations
+// from granter to grantee.
+func GrantPrefixByGranterGrantee(granter, grantee sdk.AccAddress) []byte {
+	key := append(GrantKeyPrefix, granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
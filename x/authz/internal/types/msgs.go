@@ -0,0 +1,179 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// Message types for the authz module
+const (
+	TypeMsgGrantAuthorization  = "grant_authorization"
+	TypeMsgRevokeAuthorization = "revoke_authorization"
+	TypeMsgExecAuthorized      = "exec_authorized"
+)
+
+var (
+	_ sdk.Msg = MsgGrantAuthorization{}
+	_ sdk.Msg = MsgRevokeAuthorization{}
+	_ sdk.Msg = MsgExecAuthorized{}
+)
+
+// MsgGrantAuthorization grants the Grantee permission to execute the messages
+// described by Authorization on behalf of the Granter until Expiration.
+// If there was already an authorization for the same message type, this
+// overwrites it.
+type MsgGrantAuthorization struct {
+	Granter       sdk.AccAddress         `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Authorization exported.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time              `json:"expiration" yaml:"expiration"`
+}
+
+func NewMsgGrantAuthorization(
+	granter, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time,
+) MsgGrantAuthorization {
+	return MsgGrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// Route returns the MsgGrantAuthorization's route.
+func (msg MsgGrantAuthorization) Route() string { return RouterKey }
+
+// Type returns the MsgGrantAuthorization's type.
+func (msg MsgGrantAuthorization) Type() string { return TypeMsgGrantAuthorization }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgGrantAuthorization.
+func (msg MsgGrantAuthorization) ValidateBasic() sdk.Error {
+	return sdk.ConvertError(msg.Grant().ValidateBasic())
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgGrantAuthorization message.
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgGrantAuthorization.
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// Grant returns the AuthorizationGrant created by the message.
+func (msg MsgGrantAuthorization) Grant() AuthorizationGrant {
+	return NewAuthorizationGrant(msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration)
+}
+
+// MsgRevokeAuthorization removes any existing authorization of the given
+// message type from Granter to Grantee.
+type MsgRevokeAuthorization struct {
+	Granter              sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee              sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	AuthorizationMsgType string         `json:"authorization_msg_type" yaml:"authorization_msg_type"`
+}
+
+func NewMsgRevokeAuthorization(granter, grantee sdk.AccAddress, msgType string) MsgRevokeAuthorization {
+	return MsgRevokeAuthorization{Granter: granter, Grantee: grantee, AuthorizationMsgType: msgType}
+}
+
+// Route returns the MsgRevokeAuthorization's route.
+func (msg MsgRevokeAuthorization) Route() string { return RouterKey }
+
+// Type returns the MsgRevokeAuthorization's type.
+func (msg MsgRevokeAuthorization) Type() string { return TypeMsgRevokeAuthorization }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgRevokeAuthorization.
+func (msg MsgRevokeAuthorization) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if msg.AuthorizationMsgType == "" {
+		return sdk.ConvertError(ErrInvalidAuthorization(DefaultCodespace, "message type cannot be empty"))
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgRevokeAuthorization message.
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgRevokeAuthorization.
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgExecAuthorized executes Msgs on behalf of their signers, using the
+// authorizations they granted to the Grantee.
+type MsgExecAuthorized struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs" yaml:"msgs"`
+}
+
+func NewMsgExecAuthorized(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExecAuthorized {
+	return MsgExecAuthorized{Grantee: grantee, Msgs: msgs}
+}
+
+// Route returns the MsgExecAuthorized's route.
+func (msg MsgExecAuthorized) Route() string { return RouterKey }
+
+// Type returns the MsgExecAuthorized's type.
+func (msg MsgExecAuthorized) Type() string { return TypeMsgExecAuthorized }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgExecAuthorized, including the validation of all the executed messages.
+func (msg MsgExecAuthorized) ValidateBasic() sdk.Error {
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdk.ErrUnknownRequest("must execute at least one message")
+	}
+
+	for _, m := range msg.Msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgExecAuthorized message. The executed messages are encoded by their own
+// GetSignBytes, as the module codec does not know about their types.
+func (msg MsgExecAuthorized) GetSignBytes() []byte {
+	msgsBytes := make([]json.RawMessage, 0, len(msg.Msgs))
+	for _, m := range msg.Msgs {
+		msgsBytes = append(msgsBytes, json.RawMessage(m.GetSignBytes()))
+	}
+
+	bz, err := ModuleCdc.MarshalJSON(struct {
+		Grantee sdk.AccAddress    `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}{
+		Grantee: msg.Grantee,
+		Msgs:    msgsBytes,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the single expected signer for a MsgExecAuthorized.
+func (msg MsgExecAuthorized) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
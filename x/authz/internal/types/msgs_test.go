@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	auth := NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		msg   MsgGrantAuthorization
+		valid bool
+	}{
+		"valid": {
+			msg:   NewMsgGrantAuthorization(fromAddr, toAddr, auth, expiration),
+			valid: true,
+		},
+		"missing granter": {
+			msg: NewMsgGrantAuthorization(nil, toAddr, auth, expiration),
+		},
+		"missing grantee": {
+			msg: NewMsgGrantAuthorization(fromAddr, nil, auth, expiration),
+		},
+		"self grant": {
+			msg: NewMsgGrantAuthorization(fromAddr, fromAddr, auth, expiration),
+		},
+		"missing authorization": {
+			msg: NewMsgGrantAuthorization(fromAddr, toAddr, nil, expiration),
+		},
+		"invalid authorization": {
+			msg: NewMsgGrantAuthorization(fromAddr, toAddr, NewSendAuthorization(nil), expiration),
+		},
+		"missing expiration": {
+			msg: NewMsgGrantAuthorization(fromAddr, toAddr, auth, time.Time{}),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{fromAddr}, tc.msg.GetSigners())
+			require.NotPanics(t, func() { tc.msg.GetSignBytes() })
+		})
+	}
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	require.NoError(t, NewMsgRevokeAuthorization(fromAddr, toAddr, "bank/send").ValidateBasic())
+	require.Error(t, NewMsgRevokeAuthorization(nil, toAddr, "bank/send").ValidateBasic())
+	require.Error(t, NewMsgRevokeAuthorization(fromAddr, nil, "bank/send").ValidateBasic())
+	require.Error(t, NewMsgRevokeAuthorization(fromAddr, toAddr, "").ValidateBasic())
+}
+
+func TestMsgExecAuthorized(t *testing.T) {
+	send := bank.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	invalidSend := bank.NewMsgSend(fromAddr, toAddr, sdk.Coins{})
+
+	msg := NewMsgExecAuthorized(toAddr, []sdk.Msg{send})
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{toAddr}, msg.GetSigners())
+
+	expected := `{"grantee":"` + toAddr.String() + `","msgs":[` + string(send.GetSignBytes()) + `]}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+
+	require.Error(t, NewMsgExecAuthorized(nil, []sdk.Msg{send}).ValidateBasic())
+	require.Error(t, NewMsgExecAuthorized(toAddr, nil).ValidateBasic())
+	require.Error(t, NewMsgExecAuthorized(toAddr, []sdk.Msg{invalidSend}).ValidateBasic())
+}
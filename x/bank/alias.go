@@ -12,10 +12,16 @@ import (
 
 const (
 	QueryBalance             = keeper.QueryBalance
+	QueryAllBalances         = types.QueryAllBalances
+	QueryBalanceOf           = types.QueryBalanceOf
+	QueryDenomMetadata       = types.QueryDenomMetadata
+	QueryDenomsMetadata      = types.QueryDenomsMetadata
 	DefaultCodespace         = types.DefaultCodespace
 	CodeSendDisabled         = types.CodeSendDisabled
 	CodeInvalidInputsOutputs = types.CodeInvalidInputsOutputs
+	CodeUnknownDenomMetadata = types.CodeUnknownDenomMetadata
 	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
 	QuerierRoute             = types.QuerierRoute
 	RouterKey                = types.RouterKey
 	DefaultParamspace        = types.DefaultParamspace
@@ -40,33 +46,62 @@ var (
 	ErrNoOutputs                = types.ErrNoOutputs
 	ErrInputOutputMismatch      = types.ErrInputOutputMismatch
 	ErrSendDisabled             = types.ErrSendDisabled
+	ErrDenomMetadataNotFound    = types.ErrDenomMetadataNotFound
 	NewGenesisState             = types.NewGenesisState
 	DefaultGenesisState         = types.DefaultGenesisState
 	ValidateGenesis             = types.ValidateGenesis
 	NewMsgSend                  = types.NewMsgSend
 	NewMsgMultiSend             = types.NewMsgMultiSend
+	DenomMetadataKey            = types.DenomMetadataKey
+	NewDenomUnit                = types.NewDenomUnit
+	NewMetadata                 = types.NewMetadata
 	NewInput                    = types.NewInput
 	NewOutput                   = types.NewOutput
 	ValidateInputsOutputs       = types.ValidateInputsOutputs
 	ParamKeyTable               = types.ParamKeyTable
+	NewParams                   = types.NewParams
+	DefaultParams               = types.DefaultParams
+	NewSendEnabled              = types.NewSendEnabled
 	NewQueryBalanceParams       = types.NewQueryBalanceParams
+	NewQueryAllBalancesParams   = types.NewQueryAllBalancesParams
+	NewQueryBalanceOfParams     = types.NewQueryBalanceOfParams
+	NewQueryDenomMetadataParams = types.NewQueryDenomMetadataParams
+	NewQueryServer              = keeper.NewQueryServer
+	RegisterQueryService        = types.RegisterQueryService
+	NewQueryClient              = types.NewQueryClient
 
 	// variable aliases
-	ModuleCdc                = types.ModuleCdc
-	ParamStoreKeySendEnabled = types.ParamStoreKeySendEnabled
+	ModuleCdc                       = types.ModuleCdc
+	DenomMetadataPrefix             = types.DenomMetadataPrefix
+	ParamStoreKeySendEnabled        = types.ParamStoreKeySendEnabled
+	ParamStoreKeyDefaultSendEnabled = types.ParamStoreKeyDefaultSendEnabled
 )
 
 type (
-	Keeper             = keeper.Keeper
-	BaseKeeper         = keeper.BaseKeeper
-	SendKeeper         = keeper.SendKeeper
-	BaseSendKeeper     = keeper.BaseSendKeeper
-	ViewKeeper         = keeper.ViewKeeper
-	BaseViewKeeper     = keeper.BaseViewKeeper
-	GenesisState       = types.GenesisState
-	MsgSend            = types.MsgSend
-	MsgMultiSend       = types.MsgMultiSend
-	Input              = types.Input
-	Output             = types.Output
-	QueryBalanceParams = types.QueryBalanceParams
+	Keeper                   = keeper.Keeper
+	BaseKeeper               = keeper.BaseKeeper
+	SendKeeper               = keeper.SendKeeper
+	BaseSendKeeper           = keeper.BaseSendKeeper
+	ViewKeeper               = keeper.ViewKeeper
+	BaseViewKeeper           = keeper.BaseViewKeeper
+	GenesisState             = types.GenesisState
+	DenomUnit                = types.DenomUnit
+	Metadata                 = types.Metadata
+	Params                   = types.Params
+	SendEnabled              = types.SendEnabled
+	SendEnabledParams        = types.SendEnabledParams
+	MsgSend                  = types.MsgSend
+	MsgMultiSend             = types.MsgMultiSend
+	Input                    = types.Input
+	Output                   = types.Output
+	QueryBalanceParams       = types.QueryBalanceParams
+	QueryAllBalancesParams   = types.QueryAllBalancesParams
+	QueryBalanceOfParams     = types.QueryBalanceOfParams
+	QueryDenomMetadataParams = types.QueryDenomMetadataParams
+	QueryServer              = types.QueryServer
+	QueryClient              = types.QueryClient
+	QueryBalanceRequest      = types.QueryBalanceRequest
+	QueryBalanceResponse     = types.QueryBalanceResponse
+	QueryAllBalancesRequest  = types.QueryAllBalancesRequest
+	QueryAllBalancesResponse = types.QueryAllBalancesResponse
 )
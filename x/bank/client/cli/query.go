@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+const (
+	flagDenom = "denom"
+	flagPage  = "page"
+	flagLimit = "limit"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group bank queries under a subcommand
+	bankQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the bank module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	bankQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryBalances(cdc),
+		GetCmdQueryDenomMetadata(cdc),
+	)...)
+
+	return bankQueryCmd
+}
+
+// GetCmdQueryBalances implements the query account balances command.
+func GetCmdQueryBalances(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the (paginated) balances of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a page of the balances of an account, sorted by denomination.
+
+Example:
+$ %s query %s balances [address] --page=2 --limit=50
+
+To query the balance of a specific denomination use:
+$ %s query %s balances [address] --denom=stake
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			denom := viper.GetString(flagDenom)
+			if denom == "" {
+				params := types.NewQueryAllBalancesParams(addr, viper.GetInt(flagPage), viper.GetInt(flagLimit))
+				bz, err := cdc.MarshalJSON(params)
+				if err != nil {
+					return err
+				}
+
+				route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllBalances)
+				res, _, err := cliCtx.QueryWithData(route, bz)
+				if err != nil {
+					return err
+				}
+
+				var balances sdk.Coins
+				if err := cdc.UnmarshalJSON(res, &balances); err != nil {
+					return err
+				}
+
+				return cliCtx.PrintOutput(balances)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryBalanceOfParams(addr, denom))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBalanceOf)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var balance sdk.Int
+			if err := cdc.UnmarshalJSON(res, &balance); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(balance)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "The specific balance denomination to query for")
+	cmd.Flags().Int(flagPage, 1, "pagination page of balances to query for")
+	cmd.Flags().Int(flagLimit, 100, "pagination limit of balances to query for")
+
+	return cmd
+}
+
+// GetCmdQueryDenomMetadata implements the query denomination metadata command.
+func GetCmdQueryDenomMetadata(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom-metadata [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the client metadata of coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the client metadata of all the registered coin denominations.
+
+Example:
+$ %s query %s denom-metadata
+
+To query the client metadata of a specific base denomination use:
+$ %s query %s denom-metadata uatom
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomsMetadata)
+				res, _, err := cliCtx.QueryWithData(route, nil)
+				if err != nil {
+					return err
+				}
+
+				var metadata []types.Metadata
+				if err := cdc.UnmarshalJSON(res, &metadata); err != nil {
+					return err
+				}
+
+				return cliCtx.PrintOutput(metadata)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDenomMetadataParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomMetadata)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var metadata types.Metadata
+			if err := cdc.UnmarshalJSON(res, &metadata); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(metadata)
+		},
+	}
+}
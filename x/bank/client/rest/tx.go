@@ -17,6 +17,9 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", SendRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/balances/{address}/{denom}", QueryBalanceOfRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/denoms_metadata", QueryDenomsMetadataRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/denoms_metadata/{denom}", QueryDenomMetadataRequestHandlerFn(cliCtx)).Methods("GET")
 }
 
 // SendReq defines the properties of a send request's body.
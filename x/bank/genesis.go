@@ -6,10 +6,14 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
-	keeper.SetSendEnabled(ctx, data.SendEnabled)
+	keeper.SetParams(ctx, data.Params)
+
+	for _, metadata := range data.DenomMetadata {
+		keeper.SetDenomMetaData(ctx, metadata)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSendEnabled(ctx))
+	return NewGenesisState(keeper.GetParams(ctx), keeper.GetAllDenomMetaData(ctx))
 }
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+// queryServer implements the bank gRPC query service on top of a Keeper.
+type queryServer struct {
+	keeper Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the bank gRPC query service
+// backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{keeper: k}
+}
+
+// Balance implements the Query/Balance gRPC method.
+func (q queryServer) Balance(goCtx context.Context, req *types.QueryBalanceRequest) (*types.QueryBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount := q.keeper.GetCoins(ctx, req.Address).AmountOf(req.Denom)
+
+	return &types.QueryBalanceResponse{Balance: sdk.NewCoin(req.Denom, amount)}, nil
+}
+
+// AllBalances implements the Query/AllBalances gRPC method. Balances are
+// returned one page at a time, sorted by denomination.
+func (q queryServer) AllBalances(goCtx context.Context, req *types.QueryAllBalancesRequest) (*types.QueryAllBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	balances := q.keeper.GetCoins(ctx, req.Address)
+
+	// an unset page defaults to the first one, and an unset limit to 100
+	// balances, so that a response is always bounded
+	page := int(req.Page)
+	if page == 0 {
+		page = 1
+	}
+
+	start, end := client.Paginate(len(balances), page, int(req.Limit), 100)
+	if start < 0 || end < 0 {
+		balances = sdk.NewCoins()
+	} else {
+		balances = balances[start:end]
+	}
+
+	return &types.QueryAllBalancesResponse{Balances: balances}, nil
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	keep "github.com/cosmos/cosmos-sdk/x/bank/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+func TestGRPCQueryBalances(t *testing.T) {
+	app, ctx := createTestApp(false)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keep.NewQueryServer(app.BankKeeper)
+
+	_, err := queryServer.Balance(goCtx, nil)
+	require.Error(t, err)
+	_, err = queryServer.Balance(goCtx, &types.QueryBalanceRequest{Denom: "foo"})
+	require.Error(t, err)
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+
+	// the account does not exist, a zero balance is returned
+	balanceRes, err := queryServer.Balance(goCtx, &types.QueryBalanceRequest{Address: addr, Denom: "foo"})
+	require.NoError(t, err)
+	require.True(t, balanceRes.Balance.IsZero())
+
+	allRes, err := queryServer.AllBalances(goCtx, &types.QueryAllBalancesRequest{Address: addr})
+	require.NoError(t, err)
+	require.True(t, sdk.Coins(allRes.Balances).IsZero())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("bar", 20), sdk.NewInt64Coin("foo", 10))
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	acc.SetCoins(coins)
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	balanceRes, err = queryServer.Balance(goCtx, &types.QueryBalanceRequest{Address: addr, Denom: "foo"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("foo", 10), balanceRes.Balance)
+
+	allRes, err = queryServer.AllBalances(goCtx, &types.QueryAllBalancesRequest{Address: addr})
+	require.NoError(t, err)
+	require.Equal(t, coins, sdk.Coins(allRes.Balances))
+
+	// balances are paginated by denomination
+	allRes, err = queryServer.AllBalances(goCtx, &types.QueryAllBalancesRequest{Address: addr, Page: 2, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), sdk.Coins(allRes.Balances))
+
+	allRes, err = queryServer.AllBalances(goCtx, &types.QueryAllBalancesRequest{Address: addr, Page: 3, Limit: 1})
+	require.NoError(t, err)
+	require.True(t, sdk.Coins(allRes.Balances).IsZero())
+}
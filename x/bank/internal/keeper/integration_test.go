@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 )
 
 func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
@@ -13,7 +14,7 @@ func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
 
 	app.AccountKeeper.SetParams(ctx, auth.DefaultParams())
-	app.BankKeeper.SetSendEnabled(ctx, true)
+	app.BankKeeper.SetParams(ctx, types.DefaultParams())
 
 	return app, ctx
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
@@ -22,24 +23,33 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+
+	GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool)
+	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
+	IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) (stop bool))
+	GetAllDenomMetaData(ctx sdk.Context) []types.Metadata
 }
 
 // BaseKeeper manages transfers between accounts. It implements the Keeper interface.
 type BaseKeeper struct {
 	BaseSendKeeper
 
+	cdc        *codec.Codec
+	storeKey   sdk.StoreKey
 	ak         types.AccountKeeper
 	paramSpace params.Subspace
 }
 
 // NewBaseKeeper returns a new BaseKeeper
-func NewBaseKeeper(ak types.AccountKeeper,
+func NewBaseKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, ak types.AccountKeeper,
 	paramSpace params.Subspace,
-	codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseKeeper {
+	codespace sdk.CodespaceType, blacklistedAddrs, sendEnabledExemptAddrs map[string]bool) BaseKeeper {
 
 	ps := paramSpace.WithKeyTable(types.ParamKeyTable())
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(ak, ps, codespace, blacklistedAddrs),
+		BaseSendKeeper: NewBaseSendKeeper(ak, ps, codespace, blacklistedAddrs, sendEnabledExemptAddrs),
+		cdc:            cdc,
+		storeKey:       storeKey,
 		ak:             ak,
 		paramSpace:     ps,
 	}
@@ -132,6 +142,57 @@ func (keeper BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegat
 	return nil
 }
 
+// GetDenomMetaData retrieves the denomination metadata of the given base
+// denomination. It returns false if no metadata is registered for it.
+func (keeper BaseKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.DenomMetadataKey(denom))
+	if bz == nil {
+		return types.Metadata{}, false
+	}
+
+	var metadata types.Metadata
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &metadata)
+	return metadata, true
+}
+
+// SetDenomMetaData sets the denomination metadata, keyed by its base
+// denomination.
+func (keeper BaseKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(denomMetaData)
+	store.Set(types.DenomMetadataKey(denomMetaData.Base), bz)
+}
+
+// IterateAllDenomMetaData iterates over all the denomination metadata, in
+// order of their base denomination, and calls the provided callback. The
+// iteration stops when the callback returns true.
+func (keeper BaseKeeper) IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomMetadataPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.Metadata
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &metadata)
+
+		if cb(metadata) {
+			break
+		}
+	}
+}
+
+// GetAllDenomMetaData returns all the registered denomination metadata.
+func (keeper BaseKeeper) GetAllDenomMetaData(ctx sdk.Context) []types.Metadata {
+	denomMetaData := []types.Metadata{}
+	keeper.IterateAllDenomMetaData(ctx, func(metadata types.Metadata) bool {
+		denomMetaData = append(denomMetaData, metadata)
+		return false
+	})
+
+	return denomMetaData
+}
+
 // SendKeeper defines a module interface that facilitates the transfer of coins
 // between accounts without the possibility of creating coins.
 type SendKeeper interface {
@@ -144,10 +205,13 @@ type SendKeeper interface {
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error)
 	SetCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
 
-	GetSendEnabled(ctx sdk.Context) bool
-	SetSendEnabled(ctx sdk.Context, enabled bool)
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
+	SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
+	SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error
 
 	BlacklistedAddr(addr sdk.AccAddress) bool
+	SendEnabledExemptAddr(addr sdk.AccAddress) bool
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -162,21 +226,27 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blacklistedAddrs map[string]bool
+
+	// list of addresses whose transfers are not restricted by the SendEnabled
+	// params, for the module accounts the state machine moves funds through
+	sendEnabledExemptAddrs map[string]bool
 }
 
 // NewBaseSendKeeper returns a new BaseSendKeeper.
 func NewBaseSendKeeper(ak types.AccountKeeper,
-	paramSpace params.Subspace, codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseSendKeeper {
+	paramSpace params.Subspace, codespace sdk.CodespaceType, blacklistedAddrs, sendEnabledExemptAddrs map[string]bool) BaseSendKeeper {
 
 	return BaseSendKeeper{
-		BaseViewKeeper:   NewBaseViewKeeper(ak, codespace),
-		ak:               ak,
-		paramSpace:       paramSpace,
-		blacklistedAddrs: blacklistedAddrs,
+		BaseViewKeeper:         NewBaseViewKeeper(ak, codespace),
+		ak:                     ak,
+		paramSpace:             paramSpace,
+		blacklistedAddrs:       blacklistedAddrs,
+		sendEnabledExemptAddrs: sendEnabledExemptAddrs,
 	}
 }
 
-// InputOutputCoins handles a list of inputs and outputs
+// InputOutputCoins handles a list of inputs and outputs. It fails if any of the
+// input coins cannot be transferred.
 func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) sdk.Error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -184,6 +254,12 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 		return err
 	}
 
+	for _, in := range inputs {
+		if err := keeper.SendEnabledCoins(ctx, in.Coins...); err != nil {
+			return err
+		}
+	}
+
 	for _, in := range inputs {
 		_, err := keeper.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
@@ -215,8 +291,17 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 	return nil
 }
 
-// SendCoins moves coins from one account to another
+// SendCoins moves coins from one account to another. It fails if any of the
+// coins cannot be transferred, unless either address is exempt from the
+// SendEnabled params so that the fees, rewards and bonded tokens moved by the
+// state machine keep flowing for disabled denominations.
 func (keeper BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if !keeper.SendEnabledExemptAddr(fromAddr) && !keeper.SendEnabledExemptAddr(toAddr) {
+		if err := keeper.SendEnabledCoins(ctx, amt...); err != nil {
+			return err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -315,16 +400,42 @@ func (keeper BaseSendKeeper) SetCoins(ctx sdk.Context, addr sdk.AccAddress, amt
 	return nil
 }
 
-// GetSendEnabled returns the current SendEnabled
-func (keeper BaseSendKeeper) GetSendEnabled(ctx sdk.Context) bool {
-	var enabled bool
-	keeper.paramSpace.Get(ctx, types.ParamStoreKeySendEnabled, &enabled)
-	return enabled
+// GetParams returns the total set of bank parameters.
+func (keeper BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
+	// the per-denom list is missing on chains upgraded in place, in which case
+	// every denomination follows DefaultSendEnabled
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeySendEnabled, &params.SendEnabled)
+	keeper.paramSpace.Get(ctx, types.ParamStoreKeyDefaultSendEnabled, &params.DefaultSendEnabled)
+	return params
+}
+
+// SetParams sets the total set of bank parameters.
+func (keeper BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	keeper.paramSpace.SetParamSet(ctx, &params)
+}
+
+// SendEnabledCoin returns whether the coin's denomination can be transferred.
+func (keeper BaseSendKeeper) SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
+	return keeper.GetParams(ctx).SendEnabledDenom(coin.Denom)
+}
+
+// SendEnabledCoins returns an error if any of the coins' denominations cannot
+// be transferred.
+func (keeper BaseSendKeeper) SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error {
+	params := keeper.GetParams(ctx)
+	for _, coin := range coins {
+		if !params.SendEnabledDenom(coin.Denom) {
+			return types.ErrSendDisabled(keeper.codespace, coin.Denom)
+		}
+	}
+
+	return nil
 }
 
-// SetSendEnabled sets the send enabled
-func (keeper BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
-	keeper.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
+// SendEnabledExemptAddr checks if a given address is exempt from the
+// SendEnabled params (i.e allowed to send and receive disabled denominations)
+func (keeper BaseSendKeeper) SendEnabledExemptAddr(addr sdk.AccAddress) bool {
+	return keeper.sendEnabledExemptAddrs[addr.String()]
 }
 
 // BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
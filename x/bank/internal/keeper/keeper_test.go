@@ -18,6 +18,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	keep "github.com/cosmos/cosmos-sdk/x/bank/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 func TestKeeper(t *testing.T) {
@@ -108,9 +110,9 @@ func TestSendKeeper(t *testing.T) {
 
 	blacklistedAddrs := make(map[string]bool)
 
-	paramSpace := app.ParamsKeeper.Subspace("newspace")
-	sendKeeper := keep.NewBaseSendKeeper(app.AccountKeeper, paramSpace, types.DefaultCodespace, blacklistedAddrs)
-	app.BankKeeper.SetSendEnabled(ctx, true)
+	paramSpace := app.ParamsKeeper.Subspace("newspace").WithKeyTable(types.ParamKeyTable())
+	sendKeeper := keep.NewBaseSendKeeper(app.AccountKeeper, paramSpace, types.DefaultCodespace, blacklistedAddrs, nil)
+	sendKeeper.SetParams(ctx, types.DefaultParams())
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
@@ -153,10 +155,107 @@ func TestSendKeeper(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSendEnabled(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	moduleAddr := supply.NewModuleAddress(auth.FeeCollectorName)
+	tokenizeShareAddr := supply.NewModuleAddress(staking.TokenizeSharePoolName)
+
+	app.AccountKeeper.SetAccount(ctx, supply.NewEmptyModuleAccount(auth.FeeCollectorName))
+	app.AccountKeeper.SetAccount(ctx, supply.NewEmptyModuleAccount(staking.TokenizeSharePoolName))
+	app.BankKeeper.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 20), sdk.NewInt64Coin("barcoin", 20)))
+
+	// disable transfers of barcoin only
+	params := types.NewParams(true, types.SendEnabledParams{types.NewSendEnabled("barcoin", false)})
+	app.BankKeeper.SetParams(ctx, params)
+	require.Equal(t, params, app.BankKeeper.GetParams(ctx))
+
+	require.True(t, app.BankKeeper.SendEnabledCoin(ctx, sdk.NewInt64Coin("foocoin", 1)))
+	require.False(t, app.BankKeeper.SendEnabledCoin(ctx, sdk.NewInt64Coin("barcoin", 1)))
+	require.Error(t, app.BankKeeper.SendEnabledCoins(ctx, sdk.NewInt64Coin("foocoin", 1), sdk.NewInt64Coin("barcoin", 1)))
+
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+	require.Error(t, app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5))))
+	require.True(t, app.BankKeeper.GetCoins(ctx, addr2).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+
+	inputs := []types.Input{types.NewInput(addr, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5)))}
+	outputs := []types.Output{types.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5)))}
+	require.Error(t, app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+
+	// transfers to and from the exempt module accounts are not restricted
+	require.True(t, app.BankKeeper.SendEnabledExemptAddr(moduleAddr))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr, moduleAddr, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5))))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, moduleAddr, addr2, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5))))
+
+	// transfers to and from any other module account are restricted
+	require.False(t, app.BankKeeper.SendEnabledExemptAddr(tokenizeShareAddr))
+	require.Error(t, app.BankKeeper.SendCoins(ctx, addr, tokenizeShareAddr, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5))))
+	app.BankKeeper.SetCoins(ctx, tokenizeShareAddr, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5)))
+	require.Error(t, app.BankKeeper.SendCoins(ctx, tokenizeShareAddr, addr2, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5))))
+
+	// disable all transfers by default and re-enable foocoin
+	app.BankKeeper.SetParams(ctx, types.NewParams(false, types.SendEnabledParams{types.NewSendEnabled("foocoin", true)}))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+	require.Error(t, app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("bazcoin", 5))))
+}
+
+func TestSendEnabledParamsInPlaceUpgrade(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// a chain upgraded in place only holds the former global send enabled flag
+	app.BankKeeper.SetParams(ctx, types.NewParams(false, types.SendEnabledParams{types.NewSendEnabled("foocoin", true)}))
+	paramStore := ctx.KVStore(app.GetKey(params.StoreKey))
+	paramStore.Delete(append([]byte(types.DefaultParamspace+"/"), types.ParamStoreKeySendEnabled...))
+
+	var bankParams types.Params
+	require.NotPanics(t, func() { bankParams = app.BankKeeper.GetParams(ctx) })
+	require.False(t, bankParams.DefaultSendEnabled)
+	require.Empty(t, bankParams.SendEnabled)
+	require.False(t, app.BankKeeper.SendEnabledCoin(ctx, sdk.NewInt64Coin("foocoin", 1)))
+}
+
+func TestDenomMetaData(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	_, found := app.BankKeeper.GetDenomMetaData(ctx, "uatom")
+	require.False(t, found)
+	require.Empty(t, app.BankKeeper.GetAllDenomMetaData(ctx))
+
+	atom := types.NewMetadata(
+		"The native staking token of the Cosmos Hub.", "uatom", "atom",
+		[]types.DenomUnit{types.NewDenomUnit("uatom", 0, nil), types.NewDenomUnit("matom", 3, nil), types.NewDenomUnit("atom", 6, nil)},
+	)
+	eth := types.NewMetadata(
+		"Ether.", "wei", "eth",
+		[]types.DenomUnit{types.NewDenomUnit("wei", 0, nil), types.NewDenomUnit("eth", 18, nil)},
+	)
+	app.BankKeeper.SetDenomMetaData(ctx, atom)
+	app.BankKeeper.SetDenomMetaData(ctx, eth)
+
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, "uatom")
+	require.True(t, found)
+	require.Equal(t, atom, metadata)
+
+	// metadata is keyed by base denom only
+	_, found = app.BankKeeper.GetDenomMetaData(ctx, "atom")
+	require.False(t, found)
+
+	require.Equal(t, []types.Metadata{atom, eth}, app.BankKeeper.GetAllDenomMetaData(ctx))
+
+	var iterated []types.Metadata
+	app.BankKeeper.IterateAllDenomMetaData(ctx, func(metadata types.Metadata) bool {
+		iterated = append(iterated, metadata)
+		return true
+	})
+	require.Equal(t, []types.Metadata{atom}, iterated)
+}
+
 func TestMsgSendEvents(t *testing.T) {
 	app, ctx := createTestApp(false)
 
-	app.BankKeeper.SetSendEnabled(ctx, true)
+	app.BankKeeper.SetParams(ctx, types.DefaultParams())
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
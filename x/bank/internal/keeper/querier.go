@@ -5,6 +5,7 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
@@ -22,6 +23,18 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case QueryBalance:
 			return queryBalance(ctx, req, k)
 
+		case types.QueryAllBalances:
+			return queryAllBalances(ctx, req, k)
+
+		case types.QueryBalanceOf:
+			return queryBalanceOf(ctx, req, k)
+
+		case types.QueryDenomMetadata:
+			return queryDenomMetadata(ctx, req, k)
+
+		case types.QueryDenomsMetadata:
+			return queryDenomsMetadata(ctx, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown bank query endpoint")
 		}
@@ -49,3 +62,80 @@ func queryBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk
 
 	return bz, nil
 }
+
+// queryAllBalances fetches a page of an account's balances, sorted by
+// denomination.
+func queryAllBalances(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAllBalancesParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	coins := k.GetCoins(ctx, params.Address)
+
+	start, end := client.Paginate(len(coins), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		coins = sdk.NewCoins()
+	} else {
+		coins = coins[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, coins)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryBalanceOf fetches the amount of a single denomination held by an
+// account.
+func queryBalanceOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBalanceOfParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	balance := k.GetCoins(ctx, params.Address).AmountOf(params.Denom)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, balance)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryDenomMetadata fetches the metadata of the denomination passed in the
+// query params.
+func queryDenomMetadata(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDenomMetadataParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	metadata, found := k.GetDenomMetaData(ctx, params.Denom)
+	if !found {
+		return nil, types.ErrDenomMetadataNotFound(types.DefaultCodespace, params.Denom)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, metadata)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryDenomsMetadata fetches the metadata of all registered denominations.
+func queryDenomsMetadata(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetAllDenomMetaData(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
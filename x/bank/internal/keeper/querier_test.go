@@ -47,6 +47,115 @@ func TestBalances(t *testing.T) {
 	require.True(t, coins.AmountOf("foo").Equal(sdk.NewInt(10)))
 }
 
+func TestAllBalances(t *testing.T) {
+	app, ctx := createTestApp(false)
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/bank/%s", types.QueryAllBalances),
+		Data: []byte{},
+	}
+
+	querier := keep.NewQuerier(app.BankKeeper)
+
+	res, err := querier(ctx, []string{types.QueryAllBalances}, req)
+	require.NotNil(t, err)
+	require.Nil(t, res)
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	acc.SetCoins(sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 20), sdk.NewInt64Coin("baz", 30), sdk.NewInt64Coin("foo", 10),
+	))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	tests := []struct {
+		page, limit int
+		expected    sdk.Coins
+	}{
+		{1, 0, sdk.NewCoins(sdk.NewInt64Coin("bar", 20), sdk.NewInt64Coin("baz", 30), sdk.NewInt64Coin("foo", 10))},
+		{1, 2, sdk.NewCoins(sdk.NewInt64Coin("bar", 20), sdk.NewInt64Coin("baz", 30))},
+		{2, 2, sdk.NewCoins(sdk.NewInt64Coin("foo", 10))},
+		{3, 2, sdk.NewCoins()},
+		{0, 2, sdk.NewCoins()},
+	}
+
+	for _, tc := range tests {
+		req.Data = app.Codec().MustMarshalJSON(types.NewQueryAllBalancesParams(addr, tc.page, tc.limit))
+		res, err = querier(ctx, []string{types.QueryAllBalances}, req)
+		require.Nil(t, err)
+
+		var coins sdk.Coins
+		require.NoError(t, app.Codec().UnmarshalJSON(res, &coins))
+		require.True(t, tc.expected.IsEqual(coins), "page %d, limit %d: %s", tc.page, tc.limit, coins)
+	}
+}
+
+func TestBalanceOf(t *testing.T) {
+	app, ctx := createTestApp(false)
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/bank/%s", types.QueryBalanceOf),
+		Data: []byte{},
+	}
+
+	querier := keep.NewQuerier(app.BankKeeper)
+
+	res, err := querier(ctx, []string{types.QueryBalanceOf}, req)
+	require.NotNil(t, err)
+	require.Nil(t, res)
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	acc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("bar", 20), sdk.NewInt64Coin("foo", 10)))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	var balance sdk.Int
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryBalanceOfParams(addr, "foo"))
+	res, err = querier(ctx, []string{types.QueryBalanceOf}, req)
+	require.Nil(t, err)
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &balance))
+	require.True(t, balance.Equal(sdk.NewInt(10)))
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryBalanceOfParams(addr, "baz"))
+	res, err = querier(ctx, []string{types.QueryBalanceOf}, req)
+	require.Nil(t, err)
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &balance))
+	require.True(t, balance.IsZero())
+}
+
+func TestDenomMetadata(t *testing.T) {
+	app, ctx := createTestApp(false)
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/bank/%s", types.QueryDenomMetadata),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryDenomMetadataParams("uatom")),
+	}
+
+	querier := keep.NewQuerier(app.BankKeeper)
+
+	res, err := querier(ctx, []string{types.QueryDenomMetadata}, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	metadata := types.NewMetadata(
+		"The native staking token of the Cosmos Hub.", "uatom", "atom",
+		[]types.DenomUnit{types.NewDenomUnit("uatom", 0, []string{"microatom"}), types.NewDenomUnit("atom", 6, nil)},
+	)
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+
+	res, err = querier(ctx, []string{types.QueryDenomMetadata}, req)
+	require.NoError(t, err)
+
+	var resMetadata types.Metadata
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &resMetadata))
+	require.Equal(t, metadata, resMetadata)
+
+	res, err = querier(ctx, []string{types.QueryDenomsMetadata}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var resMetadatas []types.Metadata
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &resMetadatas))
+	require.Equal(t, []types.Metadata{metadata}, resMetadatas)
+}
+
 func TestQuerierRouteNotFound(t *testing.T) {
 	app, ctx := createTestApp(false)
 	req := abci.RequestQuery{
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,6 +12,7 @@ const (
 
 	CodeSendDisabled         sdk.CodeType = 101
 	CodeInvalidInputsOutputs sdk.CodeType = 102
+	CodeUnknownDenomMetadata sdk.CodeType = 103
 )
 
 // ErrNoInputs is an error
@@ -28,6 +31,11 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 }
 
 // ErrSendDisabled is an error
-func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
-	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
+func ErrSendDisabled(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeSendDisabled, fmt.Sprintf("%s transfers are currently disabled", denom))
+}
+
+// ErrDenomMetadataNotFound is an error
+func ErrDenomMetadataNotFound(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownDenomMetadata, fmt.Sprintf("no metadata registered for denom %s", denom))
 }
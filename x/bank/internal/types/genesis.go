@@ -1,18 +1,43 @@
 package types
 
+import (
+	"fmt"
+)
+
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
-	SendEnabled bool `json:"send_enabled" yaml:"send_enabled"`
+	Params        Params     `json:"params" yaml:"params"`
+	DenomMetadata []Metadata `json:"denom_metadata" yaml:"denom_metadata"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled}
+func NewGenesisState(params Params, denomMetadata []Metadata) GenesisState {
+	return GenesisState{
+		Params:        params,
+		DenomMetadata: denomMetadata,
+	}
 }
 
 // DefaultGenesisState returns a default genesis state
-func DefaultGenesisState() GenesisState { return NewGenesisState(true) }
+func DefaultGenesisState() GenesisState { return NewGenesisState(DefaultParams(), []Metadata{}) }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenMetadata := make(map[string]bool)
+	for _, metadata := range data.DenomMetadata {
+		if seenMetadata[metadata.Base] {
+			return fmt.Errorf("duplicate client metadata for denom %s", metadata.Base)
+		}
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+		seenMetadata[metadata.Base] = true
+	}
+
+	return nil
+}
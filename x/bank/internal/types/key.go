@@ -2,6 +2,21 @@ package types
 
 const (
 	// module name
-	ModuleName   = "bank"
+	ModuleName = "bank"
+
+	// StoreKey is the default store key for bank
+	StoreKey = ModuleName
+
 	QuerierRoute = ModuleName
 )
+
+// KVStore key prefixes
+var (
+	// DenomMetadataPrefix is the prefix for denomination metadata entries
+	DenomMetadataPrefix = []byte{0x01}
+)
+
+// DenomMetadataKey returns the store key of a denomination's metadata.
+func DenomMetadataKey(denom string) []byte {
+	return append(DenomMetadataPrefix, []byte(denom)...)
+}
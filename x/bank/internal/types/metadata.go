@@ -0,0 +1,143 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DenomUnit represents a struct that describes a given denomination unit of
+// the basic token.
+type DenomUnit struct {
+	// Denom is the string name of the denomination unit
+	Denom string `json:"denom" yaml:"denom"`
+	// Exponent is the power of 10 by which a unit of this denomination
+	// exceeds one unit of the base denomination, e.g. 6 for "atom" when the
+	// base denomination is "uatom"
+	Exponent uint32 `json:"exponent" yaml:"exponent"`
+	// Aliases is a list of alternative names for the denomination unit
+	Aliases []string `json:"aliases" yaml:"aliases"`
+}
+
+// NewDenomUnit creates a new DenomUnit object
+func NewDenomUnit(denom string, exponent uint32, aliases []string) DenomUnit {
+	return DenomUnit{
+		Denom:    denom,
+		Exponent: exponent,
+		Aliases:  aliases,
+	}
+}
+
+// Validate performs a basic validation of the denomination unit fields.
+func (du DenomUnit) Validate() error {
+	if err := sdk.ValidateDenom(du.Denom); err != nil {
+		return fmt.Errorf("invalid denom unit: %w", err)
+	}
+
+	for _, alias := range du.Aliases {
+		if err := sdk.ValidateDenom(alias); err != nil {
+			return fmt.Errorf("invalid alias for denom unit %s: %w", du.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (du DenomUnit) String() string {
+	return fmt.Sprintf("%s (10^%d) aliases: [%s]", du.Denom, du.Exponent, strings.Join(du.Aliases, ", "))
+}
+
+// Metadata represents a struct that describes a basic token and the
+// denomination units it can be displayed in.
+type Metadata struct {
+	Description string `json:"description" yaml:"description"`
+	// DenomUnits represents the list of units of the token, sorted by
+	// increasing exponent, starting with the base denomination
+	DenomUnits []DenomUnit `json:"denom_units" yaml:"denom_units"`
+	// Base is the denomination of the smallest unit, in which balances and
+	// supply are stored
+	Base string `json:"base" yaml:"base"`
+	// Display is the denomination clients should use to show amounts
+	Display string `json:"display" yaml:"display"`
+}
+
+// NewMetadata creates a new Metadata object
+func NewMetadata(description, base, display string, denomUnits []DenomUnit) Metadata {
+	return Metadata{
+		Description: description,
+		DenomUnits:  denomUnits,
+		Base:        base,
+		Display:     display,
+	}
+}
+
+// Validate performs a basic validation of the coin metadata fields. Base and
+// Display must be valid coin denominations, the first denomination unit must be
+// the Base denomination with exponent 0, the remaining units must be sorted by
+// strictly increasing exponent, unit names and aliases must be unique and the
+// Display denomination must be one of the units.
+func (m Metadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return fmt.Errorf("invalid metadata base denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(m.Display); err != nil {
+		return fmt.Errorf("invalid metadata display denom: %w", err)
+	}
+	if len(m.DenomUnits) == 0 {
+		return errors.New("metadata must contain at least the base denom unit")
+	}
+
+	var hasDisplay bool
+	seenUnits := make(map[string]bool)
+
+	for i, du := range m.DenomUnits {
+		if i == 0 {
+			if du.Denom != m.Base {
+				return fmt.Errorf("metadata's first denomination unit must be the base denom %s, got %s", m.Base, du.Denom)
+			}
+			if du.Exponent != 0 {
+				return fmt.Errorf("the exponent of the base denomination unit %s must be 0, got %d", du.Denom, du.Exponent)
+			}
+		} else if du.Exponent <= m.DenomUnits[i-1].Exponent {
+			return fmt.Errorf("denom units must be sorted by strictly increasing exponent")
+		}
+
+		if err := du.Validate(); err != nil {
+			return err
+		}
+
+		if du.Denom == m.Display {
+			hasDisplay = true
+		}
+
+		for _, name := range append([]string{du.Denom}, du.Aliases...) {
+			if seenUnits[name] {
+				return fmt.Errorf("duplicate denomination unit or alias %s", name)
+			}
+			seenUnits[name] = true
+		}
+	}
+
+	if !hasDisplay {
+		return fmt.Errorf("metadata must contain a denomination unit with display denom %s", m.Display)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (m Metadata) String() string {
+	var units strings.Builder
+	for _, du := range m.DenomUnits {
+		units.WriteString("\n    " + du.String())
+	}
+
+	return fmt.Sprintf(`Metadata:
+  Description: %s
+  Base:        %s
+  Display:     %s
+  Denom Units:%s`, m.Description, m.Base, m.Display, units.String())
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata Metadata
+		expPass  bool
+	}{
+		{
+			"valid metadata",
+			NewMetadata("The native staking token", "uatom", "atom",
+				[]DenomUnit{NewDenomUnit("uatom", 0, []string{"microatom"}), NewDenomUnit("matom", 3, []string{"milliatom"}), NewDenomUnit("atom", 6, nil)}),
+			true,
+		},
+		{
+			"base denom is display denom",
+			NewMetadata("", "stake", "stake", []DenomUnit{NewDenomUnit("stake", 0, nil)}),
+			true,
+		},
+		{
+			"invalid base denom",
+			NewMetadata("", "UATOM", "atom", []DenomUnit{NewDenomUnit("UATOM", 0, nil), NewDenomUnit("atom", 6, nil)}),
+			false,
+		},
+		{
+			"invalid display denom",
+			NewMetadata("", "uatom", "", []DenomUnit{NewDenomUnit("uatom", 0, nil)}),
+			false,
+		},
+		{
+			"no denom units",
+			NewMetadata("", "uatom", "atom", nil),
+			false,
+		},
+		{
+			"first denom unit is not the base denom",
+			NewMetadata("", "uatom", "atom", []DenomUnit{NewDenomUnit("atom", 0, nil), NewDenomUnit("uatom", 6, nil)}),
+			false,
+		},
+		{
+			"non-zero base exponent",
+			NewMetadata("", "uatom", "atom", []DenomUnit{NewDenomUnit("uatom", 1, nil), NewDenomUnit("atom", 6, nil)}),
+			false,
+		},
+		{
+			"unsorted denom units",
+			NewMetadata("", "uatom", "atom", []DenomUnit{NewDenomUnit("uatom", 0, nil), NewDenomUnit("atom", 6, nil), NewDenomUnit("matom", 3, nil)}),
+			false,
+		},
+		{
+			"duplicate exponent",
+			NewMetadata("", "uatom", "atom", []DenomUnit{NewDenomUnit("uatom", 0, nil), NewDenomUnit("atom", 6, nil), NewDenomUnit("katom", 6, nil)}),
+			false,
+		},
+		{
+			"invalid alias",
+			NewMetadata("", "uatom", "atom", []DenomUnit{NewDenomUnit("uatom", 0, []string{"MICRO"}), NewDenomUnit("atom", 6, nil)}),
+			false,
+		},
+		{
+			"duplicate alias",
+			NewMetadata("", "uatom", "atom", []DenomUnit{NewDenomUnit("uatom", 0, []string{"atom"}), NewDenomUnit("atom", 6, nil)}),
+			false,
+		},
+		{
+			"missing display denom unit",
+			NewMetadata("", "uatom", "atom", []DenomUnit{NewDenomUnit("uatom", 0, nil), NewDenomUnit("matom", 3, nil)}),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		err := tc.metadata.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestValidateGenesisDenomMetadata(t *testing.T) {
+	metadata := NewMetadata("", "uatom", "atom", []DenomUnit{NewDenomUnit("uatom", 0, nil), NewDenomUnit("atom", 6, nil)})
+
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+	require.NoError(t, ValidateGenesis(NewGenesisState(DefaultParams(), []Metadata{metadata})))
+	require.Error(t, ValidateGenesis(NewGenesisState(DefaultParams(), []Metadata{metadata, metadata})))
+	require.Error(t, ValidateGenesis(NewGenesisState(DefaultParams(), []Metadata{{Base: "uatom"}})))
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -13,17 +15,127 @@ const (
 	DefaultSendEnabled = true
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+// Parameter store keys
+var (
+	// ParamStoreKeySendEnabled is store's key for the per-denom SendEnabled list
+	ParamStoreKeySendEnabled = []byte("SendEnabled")
+	// ParamStoreKeyDefaultSendEnabled is store's key for DefaultSendEnabled. It
+	// keeps the key of the former global send enabled flag so that chains
+	// upgraded in place carry the flag over as the default.
+	ParamStoreKeyDefaultSendEnabled = []byte("sendenabled")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// SendEnabled maps a coin denomination to whether it can be transferred.
+type SendEnabled struct {
+	Denom   string `json:"denom" yaml:"denom"`
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+}
+
+// NewSendEnabled creates a new SendEnabled object
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{Denom: denom, Enabled: enabled}
+}
+
+// String implements the Stringer interface.
+func (se SendEnabled) String() string {
+	return fmt.Sprintf("%s: %t", se.Denom, se.Enabled)
+}
+
+// SendEnabledParams is a collection of per-denom SendEnabled flags.
+type SendEnabledParams []SendEnabled
+
+// Params defines the parameters for the bank module.
+type Params struct {
+	// SendEnabled holds the denominations whose transferability differs
+	// from DefaultSendEnabled
+	SendEnabled SendEnabledParams `json:"send_enabled" yaml:"send_enabled"`
+	// DefaultSendEnabled is the transferability of any denomination not
+	// listed in SendEnabled
+	DefaultSendEnabled bool `json:"default_send_enabled" yaml:"default_send_enabled"`
+}
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable(
-		params.NewParamSetPair(ParamStoreKeySendEnabled, false, validateSendEnabled),
-	)
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(defaultSendEnabled bool, sendEnabled SendEnabledParams) Params {
+	return Params{
+		SendEnabled:        sendEnabled,
+		DefaultSendEnabled: defaultSendEnabled,
+	}
+}
+
+// DefaultParams returns the default bank module parameters
+func DefaultParams() Params {
+	return NewParams(DefaultSendEnabled, SendEnabledParams{})
+}
+
+// Validate performs basic validation on the bank parameters.
+func (p Params) Validate() error {
+	if err := validateSendEnabledParams(p.SendEnabled); err != nil {
+		return err
+	}
+
+	return validateDefaultSendEnabled(p.DefaultSendEnabled)
+}
+
+// SendEnabledDenom returns whether the given denomination can be transferred.
+func (p Params) SendEnabledDenom(denom string) bool {
+	for _, se := range p.SendEnabled {
+		if se.Denom == denom {
+			return se.Enabled
+		}
+	}
+
+	return p.DefaultSendEnabled
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	var sendEnabled strings.Builder
+	for _, se := range p.SendEnabled {
+		sendEnabled.WriteString("\n    " + se.String())
+	}
+
+	return fmt.Sprintf(`Bank Params:
+  Default Send Enabled: %t
+  Send Enabled:%s`, p.DefaultSendEnabled, sendEnabled.String())
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of bank module's parameters.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
+		params.NewParamSetPair(ParamStoreKeyDefaultSendEnabled, &p.DefaultSendEnabled, validateDefaultSendEnabled),
+	}
+}
+
+func validateSendEnabledParams(i interface{}) error {
+	sendEnabled, ok := i.(SendEnabledParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, se := range sendEnabled {
+		if err := sdk.ValidateDenom(se.Denom); err != nil {
+			return err
+		}
+		if seen[se.Denom] {
+			return fmt.Errorf("duplicate send enabled parameter for denom %s", se.Denom)
+		}
+		seen[se.Denom] = true
+	}
+
+	return nil
 }
 
-func validateSendEnabled(i interface{}) error {
+func validateDefaultSendEnabled(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
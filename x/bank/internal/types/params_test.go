@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default params", DefaultParams(), true},
+		{"empty send enabled list", NewParams(false, nil), true},
+		{"valid send enabled list", NewParams(true, SendEnabledParams{NewSendEnabled("foo", false), NewSendEnabled("bar", true)}), true},
+		{"invalid denom", NewParams(true, SendEnabledParams{NewSendEnabled("FOO", false)}), false},
+		{"duplicate denom", NewParams(true, SendEnabledParams{NewSendEnabled("foo", false), NewSendEnabled("foo", true)}), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestParamsSendEnabledDenom(t *testing.T) {
+	params := NewParams(true, SendEnabledParams{NewSendEnabled("foo", false)})
+	require.False(t, params.SendEnabledDenom("foo"))
+	require.True(t, params.SendEnabledDenom("bar"))
+
+	params = NewParams(false, SendEnabledParams{NewSendEnabled("foo", true)})
+	require.True(t, params.SendEnabledDenom("foo"))
+	require.False(t, params.SendEnabledDenom("bar"))
+}
@@ -4,6 +4,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// querier keys
+const (
+	QueryAllBalances    = "all_balances"
+	QueryBalanceOf      = "balance_of"
+	QueryDenomMetadata  = "denom_metadata"
+	QueryDenomsMetadata = "denoms_metadata"
+)
+
 // QueryBalanceParams defines the params for querying an account balance.
 type QueryBalanceParams struct {
 	Address sdk.AccAddress
@@ -13,3 +21,45 @@ type QueryBalanceParams struct {
 func NewQueryBalanceParams(addr sdk.AccAddress) QueryBalanceParams {
 	return QueryBalanceParams{Address: addr}
 }
+
+// QueryAllBalancesParams defines the params for querying a page of the
+// balances of an account, sorted by denomination.
+type QueryAllBalancesParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Page    int            `json:"page" yaml:"page"`
+	Limit   int            `json:"limit" yaml:"limit"`
+}
+
+// NewQueryAllBalancesParams creates a new instance of QueryAllBalancesParams.
+func NewQueryAllBalancesParams(addr sdk.AccAddress, page, limit int) QueryAllBalancesParams {
+	return QueryAllBalancesParams{Address: addr, Page: page, Limit: limit}
+}
+
+// QueryBalanceOfParams defines the params for querying the balance of a single
+// denomination held by an account.
+type QueryBalanceOfParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Denom   string         `json:"denom" yaml:"denom"`
+}
+
+// NewQueryBalanceOfParams creates a new instance of QueryBalanceOfParams.
+func NewQueryBalanceOfParams(addr sdk.AccAddress, denom string) QueryBalanceOfParams {
+	return QueryBalanceOfParams{Address: addr, Denom: denom}
+}
+
+// QueryDenomMetadataParams defines the params for querying the metadata of a
+// denomination.
+type QueryDenomMetadataParams struct {
+	Denom string
+}
+
+// NewQueryDenomMetadataParams creates a new instance of QueryDenomMetadataParams.
+func NewQueryDenomMetadataParams(denom string) QueryDenomMetadataParams {
+	return QueryDenomMetadataParams{Denom: denom}
+}
+
+// RegisterQueryService registers the bank gRPC query service with the given
+// server.
+func RegisterQueryService(server sdk.GRPCServer, srv QueryServer) {
+	server.RegisterService(&_Query_serviceDesc, srv)
+}
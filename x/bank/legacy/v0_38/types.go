@@ -0,0 +1,13 @@
+// DONTCOVER
+// nolint
+package v038
+
+const (
+	ModuleName = "bank"
+)
+
+type (
+	GenesisState struct {
+		SendEnabled bool `json:"send_enabled" yaml:"send_enabled"`
+	}
+)
@@ -0,0 +1,19 @@
+package v039
+
+import (
+	v038bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_38"
+)
+
+// Migrate accepts exported genesis state from v0.38 and migrates it to v0.39
+// genesis state. The global SendEnabled flag becomes the default for all
+// denominations, none of which are configured individually, and no denomination
+// metadata is registered.
+func Migrate(oldGenState v038bank.GenesisState) GenesisState {
+	return GenesisState{
+		Params: Params{
+			SendEnabled:        []SendEnabled{},
+			DefaultSendEnabled: oldGenState.SendEnabled,
+		},
+		DenomMetadata: []Metadata{},
+	}
+}
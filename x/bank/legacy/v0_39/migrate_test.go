@@ -0,0 +1,24 @@
+package v039
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	v038bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_38"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := codec.New()
+
+	for _, sendEnabled := range []bool{true, false} {
+		migrated := Migrate(v038bank.GenesisState{SendEnabled: sendEnabled})
+		require.Equal(t, sendEnabled, migrated.Params.DefaultSendEnabled)
+		require.Empty(t, migrated.Params.SendEnabled)
+		require.Empty(t, migrated.DenomMetadata)
+	}
+
+	expected := `{"params":{"send_enabled":[],"default_send_enabled":false},"denom_metadata":[]}`
+	require.Equal(t, expected, string(cdc.MustMarshalJSON(Migrate(v038bank.GenesisState{}))))
+}
@@ -0,0 +1,37 @@
+// DONTCOVER
+// nolint
+package v039
+
+const (
+	ModuleName = "bank"
+)
+
+type (
+	SendEnabled struct {
+		Denom   string `json:"denom" yaml:"denom"`
+		Enabled bool   `json:"enabled" yaml:"enabled"`
+	}
+
+	Params struct {
+		SendEnabled        []SendEnabled `json:"send_enabled" yaml:"send_enabled"`
+		DefaultSendEnabled bool          `json:"default_send_enabled" yaml:"default_send_enabled"`
+	}
+
+	DenomUnit struct {
+		Denom    string   `json:"denom" yaml:"denom"`
+		Exponent uint32   `json:"exponent" yaml:"exponent"`
+		Aliases  []string `json:"aliases" yaml:"aliases"`
+	}
+
+	Metadata struct {
+		Description string      `json:"description" yaml:"description"`
+		DenomUnits  []DenomUnit `json:"denom_units" yaml:"denom_units"`
+		Base        string      `json:"base" yaml:"base"`
+		Display     string      `json:"display" yaml:"display"`
+	}
+
+	GenesisState struct {
+		Params        Params     `json:"params" yaml:"params"`
+		DenomMetadata []Metadata `json:"denom_metadata" yaml:"denom_metadata"`
+	}
+)
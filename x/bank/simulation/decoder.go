@@ -0,0 +1,24 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding bank type
+func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.DenomMetadataPrefix):
+		var metadataA, metadataB types.Metadata
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &metadataA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &metadataB)
+		return fmt.Sprintf("%v\n%v", metadataA, metadataB)
+	default:
+		panic(fmt.Sprintf("invalid bank key %X", kvA.Key))
+	}
+}
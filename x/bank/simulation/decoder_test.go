@@ -0,0 +1,45 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.New()
+	metadata := types.NewMetadata(
+		"The native staking token", "ustake", "stake",
+		[]types.DenomUnit{types.NewDenomUnit("ustake", 0, nil), types.NewDenomUnit("stake", 6, nil)},
+	)
+
+	kvPairs := cmn.KVPairs{
+		cmn.KVPair{Key: types.DenomMetadataKey(metadata.Base), Value: cdc.MustMarshalBinaryLengthPrefixed(metadata)},
+		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Metadata", fmt.Sprintf("%v\n%v", metadata, metadata)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { DecodeStore(cdc, kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -7,29 +7,48 @@ import (
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 )
 
 // Simulation parameter constants
 const (
-	SendEnabled = "send_enabled"
+	SendEnabled        = "send_enabled"
+	DefaultSendEnabled = "default_send_enabled"
 )
 
-// GenSendEnabled randomized SendEnabled
-func GenSendEnabled(r *rand.Rand) bool {
+// GenDefaultSendEnabled randomized DefaultSendEnabled
+func GenDefaultSendEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of transfers being enabled
 }
 
+// GenSendEnabled randomized SendEnabled. Half of the time, it adds a flag for
+// the bond denom which is enabled 95% of the time.
+func GenSendEnabled(r *rand.Rand) types.SendEnabledParams {
+	sendEnabled := types.SendEnabledParams{}
+	if r.Int63n(101) <= 50 {
+		sendEnabled = append(sendEnabled, types.NewSendEnabled(sdk.DefaultBondDenom, r.Int63n(101) <= 95))
+	}
+
+	return sendEnabled
+}
+
 // RandomizedGenState generates a random GenesisState for bank
 func RandomizedGenState(simState *module.SimulationState) {
-	var sendEnabled bool
+	var defaultSendEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DefaultSendEnabled, &defaultSendEnabled, simState.Rand,
+		func(r *rand.Rand) { defaultSendEnabled = GenDefaultSendEnabled(r) },
+	)
+
+	var sendEnabled types.SendEnabledParams
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, SendEnabled, &sendEnabled, simState.Rand,
 		func(r *rand.Rand) { sendEnabled = GenSendEnabled(r) },
 	)
 
-	bankGenesis := types.NewGenesisState(sendEnabled)
+	bankGenesis := types.NewGenesisState(types.NewParams(defaultSendEnabled, sendEnabled), []types.Metadata{})
 
 	fmt.Printf("Selected randomly generated bank parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, bankGenesis))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(bankGenesis)
@@ -60,10 +60,6 @@ func SimulateMsgSend(ak types.AccountKeeper, bk keeper.Keeper) simulation.Operat
 		accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
 
-		if !bk.GetSendEnabled(ctx) {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
-		}
-
 		simAccount, toSimAcc, coins, skip, err := randomSendFields(r, ctx, accs, ak)
 		if err != nil {
 			return simulation.NoOpMsg(types.ModuleName), nil, err
@@ -73,6 +69,11 @@ func SimulateMsgSend(ak types.AccountKeeper, bk keeper.Keeper) simulation.Operat
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
+		// skip coins which cannot be transferred
+		if err := bk.SendEnabledCoins(ctx, coins...); err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
 		msg := types.NewMsgSend(simAccount.Address, toSimAcc.Address, coins)
 
 		err = sendMsgSend(r, app, ak, msg, ctx, chainID, []crypto.PrivKey{simAccount.PrivKey})
@@ -131,10 +132,6 @@ func SimulateMsgMultiSend(ak types.AccountKeeper, bk keeper.Keeper) simulation.O
 		accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
 
-		if !bk.GetSendEnabled(ctx) {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
-		}
-
 		// random number of inputs/outputs between [1, 3]
 		inputs := make([]types.Input, r.Intn(3)+1)
 		outputs := make([]types.Output, r.Intn(3)+1)
@@ -162,6 +159,11 @@ func SimulateMsgMultiSend(ak types.AccountKeeper, bk keeper.Keeper) simulation.O
 				return simulation.NoOpMsg(types.ModuleName), nil, nil
 			}
 
+			// skip coins which cannot be transferred
+			if err := bk.SendEnabledCoins(ctx, coins...); err != nil {
+				return simulation.NoOpMsg(types.ModuleName), nil, nil
+			}
+
 			// set input address in used address map
 			usedAddrs[simAccount.Address.String()] = true
 
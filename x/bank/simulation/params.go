@@ -10,7 +10,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
-const keySendEnabled = "sendenabled"
+const (
+	keySendEnabled        = "SendEnabled"
+	keyDefaultSendEnabled = "sendenabled"
+)
 
 // ParamChanges defines the parameters that can be modified by param change proposals
 // on the simulation
@@ -18,7 +21,12 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 	return []simulation.ParamChange{
 		simulation.NewSimParamChange(types.ModuleName, keySendEnabled,
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("%v", GenSendEnabled(r))
+				return string(types.ModuleCdc.MustMarshalJSON(GenSendEnabled(r)))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyDefaultSendEnabled,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%v", GenDefaultSendEnabled(r))
 			},
 		),
 	}
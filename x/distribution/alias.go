@@ -101,6 +101,9 @@ var (
 	NewValidatorCurrentRewards                 = types.NewValidatorCurrentRewards
 	InitialValidatorAccumulatedCommission      = types.InitialValidatorAccumulatedCommission
 	NewValidatorSlashEvent                     = types.NewValidatorSlashEvent
+	NewQueryServer                             = keeper.NewQueryServer
+	RegisterQueryService                       = types.RegisterQueryService
+	NewQueryClient                             = types.NewQueryClient
 
 	// variable aliases
 	FeePoolKey                           = keeper.FeePoolKey
@@ -131,35 +134,47 @@ var (
 )
 
 type (
-	Hooks                                  = keeper.Hooks
-	Keeper                                 = keeper.Keeper
-	DelegatorStartingInfo                  = types.DelegatorStartingInfo
-	CodeType                               = types.CodeType
-	FeePool                                = types.FeePool
-	DelegatorWithdrawInfo                  = types.DelegatorWithdrawInfo
-	ValidatorOutstandingRewardsRecord      = types.ValidatorOutstandingRewardsRecord
-	ValidatorAccumulatedCommissionRecord   = types.ValidatorAccumulatedCommissionRecord
-	ValidatorHistoricalRewardsRecord       = types.ValidatorHistoricalRewardsRecord
-	ValidatorCurrentRewardsRecord          = types.ValidatorCurrentRewardsRecord
-	DelegatorStartingInfoRecord            = types.DelegatorStartingInfoRecord
-	ValidatorSlashEventRecord              = types.ValidatorSlashEventRecord
-	GenesisState                           = types.GenesisState
-	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
-	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
-	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
-	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
-	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
-	QueryValidatorCommissionParams         = types.QueryValidatorCommissionParams
-	QueryValidatorSlashesParams            = types.QueryValidatorSlashesParams
-	QueryDelegationRewardsParams           = types.QueryDelegationRewardsParams
-	QueryDelegatorParams                   = types.QueryDelegatorParams
-	QueryDelegatorWithdrawAddrParams       = types.QueryDelegatorWithdrawAddrParams
-	QueryDelegatorTotalRewardsResponse     = types.QueryDelegatorTotalRewardsResponse
-	DelegationDelegatorReward              = types.DelegationDelegatorReward
-	ValidatorHistoricalRewards             = types.ValidatorHistoricalRewards
-	ValidatorCurrentRewards                = types.ValidatorCurrentRewards
-	ValidatorAccumulatedCommission         = types.ValidatorAccumulatedCommission
-	ValidatorSlashEvent                    = types.ValidatorSlashEvent
-	ValidatorSlashEvents                   = types.ValidatorSlashEvents
-	ValidatorOutstandingRewards            = types.ValidatorOutstandingRewards
+	Hooks                                    = keeper.Hooks
+	Keeper                                   = keeper.Keeper
+	DelegatorStartingInfo                    = types.DelegatorStartingInfo
+	CodeType                                 = types.CodeType
+	FeePool                                  = types.FeePool
+	DelegatorWithdrawInfo                    = types.DelegatorWithdrawInfo
+	ValidatorOutstandingRewardsRecord        = types.ValidatorOutstandingRewardsRecord
+	ValidatorAccumulatedCommissionRecord     = types.ValidatorAccumulatedCommissionRecord
+	ValidatorHistoricalRewardsRecord         = types.ValidatorHistoricalRewardsRecord
+	ValidatorCurrentRewardsRecord            = types.ValidatorCurrentRewardsRecord
+	DelegatorStartingInfoRecord              = types.DelegatorStartingInfoRecord
+	ValidatorSlashEventRecord                = types.ValidatorSlashEventRecord
+	GenesisState                             = types.GenesisState
+	MsgSetWithdrawAddress                    = types.MsgSetWithdrawAddress
+	MsgWithdrawDelegatorReward               = types.MsgWithdrawDelegatorReward
+	MsgWithdrawValidatorCommission           = types.MsgWithdrawValidatorCommission
+	CommunityPoolSpendProposal               = types.CommunityPoolSpendProposal
+	QueryValidatorOutstandingRewardsParams   = types.QueryValidatorOutstandingRewardsParams
+	QueryValidatorCommissionParams           = types.QueryValidatorCommissionParams
+	QueryValidatorSlashesParams              = types.QueryValidatorSlashesParams
+	QueryDelegationRewardsParams             = types.QueryDelegationRewardsParams
+	QueryDelegatorParams                     = types.QueryDelegatorParams
+	QueryDelegatorWithdrawAddrParams         = types.QueryDelegatorWithdrawAddrParams
+	QueryDelegatorTotalRewardsResponse       = types.QueryDelegatorTotalRewardsResponse
+	DelegationDelegatorReward                = types.DelegationDelegatorReward
+	ValidatorHistoricalRewards               = types.ValidatorHistoricalRewards
+	ValidatorCurrentRewards                  = types.ValidatorCurrentRewards
+	ValidatorAccumulatedCommission           = types.ValidatorAccumulatedCommission
+	ValidatorSlashEvent                      = types.ValidatorSlashEvent
+	ValidatorSlashEvents                     = types.ValidatorSlashEvents
+	ValidatorOutstandingRewards              = types.ValidatorOutstandingRewards
+	QueryServer                              = types.QueryServer
+	QueryClient                              = types.QueryClient
+	QueryValidatorOutstandingRewardsRequest  = types.QueryValidatorOutstandingRewardsRequest
+	QueryValidatorOutstandingRewardsResponse = types.QueryValidatorOutstandingRewardsResponse
+	QueryValidatorCommissionRequest          = types.QueryValidatorCommissionRequest
+	QueryValidatorCommissionResponse         = types.QueryValidatorCommissionResponse
+	QueryDelegationRewardsRequest            = types.QueryDelegationRewardsRequest
+	QueryDelegationRewardsResponse           = types.QueryDelegationRewardsResponse
+	QueryDelegatorWithdrawAddressRequest     = types.QueryDelegatorWithdrawAddressRequest
+	QueryDelegatorWithdrawAddressResponse    = types.QueryDelegatorWithdrawAddressResponse
+	QueryCommunityPoolRequest                = types.QueryCommunityPoolRequest
+	QueryCommunityPoolResponse               = types.QueryCommunityPoolResponse
 )
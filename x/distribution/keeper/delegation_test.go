@@ -260,6 +260,67 @@ func TestCalculateRewardsMultiDelegator(t *testing.T) {
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(initial)}}, k.GetValidatorAccumulatedCommission(ctx, valOpAddr1))
 }
 
+func TestTokenizeSharePoolRewards(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// delegate and tokenize the whole delegation
+	delTokens := sdk.NewCoin(sdk.DefaultBondDenom, valTokens)
+	require.True(t, sh(ctx, staking.NewMsgDelegate(delAddr1, valOpAddr1, delTokens)).IsOK())
+	require.True(t, sh(ctx, staking.NewMsgTokenizeShares(delAddr1, valOpAddr1, delTokens)).IsOK())
+
+	shareDenom, found := sk.GetTokenizeShareDenom(ctx, valOpAddr1)
+	require.True(t, found)
+
+	// allocate some rewards, a quarter of which accrue to the pool delegation
+	initial := sdk.TokensFromConsensusPower(10)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// redeeming half the share tokens re-stakes the rewards of the pool
+	// delegation, half of which are redeemed along with the share tokens
+	redeemed := sdk.NewCoin(shareDenom, valTokens.QuoRaw(2))
+	require.True(t, sh(ctx, staking.NewMsgRedeemTokensForShares(delAddr1, redeemed)).IsOK())
+
+	poolAddr := k.supplyKeeper.GetModuleAddress(staking.TokenizeSharePoolName)
+	require.True(t, ak.GetAccount(ctx, poolAddr).GetCoins().IsZero())
+
+	poolRewards := initial.QuoRaw(4)
+	del := sk.Delegation(ctx, delAddr1, valOpAddr1)
+	require.Equal(t, valTokens.Add(poolRewards).QuoRaw(2).ToDec(), del.GetShares())
+
+	poolDel := sk.Delegation(ctx, poolAddr, valOpAddr1)
+	require.Equal(t, valTokens.Add(poolRewards).QuoRaw(2).ToDec(), poolDel.GetShares())
+
+	invariantMsg, broken := staking.TokenizeSharesInvariant(sk)(ctx)
+	require.False(t, broken, invariantMsg)
+}
+
 func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	balancePower := int64(1000)
 	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// queryServer implements the distribution gRPC query service on top of a
+// Keeper.
+type queryServer struct {
+	keeper Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the distribution gRPC query
+// service backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{keeper: k}
+}
+
+// ValidatorOutstandingRewards implements the Query/ValidatorOutstandingRewards
+// gRPC method.
+func (q queryServer) ValidatorOutstandingRewards(goCtx context.Context, req *types.QueryValidatorOutstandingRewardsRequest) (*types.QueryValidatorOutstandingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ValidatorAddress.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if q.keeper.stakingKeeper.Validator(ctx, req.ValidatorAddress) == nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s does not exist", req.ValidatorAddress)
+	}
+
+	rewards := q.keeper.GetValidatorOutstandingRewards(ctx, req.ValidatorAddress)
+
+	return &types.QueryValidatorOutstandingRewardsResponse{Rewards: rewards}, nil
+}
+
+// ValidatorCommission implements the Query/ValidatorCommission gRPC method.
+func (q queryServer) ValidatorCommission(goCtx context.Context, req *types.QueryValidatorCommissionRequest) (*types.QueryValidatorCommissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ValidatorAddress.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	commission := q.keeper.GetValidatorAccumulatedCommission(ctx, req.ValidatorAddress)
+
+	return &types.QueryValidatorCommissionResponse{Commission: commission}, nil
+}
+
+// DelegationRewards implements the Query/DelegationRewards gRPC method.
+func (q queryServer) DelegationRewards(goCtx context.Context, req *types.QueryDelegationRewardsRequest) (*types.QueryDelegationRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.DelegatorAddress.Empty() || req.ValidatorAddress.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "delegator and validator addresses cannot be empty")
+	}
+
+	// cache-wrap context as to not persist state changes during querying
+	ctx, _ := sdk.UnwrapSDKContext(goCtx).CacheContext()
+
+	val := q.keeper.stakingKeeper.Validator(ctx, req.ValidatorAddress)
+	if val == nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s does not exist", req.ValidatorAddress)
+	}
+
+	del := q.keeper.stakingKeeper.Delegation(ctx, req.DelegatorAddress, req.ValidatorAddress)
+	if del == nil {
+		return nil, status.Errorf(
+			codes.NotFound, "delegation with delegator %s does not exist for validator %s", req.DelegatorAddress, req.ValidatorAddress,
+		)
+	}
+
+	endingPeriod := q.keeper.incrementValidatorPeriod(ctx, val)
+	rewards := q.keeper.calculateDelegationRewards(ctx, val, del, endingPeriod)
+
+	return &types.QueryDelegationRewardsResponse{Rewards: rewards}, nil
+}
+
+// DelegatorWithdrawAddress implements the Query/DelegatorWithdrawAddress gRPC
+// method.
+func (q queryServer) DelegatorWithdrawAddress(goCtx context.Context, req *types.QueryDelegatorWithdrawAddressRequest) (*types.QueryDelegatorWithdrawAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.DelegatorAddress.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	withdrawAddr := q.keeper.GetDelegatorWithdrawAddr(ctx, req.DelegatorAddress)
+
+	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr}, nil
+}
+
+// CommunityPool implements the Query/CommunityPool gRPC method.
+func (q queryServer) CommunityPool(goCtx context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryCommunityPoolResponse{Pool: q.keeper.GetFeePoolCommunityCoins(ctx)}, nil
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestGRPCQueryValidatorOutstandingRewards(t *testing.T) {
+	ctx, _, keeper, sk, _ := CreateTestInputDefault(t, false, 1000)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := NewQueryServer(keeper)
+
+	sh := staking.NewHandler(sk)
+	comm := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, comm, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+
+	outstandingRewards := sdk.DecCoins{{Denom: "mytoken", Amount: sdk.NewDec(3)}, {Denom: "myothertoken", Amount: sdk.NewDecWithPrec(3, 7)}}
+	keeper.SetValidatorOutstandingRewards(ctx, valOpAddr1, outstandingRewards)
+
+	testCases := []struct {
+		msg        string
+		req        *types.QueryValidatorOutstandingRewardsRequest
+		expCode    codes.Code
+		expRewards sdk.DecCoins
+	}{
+		{"nil request", nil, codes.InvalidArgument, nil},
+		{"empty address", &types.QueryValidatorOutstandingRewardsRequest{}, codes.InvalidArgument, nil},
+		{"unknown validator", &types.QueryValidatorOutstandingRewardsRequest{ValidatorAddress: valOpAddr2}, codes.NotFound, nil},
+		{"existing rewards", &types.QueryValidatorOutstandingRewardsRequest{ValidatorAddress: valOpAddr1}, codes.OK, outstandingRewards},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.ValidatorOutstandingRewards(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Equal(t, tc.expRewards, sdk.DecCoins(res.Rewards), tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryValidatorCommission(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInputDefault(t, false, 100)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := NewQueryServer(keeper)
+
+	commission := sdk.DecCoins{{Denom: "token1", Amount: sdk.NewDec(4)}, {Denom: "token2", Amount: sdk.NewDec(2)}}
+	keeper.SetValidatorAccumulatedCommission(ctx, valOpAddr1, commission)
+
+	testCases := []struct {
+		msg           string
+		req           *types.QueryValidatorCommissionRequest
+		expCode       codes.Code
+		expCommission sdk.DecCoins
+	}{
+		{"nil request", nil, codes.InvalidArgument, nil},
+		{"empty address", &types.QueryValidatorCommissionRequest{}, codes.InvalidArgument, nil},
+		{"no commission", &types.QueryValidatorCommissionRequest{ValidatorAddress: valOpAddr2}, codes.OK, sdk.DecCoins{}},
+		{"existing commission", &types.QueryValidatorCommissionRequest{ValidatorAddress: valOpAddr1}, codes.OK, commission},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.ValidatorCommission(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Equal(t, tc.expCommission, sdk.DecCoins(res.Commission), tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryDelegationRewards(t *testing.T) {
+	ctx, _, keeper, sk, _ := CreateTestInputDefault(t, false, 1000)
+	queryServer := NewQueryServer(keeper)
+
+	// create validator with 50% commission
+	sh := staking.NewHandler(sk)
+	comm := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, comm, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// next block, allocate some rewards
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	val := sk.Validator(ctx, valOpAddr1)
+	keeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(10)}})
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryDelegationRewardsRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"empty delegator", &types.QueryDelegationRewardsRequest{ValidatorAddress: valOpAddr1}, codes.InvalidArgument},
+		{"empty validator", &types.QueryDelegationRewardsRequest{DelegatorAddress: valAccAddr1}, codes.InvalidArgument},
+		{"unknown validator", &types.QueryDelegationRewardsRequest{DelegatorAddress: valAccAddr1, ValidatorAddress: valOpAddr2}, codes.NotFound},
+		{"unknown delegation", &types.QueryDelegationRewardsRequest{DelegatorAddress: delAddr1, ValidatorAddress: valOpAddr1}, codes.NotFound},
+		{"existing delegation", &types.QueryDelegationRewardsRequest{DelegatorAddress: valAccAddr1, ValidatorAddress: valOpAddr1}, codes.OK},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.DelegationRewards(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(5)}}, sdk.DecCoins(res.Rewards), tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryDelegatorWithdrawAddress(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInputDefault(t, false, 100)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := NewQueryServer(keeper)
+
+	keeper.SetDelegatorWithdrawAddr(ctx, delAddr1, delAddr2)
+
+	testCases := []struct {
+		msg             string
+		req             *types.QueryDelegatorWithdrawAddressRequest
+		expCode         codes.Code
+		expWithdrawAddr sdk.AccAddress
+	}{
+		{"nil request", nil, codes.InvalidArgument, nil},
+		{"empty address", &types.QueryDelegatorWithdrawAddressRequest{}, codes.InvalidArgument, nil},
+		{"default withdraw address", &types.QueryDelegatorWithdrawAddressRequest{DelegatorAddress: delAddr3}, codes.OK, delAddr3},
+		{"custom withdraw address", &types.QueryDelegatorWithdrawAddressRequest{DelegatorAddress: delAddr1}, codes.OK, delAddr2},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.DelegatorWithdrawAddress(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Equal(t, tc.expWithdrawAddr, res.WithdrawAddress, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryCommunityPool(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInputDefault(t, false, 100)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := NewQueryServer(keeper)
+
+	_, err := queryServer.CommunityPool(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := queryServer.CommunityPool(goCtx, &types.QueryCommunityPoolRequest{})
+	require.NoError(t, err)
+	require.True(t, sdk.DecCoins(res.Pool).IsZero())
+
+	communityPool := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(7)}}
+	feePool := keeper.GetFeePool(ctx)
+	feePool.CommunityPool = communityPool
+	keeper.SetFeePool(ctx, feePool)
+
+	res, err = queryServer.CommunityPool(goCtx, &types.QueryCommunityPoolRequest{})
+	require.NoError(t, err)
+	require.Equal(t, communityPool, sdk.DecCoins(res.Pool))
+}
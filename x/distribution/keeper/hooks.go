@@ -100,8 +100,14 @@ func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, f
 	h.k.updateValidatorSlashFraction(ctx, valAddr, fraction)
 }
 
+// move the validator's distribution records to its new operator address
+func (h Hooks) AfterValidatorOperatorChanged(ctx sdk.Context, oldValAddr, newValAddr sdk.ValAddress) {
+	h.k.changeValidatorOperator(ctx, oldValAddr, newValAddr)
+}
+
 // nolint - unused hooks
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                         {}
 func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)         {}
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)       {}
+func (h Hooks) AfterConsPubKeyRotated(_ sdk.Context, _ sdk.ValAddress, _, _ sdk.ConsAddress)    {}
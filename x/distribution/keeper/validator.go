@@ -105,3 +105,48 @@ func (k Keeper) updateValidatorSlashFraction(ctx sdk.Context, valAddr sdk.ValAdd
 
 	k.SetValidatorSlashEvent(ctx, valAddr, height, newPeriod, slashEvent)
 }
+
+// move all distribution records of a validator to its new operator address,
+// including the starting info of its self-delegation which moves to the new
+// operator account
+func (k Keeper) changeValidatorOperator(ctx sdk.Context, oldValAddr, newValAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, prefix := range [][]byte{
+		ValidatorOutstandingRewardsPrefix,
+		DelegatorStartingInfoPrefix,
+		ValidatorHistoricalRewardsPrefix,
+		ValidatorCurrentRewardsPrefix,
+		ValidatorAccumulatedCommissionPrefix,
+		ValidatorSlashEventPrefix,
+	} {
+		moveValidatorRecords(store, prefix, oldValAddr, newValAddr)
+	}
+
+	oldDelAddr, newDelAddr := sdk.AccAddress(oldValAddr), sdk.AccAddress(newValAddr)
+	if k.HasDelegatorStartingInfo(ctx, newValAddr, oldDelAddr) {
+		info := k.GetDelegatorStartingInfo(ctx, newValAddr, oldDelAddr)
+		k.DeleteDelegatorStartingInfo(ctx, newValAddr, oldDelAddr)
+		k.SetDelegatorStartingInfo(ctx, newValAddr, newDelAddr, info)
+	}
+}
+
+// move the records stored under a prefix and the old validator address to the
+// same keys under the new validator address
+func moveValidatorRecords(store sdk.KVStore, prefix []byte, oldValAddr, newValAddr sdk.ValAddress) {
+	oldPrefix := append(append([]byte{}, prefix...), oldValAddr.Bytes()...)
+	newPrefix := append(append([]byte{}, prefix...), newValAddr.Bytes()...)
+
+	var keys, values [][]byte
+	iter := sdk.KVStorePrefixIterator(store, oldPrefix)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		values = append(values, iter.Value())
+	}
+	iter.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+		store.Set(append(append([]byte{}, newPrefix...), key[len(oldPrefix):]...), values[i])
+	}
+}
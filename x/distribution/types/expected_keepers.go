@@ -60,6 +60,7 @@ type StakingHooks interface {
 	BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) // Must be called when a delegation's shares are modified
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec)
+	AfterValidatorOperatorChanged(ctx sdk.Context, oldValAddr, newValAddr sdk.ValAddress)
 }
 
 // SupplyKeeper defines the expected supply Keeper (noalias)
@@ -44,14 +44,23 @@ var (
 	KeyMaxEvidenceAge            = types.KeyMaxEvidenceAge
 	DoubleSignJailEndTime        = types.DoubleSignJailEndTime
 	ParamKeyTable                = types.ParamKeyTable
+	NewQueryServer               = keeper.NewQueryServer
+	RegisterQueryService         = types.RegisterQueryService
+	NewQueryClient               = types.NewQueryClient
 )
 
 type (
 	Keeper = keeper.Keeper
 
-	GenesisState      = types.GenesisState
-	MsgSubmitEvidence = types.MsgSubmitEvidence
-	Handler           = types.Handler
-	Router            = types.Router
-	Equivocation      = types.Equivocation
+	GenesisState             = types.GenesisState
+	MsgSubmitEvidence        = types.MsgSubmitEvidence
+	Handler                  = types.Handler
+	Router                   = types.Router
+	Equivocation             = types.Equivocation
+	QueryServer              = types.QueryServer
+	QueryClient              = types.QueryClient
+	QueryEvidenceRequest     = types.QueryEvidenceRequest
+	QueryEvidenceResponse    = types.QueryEvidenceResponse
+	QueryAllEvidenceRequest  = types.QueryAllEvidenceRequest
+	QueryAllEvidenceResponse = types.QueryAllEvidenceResponse
 )
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+// queryServer implements the evidence gRPC query service on top of a Keeper.
+type queryServer struct {
+	keeper Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the evidence gRPC query service
+// backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{keeper: k}
+}
+
+// Evidence implements the Query/Evidence gRPC method.
+func (q queryServer) Evidence(goCtx context.Context, req *types.QueryEvidenceRequest) (*types.QueryEvidenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.EvidenceHash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "evidence hash cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	evidence, found := q.keeper.GetEvidence(ctx, req.EvidenceHash)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "evidence %s not found", req.EvidenceHash)
+	}
+
+	any, err := packEvidence(evidence)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEvidenceResponse{Evidence: any}, nil
+}
+
+// AllEvidence implements the Query/AllEvidence gRPC method.
+func (q queryServer) AllEvidence(goCtx context.Context, req *types.QueryAllEvidenceRequest) (*types.QueryAllEvidenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	evidence := q.keeper.GetAllEvidence(ctx)
+
+	anys := make([]*gogotypes.Any, len(evidence))
+	for i, e := range evidence {
+		any, err := packEvidence(e)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		anys[i] = any
+	}
+
+	return &types.QueryAllEvidenceResponse{Evidence: anys}, nil
+}
+
+// packEvidence packs evidence into an Any. Evidence types are registered with
+// Amino as values while their protobuf methods are defined on pointers, so
+// value evidence is addressed before packing.
+func packEvidence(evidence exported.Evidence) (*gogotypes.Any, error) {
+	v := reflect.ValueOf(evidence)
+	if v.Kind() != reflect.Ptr {
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		v = ptr
+	}
+
+	msg, ok := v.Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("evidence %T is not a protobuf message", evidence)
+	}
+
+	return codec.NewAnyWithValue(msg)
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+// setEquivocations stores numEvidence equivocations of distinct heights.
+func (suite *KeeperTestSuite) setEquivocations(ctx sdk.Context, numEvidence int) []types.Equivocation {
+	evidence := make([]types.Equivocation, numEvidence)
+
+	for i := 0; i < numEvidence; i++ {
+		evidence[i] = types.Equivocation{
+			Height:           int64(i + 1),
+			Time:             time.Unix(int64(i), 0).UTC(),
+			Power:            100,
+			ConsensusAddress: sdk.ConsAddress(valAddresses[0]),
+		}
+		suite.keeper.SetEvidence(ctx, evidence[i])
+	}
+
+	return evidence
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryEvidence() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keeper.NewQueryServer(suite.keeper)
+
+	evidence := suite.setEquivocations(ctx, 2)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryEvidenceRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"empty hash", &types.QueryEvidenceRequest{}, codes.InvalidArgument},
+		{"unknown evidence", &types.QueryEvidenceRequest{EvidenceHash: make([]byte, 32)}, codes.NotFound},
+		{"existing evidence", &types.QueryEvidenceRequest{EvidenceHash: evidence[0].Hash()}, codes.OK},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.Evidence(goCtx, tc.req)
+		suite.Equal(tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode != codes.OK {
+			continue
+		}
+
+		msg, err := codec.UnpackAny(res.Evidence)
+		suite.NoError(err, tc.msg)
+		suite.Equal(&evidence[0], msg, tc.msg)
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryAllEvidence() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keeper.NewQueryServer(suite.keeper)
+
+	_, err := queryServer.AllEvidence(goCtx, nil)
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	res, err := queryServer.AllEvidence(goCtx, &types.QueryAllEvidenceRequest{})
+	suite.NoError(err)
+	suite.Empty(res.Evidence)
+
+	suite.setEquivocations(ctx, 3)
+
+	res, err = queryServer.AllEvidence(goCtx, &types.QueryAllEvidenceRequest{})
+	suite.NoError(err)
+	suite.Len(res.Evidence, 3)
+}
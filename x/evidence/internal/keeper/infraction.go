@@ -62,6 +62,10 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation) {
 		return
 	}
 
+	// the evidence may have been signed with a consensus pubkey the validator
+	// has since rotated away from, the signing info is kept for the current one
+	consAddr = validator.GetConsAddr()
+
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
 		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
 	}
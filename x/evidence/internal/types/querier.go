@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Querier routes for the evidence module
 const (
 	QueryParameters  = "parameters"
@@ -25,3 +29,9 @@ type QueryAllEvidenceParams struct {
 func NewQueryAllEvidenceParams(page, limit int) QueryAllEvidenceParams {
 	return QueryAllEvidenceParams{Page: page, Limit: limit}
 }
+
+// RegisterQueryService registers the evidence gRPC query service with the
+// given server.
+func RegisterQueryService(server sdk.GRPCServer, srv QueryServer) {
+	server.RegisterService(&_Query_serviceDesc, srv)
+}
@@ -0,0 +1,435 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: x/evidence/internal/types/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Equivocation implements the Evidence interface and defines evidence of double
+// signing misbehavior.
+type Equivocation struct {
+	Height           int64                                          `protobuf:"varint,1,opt,name=height,proto3" json:"height" yaml:"height"`
+	Time             time.Time                                      `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	Power            int64                                          `protobuf:"varint,3,opt,name=power,proto3" json:"power" yaml:"power"`
+	ConsensusAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,4,opt,name=consensus_address,json=consensusAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"consensus_address" yaml:"consensus_address"`
+}
+
+func (m *Equivocation) Reset()      { *m = Equivocation{} }
+func (*Equivocation) ProtoMessage() {}
+func (*Equivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8104c3dab679bfb4, []int{0}
+}
+func (m *Equivocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Equivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Equivocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Equivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Equivocation.Merge(m, src)
+}
+func (m *Equivocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Equivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Equivocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Equivocation proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Equivocation)(nil), "cosmos_sdk.x.evidence.v1.Equivocation")
+}
+
+func init() {
+	proto.RegisterFile("x/evidence/internal/types/types.proto", fileDescriptor_8104c3dab679bfb4)
+}
+
+var fileDescriptor_8104c3dab679bfb4 = []byte{
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x3f, 0x4f, 0xf2, 0x40,
+	0x1c, 0xee, 0x01, 0x2f, 0x43, 0xe1, 0x4d, 0xde, 0xb7, 0x71, 0xa8, 0x98, 0xdc, 0x91, 0x26, 0x26,
+	0x2c, 0xde, 0x45, 0x49, 0x1c, 0xdc, 0xac, 0x71, 0x36, 0x69, 0x1c, 0x8c, 0x0b, 0x29, 0xed, 0x59,
+	0x1a, 0x68, 0xaf, 0xf6, 0xae, 0x08, 0xdf, 0x82, 0x8f, 0xc5, 0xc8, 0xc8, 0x74, 0x0a, 0x6c, 0x1d,
+	0x3b, 0x3a, 0x19, 0x7a, 0xc5, 0x85, 0xe8, 0xd2, 0xf6, 0xf9, 0xf3, 0x7b, 0x9e, 0xe6, 0x77, 0xa7,
+	0x9f, 0xcf, 0x08, 0x9d, 0x86, 0x3e, 0x8d, 0x3d, 0x4a, 0xc2, 0x58, 0xd0, 0x34, 0x76, 0x27, 0x44,
+	0xcc, 0x13, 0xca, 0xd5, 0x13, 0x27, 0x29, 0x13, 0xcc, 0x30, 0x3d, 0xc6, 0x23, 0xc6, 0x07, 0xdc,
+	0x1f, 0xe3, 0x19, 0x3e, 0x4c, 0xe0, 0xe9, 0x65, 0xe7, 0x24, 0x60, 0x01, 0x2b, 0x4d, 0x64, 0xff,
+	0xa5, 0xfc, 0x1d, 0x14, 0x30, 0x16, 0x4c, 0x28, 0x29, 0xd1, 0x30, 0x7b, 0x21, 0x22, 0x8c, 0x28,
+	0x17, 0x6e, 0x94, 0x28, 0x83, 0xb5, 0xae, 0xe9, 0xed, 0xfb, 0xd7, 0x2c, 0x9c, 0x32, 0xcf, 0x15,
+	0x21, 0x8b, 0x8d, 0xbe, 0xde, 0x1c, 0xd1, 0x30, 0x18, 0x09, 0x13, 0x74, 0x41, 0xaf, 0x6e, 0x9f,
+	0xe5, 0x12, 0x55, 0x4c, 0x21, 0xd1, 0xdf, 0xb9, 0x1b, 0x4d, 0x6e, 0x2c, 0x85, 0x2d, 0xa7, 0x12,
+	0x8c, 0x07, 0xbd, 0xb1, 0x0f, 0x36, 0x6b, 0x5d, 0xd0, 0x6b, 0x5d, 0x75, 0xb0, 0x6a, 0xc5, 0x87,
+	0x56, 0xfc, 0x78, 0x68, 0xb5, 0xd1, 0x52, 0x22, 0x2d, 0x97, 0xa8, 0xf4, 0x17, 0x12, 0xb5, 0x54,
+	0xe0, 0x1e, 0x59, 0x8b, 0x77, 0x04, 0x9c, 0x52, 0x30, 0x88, 0xfe, 0x27, 0x61, 0x6f, 0x34, 0x35,
+	0xeb, 0xe5, 0x4f, 0x9c, 0xe6, 0x12, 0x29, 0xa2, 0x90, 0xa8, 0xad, 0x46, 0x4a, 0x68, 0x39, 0x8a,
+	0x36, 0x16, 0x40, 0xff, 0xef, 0xb1, 0x98, 0xd3, 0x98, 0x67, 0x7c, 0xe0, 0xfa, 0x7e, 0x4a, 0x39,
+	0x37, 0x1b, 0x5d, 0xd0, 0x6b, 0xdb, 0x5e, 0x2e, 0xd1, 0xb1, 0x58, 0x48, 0x64, 0xaa, 0xa4, 0x23,
+	0xc9, 0xfa, 0x94, 0x08, 0x07, 0xa1, 0x18, 0x65, 0x43, 0xec, 0xb1, 0x88, 0xa8, 0xa5, 0x57, 0xaf,
+	0x0b, 0xee, 0x8f, 0xab, 0x33, 0xb9, 0x63, 0x31, 0xbf, 0x55, 0x23, 0xce, 0xbf, 0xef, 0x94, 0x8a,
+	0xb1, 0x9f, 0x96, 0x1b, 0xa8, 0xad, 0x37, 0x50, 0x5b, 0x6e, 0x21, 0x58, 0x6d, 0x21, 0xf8, 0xd8,
+	0x42, 0xb0, 0xd8, 0x41, 0x6d, 0xb5, 0x83, 0xda, 0x7a, 0x07, 0xb5, 0xe7, 0xeb, 0x5f, 0x3b, 0x7e,
+	0xbc, 0x11, 0xc3, 0x66, 0xb9, 0xd8, 0xfe, 0xd7, 0x00, 0xe9, 0x74, 0xbb, 0x7a, 0x35, 0x02, 0x00,
+	0x00,
+}
+
+func (m *Equivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Equivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Power != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Equivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovTypes(uint64(m.Power))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Equivocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Equivocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Equivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = append(m.ConsensusAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsensusAddress == nil {
+				m.ConsensusAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
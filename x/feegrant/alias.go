@@ -0,0 +1,73 @@
+package feegrant
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// nolint
+
+const (
+	ModuleName                = types.ModuleName
+	StoreKey                  = types.StoreKey
+	RouterKey                 = types.RouterKey
+	QuerierRoute              = types.QuerierRoute
+	QueryGetFeeAllowances     = types.QueryGetFeeAllowances
+	DefaultCodespace          = types.DefaultCodespace
+	CodeFeeLimitExceeded      = types.CodeFeeLimitExceeded
+	CodeFeeLimitExpired       = types.CodeFeeLimitExpired
+	CodeInvalidDuration       = types.CodeInvalidDuration
+	CodeInvalidAllowance      = types.CodeInvalidAllowance
+	CodeNoAllowance           = types.CodeNoAllowance
+	TypeMsgGrantFeeAllowance  = types.TypeMsgGrantFeeAllowance
+	TypeMsgRevokeFeeAllowance = types.TypeMsgRevokeFeeAllowance
+	EventTypeUseFeeGrant      = types.EventTypeUseFeeGrant
+	EventTypeRevokeFeeGrant   = types.EventTypeRevokeFeeGrant
+	EventTypeSetFeeGrant      = types.EventTypeSetFeeGrant
+	AttributeValueCategory    = types.AttributeValueCategory
+	AttributeKeyGranter       = types.AttributeKeyGranter
+	AttributeKeyGrantee       = types.AttributeKeyGrantee
+)
+
+var (
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	NewBasicFeeAllowance        = types.NewBasicFeeAllowance
+	NewPeriodicFeeAllowance     = types.NewPeriodicFeeAllowance
+	NewFeeAllowanceGrant        = types.NewFeeAllowanceGrant
+	NewMsgGrantFeeAllowance     = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance    = types.NewMsgRevokeFeeAllowance
+	NewQueryFeeAllowancesParams = types.NewQueryFeeAllowancesParams
+	ExpiresAtTime               = types.ExpiresAtTime
+	ExpiresAtHeight             = types.ExpiresAtHeight
+	ClockDuration               = types.ClockDuration
+	BlockDuration               = types.BlockDuration
+	FeeAllowanceKey             = types.FeeAllowanceKey
+	FeeAllowancePrefixByGrantee = types.FeeAllowancePrefixByGrantee
+	RegisterCodec               = types.RegisterCodec
+	ModuleCdc                   = types.ModuleCdc
+	NewGenesisState             = types.NewGenesisState
+	DefaultGenesisState         = types.DefaultGenesisState
+	ErrFeeLimitExceeded         = types.ErrFeeLimitExceeded
+	ErrFeeLimitExpired          = types.ErrFeeLimitExpired
+	ErrInvalidDuration          = types.ErrInvalidDuration
+	ErrInvalidAllowance         = types.ErrInvalidAllowance
+	ErrNoAllowance              = types.ErrNoAllowance
+
+	FeeAllowanceKeyPrefix = types.FeeAllowanceKeyPrefix
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	GenesisState             = types.GenesisState
+	BasicFeeAllowance        = types.BasicFeeAllowance
+	PeriodicFeeAllowance     = types.PeriodicFeeAllowance
+	FeeAllowanceGrant        = types.FeeAllowanceGrant
+	ExpiresAt                = types.ExpiresAt
+	Duration                 = types.Duration
+	MsgGrantFeeAllowance     = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance    = types.MsgRevokeFeeAllowance
+	QueryFeeAllowancesParams = types.QueryFeeAllowancesParams
+)
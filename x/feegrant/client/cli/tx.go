@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// flags for the feegrant tx commands
+const (
+	FlagSpendLimit  = "spend-limit"
+	FlagExpiration  = "expiration"
+	FlagPeriod      = "period"
+	FlagPeriodLimit = "period-limit"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	feegrantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Feegrant transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantTxCmd.AddCommand(client.PostCommands(
+		GetCmdFeeGrant(cdc),
+		GetCmdRevokeFeegrant(cdc),
+	)...)
+
+	return feegrantTxCmd
+}
+
+// GetCmdFeeGrant implements the command to grant a fee allowance.
+func GetCmdFeeGrant(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee]",
+		Short: "Grant a fee allowance to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to pay fees from your address. Without a spend
+limit, the grantee may pay any amount of fees from your account. If a period is
+given, the grantee may spend at most the period limit within each period.
+
+Example:
+$ %s tx %s grant cosmos1skjw... --spend-limit=100stake --expiration=2021-01-01T00:00:00Z --from=mykey
+$ %s tx %s grant cosmos1skjw... --spend-limit=100stake --period=24h --period-limit=10stake --from=mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			allowance, err := allowanceFromFlags(time.Now())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantFeeAllowance(cliCtx.GetFromAddress(), grantee, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "The maximum amount of fees the grantee can spend, unlimited if empty")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 time at which the allowance expires, e.g. 2021-01-01T00:00:00Z")
+	cmd.Flags().Duration(FlagPeriod, 0, "The period after which the period spend limit is reset, e.g. 24h")
+	cmd.Flags().String(FlagPeriodLimit, "", "The maximum amount of fees the grantee can spend within each period")
+
+	return cmd
+}
+
+// allowanceFromFlags builds a basic or periodic fee allowance from the command
+// flags. Periods start at the given time.
+func allowanceFromFlags(now time.Time) (exported.FeeAllowanceI, error) {
+	spendLimit, err := sdk.ParseCoins(viper.GetString(FlagSpendLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	basic := types.BasicFeeAllowance{SpendLimit: spendLimit}
+	if exp := viper.GetString(FlagExpiration); exp != "" {
+		expiration, err := time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiration %q: %w", exp, err)
+		}
+		basic.Expiration = types.ExpiresAtTime(expiration)
+	}
+
+	period := viper.GetDuration(FlagPeriod)
+	periodLimitStr := viper.GetString(FlagPeriodLimit)
+	if period == 0 && periodLimitStr == "" {
+		return &basic, nil
+	}
+	if period == 0 || periodLimitStr == "" {
+		return nil, fmt.Errorf("both --%s and --%s must be given for a periodic allowance", FlagPeriod, FlagPeriodLimit)
+	}
+
+	periodLimit, err := sdk.ParseCoins(periodLimitStr)
+	if err != nil {
+		return nil, err
+	}
+
+	clockPeriod := types.ClockDuration(period)
+	return types.NewPeriodicFeeAllowance(
+		basic, clockPeriod, periodLimit, types.ExpiresAtTime(now).MustStep(clockPeriod),
+	), nil
+}
+
+// GetCmdRevokeFeegrant implements the command to revoke a fee allowance.
+func GetCmdRevokeFeegrant(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke a fee allowance granted to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the fee allowance you granted to an address.
+
+Example:
+$ %s tx %s revoke cosmos1skjw... --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(cliCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
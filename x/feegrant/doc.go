@@ -0,0 +1,35 @@
+/*
+Package feegrant provides functionality for authorizing the payment of transaction
+fees from one account (granter) to another account (grantee).
+
+Fees are paid by the granter when the grantee submits a transaction whose StdFee
+names the granter. The allowance held by the grantee is checked and updated by
+the DeductFeeDecorator in x/auth/ante, which then deducts the fee from the
+granter's account instead of the first signer's.
+
+Grants are stored as a FeeAllowanceGrant, which wraps a concrete FeeAllowanceI
+implementation:
+
+	BasicFeeAllowance: a one-time spend limit which may expire at a given block
+	time or height.
+
+	PeriodicFeeAllowance: a BasicFeeAllowance which additionally limits the fees
+	that can be paid within a repeating period.
+
+A full setup of the feegrant module may look something as follows:
+
+	ModuleBasics = module.NewBasicManager(
+	  // ...,
+	  feegrant.AppModuleBasic{},
+	)
+
+	app.FeeGrantKeeper = feegrant.NewKeeper(
+	  app.cdc, keys[feegrant.StoreKey], feegrant.DefaultCodespace,
+	)
+
+	app.SetAnteHandler(ante.NewAnteHandler(
+	  app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, nil, auth.DefaultSigVerificationGasConsumer,
+	  auth.DefaultSignModeHandler(auth.DefaultTxEncoder(app.cdc)),
+	))
+*/
+package feegrant
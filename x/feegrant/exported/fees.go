@@ -0,0 +1,25 @@
+package exported
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowanceI defines the contract which concrete fee allowance types must
+// implement. A fee allowance allows a grantee to pay transaction fees out of
+// the granter's account.
+type FeeAllowanceI interface {
+	// Accept can use fee payment requested as well as timestamp/height of the
+	// current block to determine whether or not to process this. This is checked
+	// in the ante decorator. If it returns an error, the fee payment is rejected,
+	// otherwise it is accepted. The FeeAllowance implementation is expected to
+	// update its internal state and will be saved again after an acceptance.
+	//
+	// If remove is true (regardless of the error), the FeeAllowance will be
+	// deleted from storage (e.g. when it is used up).
+	Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64) (remove bool, err error)
+
+	// ValidateBasic performs a stateless validity check on the allowance.
+	ValidateBasic() error
+}
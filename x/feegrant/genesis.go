@@ -0,0 +1,29 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the feegrant module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	for _, f := range gs.FeeAllowances {
+		k.GrantFeeAllowance(ctx, f)
+	}
+}
+
+// ExportGenesis returns the feegrant module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	grants := k.GetAllFeeAllowances(ctx)
+	if grants == nil {
+		grants = []FeeAllowanceGrant{}
+	}
+
+	return NewGenesisState(grants)
+}
@@ -0,0 +1,65 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper feegrant.Keeper
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 1})
+	suite.keeper = app.FeeGrantKeeper
+}
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)))
+
+	allowance := feegrant.NewBasicFeeAllowance(coins, feegrant.ExpiresAtHeight(1000))
+	suite.keeper.GrantFeeAllowance(suite.ctx, feegrant.NewFeeAllowanceGrant(granter, grantee, allowance))
+
+	genesis := feegrant.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Len(genesis.FeeAllowances, 1)
+
+	// revoke the grant and import it again from the exported genesis
+	suite.Require().NoError(suite.keeper.RevokeFeeAllowance(suite.ctx, granter, grantee))
+	suite.Require().Nil(suite.keeper.GetFeeAllowance(suite.ctx, granter, grantee))
+
+	feegrant.InitGenesis(suite.ctx, suite.keeper, genesis)
+	suite.Require().Equal(allowance, suite.keeper.GetFeeAllowance(suite.ctx, granter, grantee))
+	suite.Require().Equal(genesis, feegrant.ExportGenesis(suite.ctx, suite.keeper))
+}
+
+func (suite *GenesisTestSuite) TestInitGenesis_Invalid() {
+	granter := sdk.AccAddress("granter_____________")
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)))
+
+	// self grants are invalid
+	genesis := feegrant.NewGenesisState([]feegrant.FeeAllowanceGrant{
+		feegrant.NewFeeAllowanceGrant(granter, granter, feegrant.NewBasicFeeAllowance(coins, feegrant.ExpiresAt{})),
+	})
+
+	suite.Require().Panics(func() {
+		feegrant.InitGenesis(suite.ctx, suite.keeper, genesis)
+	})
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// Keeper manages state of all fee grants, as well as calculating approval.
+// It must have a codec with all available allowances registered.
+type Keeper struct {
+	cdc       *codec.Codec
+	storeKey  sdk.StoreKey
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a fee grant Keeper
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		codespace: codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantFeeAllowance creates a new grant, overwriting any existing grant from
+// the same granter to the same grantee.
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, grant types.FeeAllowanceGrant) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FeeAllowanceKey(grant.Granter, grant.Grantee)
+	bz := k.cdc.MustMarshalBinaryBare(grant)
+	store.Set(key, bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+		),
+	)
+}
+
+// RevokeFeeAllowance removes an existing grant. It returns an error if no
+// grant exists from the granter to the grantee.
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FeeAllowanceKey(granter, grantee)
+	if !store.Has(key) {
+		return types.ErrNoAllowance(k.codespace, granter, grantee)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetFeeAllowance returns the allowance between the granter and grantee.
+// If there is none, it returns nil.
+// It should only return an error if there is a problem with the stored data.
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) exported.FeeAllowanceI {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return nil
+	}
+
+	return grant.Allowance
+}
+
+// GetFeeGrant returns the full FeeAllowanceGrant between the granter and
+// grantee, if it exists.
+func (k Keeper) GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant types.FeeAllowanceGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeAllowanceKey(granter, grantee))
+	if len(bz) == 0 {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// IterateAllGranteeFeeAllowances iterates over all the grants from anyone to
+// the given grantee. Callback to get all data, returns true to stop, false to
+// keep reading.
+func (k Keeper) IterateAllGranteeFeeAllowances(ctx sdk.Context, grantee sdk.AccAddress, cb func(types.FeeAllowanceGrant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FeeAllowancePrefixByGrantee(grantee))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// IterateAllFeeAllowances iterates over all the grants in the store. Callback
+// to get all data, returns true to stop, false to keep reading.
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(types.FeeAllowanceGrant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FeeAllowanceKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// GetAllFeeAllowances returns all stored fee allowance grants.
+func (k Keeper) GetAllFeeAllowances(ctx sdk.Context) (grants []types.FeeAllowanceGrant) {
+	k.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	return grants
+}
+
+// UseGrantedFees will try to pay the given fee from the granter's account as
+// requested by the grantee. It returns an error if the grantee holds no
+// allowance from the granter or the allowance does not cover the fee. The
+// updated allowance is stored again, or removed if it has been used up or
+// expired.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found || grant.Allowance == nil {
+		return types.ErrNoAllowance(k.codespace, granter, grantee)
+	}
+
+	remove, err := grant.Allowance.Accept(fee, ctx.BlockTime(), ctx.BlockHeight())
+	if remove {
+		// ignore the error, the grant is known to exist
+		_ = k.RevokeFeeAllowance(ctx, granter, grantee)
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	if !remove {
+		// if we accepted, store the updated state of the allowance
+		k.GrantFeeAllowance(ctx, grant)
+	}
+
+	return nil
+}
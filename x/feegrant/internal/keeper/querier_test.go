@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+const (
+	custom = "custom"
+)
+
+func (suite *KeeperTestSuite) TestQueryFeeAllowances() {
+	ctx := suite.ctx
+	cdc := suite.app.Codec()
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	grant := types.NewFeeAllowanceGrant(suite.addr, suite.addr2, types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(100)))
+	suite.keeper.GrantFeeAllowance(ctx, grant)
+
+	query := func(grantee sdk.AccAddress) []types.FeeAllowanceGrant {
+		req := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetFeeAllowances}, "/"),
+			Data: cdc.MustMarshalJSON(types.NewQueryFeeAllowancesParams(grantee)),
+		}
+
+		bz, err := suite.querier(ctx, []string{types.QueryGetFeeAllowances}, req)
+		suite.Require().NoError(err)
+
+		var grants []types.FeeAllowanceGrant
+		suite.Require().NoError(cdc.UnmarshalJSON(bz, &grants))
+		return grants
+	}
+
+	suite.Equal([]types.FeeAllowanceGrant{grant}, query(suite.addr2))
+	suite.Empty(query(suite.addr))
+
+	_, err := suite.querier(ctx, []string{"foo"}, abci.RequestQuery{})
+	suite.Error(err)
+
+	_, err = suite.querier(ctx, []string{types.QueryGetFeeAllowances}, abci.RequestQuery{Data: []byte("invalid")})
+	suite.Error(err)
+}
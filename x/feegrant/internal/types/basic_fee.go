@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+var _ exported.FeeAllowanceI = (*BasicFeeAllowance)(nil)
+
+// BasicFeeAllowance implements FeeAllowance with a one-time grant of tokens
+// that optionally expires. The delegatee can use up to SpendLimit to cover fees.
+// An empty SpendLimit places no limit on the fees which can be paid.
+type BasicFeeAllowance struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+	Expiration ExpiresAt `json:"expiration" yaml:"expiration"`
+}
+
+// NewBasicFeeAllowance creates a new BasicFeeAllowance.
+func NewBasicFeeAllowance(spendLimit sdk.Coins, expiration ExpiresAt) *BasicFeeAllowance {
+	return &BasicFeeAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// Accept can use fee payment requested as well as timestamp/height of the current block
+// to determine whether or not to process this. This is checked in
+// Keeper.UseGrantedFees and the return values should match how it is handled there.
+//
+// If it returns an error, the fee payment is rejected, otherwise it is accepted.
+// The FeeAllowance implementation is expected to update it's internal state
+// and will be saved again after an acceptance.
+//
+// If remove is true (regardless of the error), the FeeAllowance will be deleted from storage
+// (eg. when it is used up). (See call to RevokeFeeAllowance in Keeper.UseGrantedFees)
+func (a *BasicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64) (bool, error) {
+	if a.Expiration.IsExpired(blockTime, blockHeight) {
+		return true, ErrFeeLimitExpired(DefaultCodespace)
+	}
+
+	if a.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	left, invalid := a.SpendLimit.SafeSub(fee)
+	if invalid {
+		return false, ErrFeeLimitExceeded(DefaultCodespace)
+	}
+
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a BasicFeeAllowance) ValidateBasic() error {
+	if !a.SpendLimit.Empty() {
+		if !a.SpendLimit.IsValid() {
+			return ErrInvalidAllowance(DefaultCodespace, "spend limit must be valid: "+a.SpendLimit.String())
+		}
+		if !a.SpendLimit.IsAllPositive() {
+			return ErrInvalidAllowance(DefaultCodespace, "spend limit must be positive")
+		}
+	}
+
+	return a.Expiration.ValidateBasic()
+}
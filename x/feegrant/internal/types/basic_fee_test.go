@@ -0,0 +1,124 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBasicFeeValidAllow(t *testing.T) {
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	now := time.Now()
+
+	cases := map[string]struct {
+		allow BasicFeeAllowance
+		// all other checks are ignored if valid=false
+		fee       sdk.Coins
+		blockTime time.Time
+		valid     bool
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+	}{
+		"empty": {
+			allow:  BasicFeeAllowance{},
+			valid:  true,
+			fee:    atom,
+			accept: true,
+		},
+		"negative": {
+			allow: BasicFeeAllowance{
+				SpendLimit: sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}},
+			},
+			valid: false,
+		},
+		"small fee": {
+			allow: BasicFeeAllowance{
+				SpendLimit: atom,
+			},
+			valid:   true,
+			fee:     smallAtom,
+			accept:  true,
+			remove:  false,
+			remains: leftAtom,
+		},
+		"all fee": {
+			allow: BasicFeeAllowance{
+				SpendLimit: smallAtom,
+			},
+			valid:  true,
+			fee:    smallAtom,
+			accept: true,
+			remove: true,
+		},
+		"wrong fee": {
+			allow: BasicFeeAllowance{
+				SpendLimit: smallAtom,
+			},
+			valid:  true,
+			fee:    eth,
+			accept: false,
+		},
+		"non-expired": {
+			allow: BasicFeeAllowance{
+				SpendLimit: atom,
+				Expiration: ExpiresAtHeight(100),
+			},
+			valid:   true,
+			fee:     smallAtom,
+			accept:  true,
+			remove:  false,
+			remains: leftAtom,
+		},
+		"expired": {
+			allow: BasicFeeAllowance{
+				SpendLimit: atom,
+				Expiration: ExpiresAtTime(now),
+			},
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now.Add(time.Second),
+			accept:    false,
+			remove:    true,
+		},
+		"invalid expiration": {
+			allow: BasicFeeAllowance{
+				SpendLimit: atom,
+				Expiration: ExpiresAt{Height: 100, Time: now},
+			},
+			valid: false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// now try to deduct
+			remove, err := tc.allow.Accept(tc.fee, tc.blockTime, 10)
+			if !tc.accept {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.remove, remove)
+
+			if tc.accept && !remove {
+				assert.Equal(t, tc.remains, tc.allow.SpendLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// DONTCOVER
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Error codes specific to the feegrant module
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeFeeLimitExceeded sdk.CodeType = 1
+	CodeFeeLimitExpired  sdk.CodeType = 2
+	CodeInvalidDuration  sdk.CodeType = 3
+	CodeInvalidAllowance sdk.CodeType = 4
+	CodeNoAllowance      sdk.CodeType = 5
+)
+
+// ErrFeeLimitExceeded returns a typed ABCI error for a fee exceeding the
+// remaining allowance.
+func ErrFeeLimitExceeded(codespace sdk.CodespaceType) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeFeeLimitExceeded),
+		"fee limit exceeded",
+	)
+}
+
+// ErrFeeLimitExpired returns a typed ABCI error for an expired fee allowance.
+func ErrFeeLimitExpired(codespace sdk.CodespaceType) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeFeeLimitExpired),
+		"fee limit expired",
+	)
+}
+
+// ErrInvalidDuration returns a typed ABCI error for an invalid period or an
+// expiration incompatible with it.
+func ErrInvalidDuration(codespace sdk.CodespaceType, msg string) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeInvalidDuration),
+		fmt.Sprintf("invalid duration: %s", msg),
+	)
+}
+
+// ErrInvalidAllowance returns a typed ABCI error for a malformed fee allowance.
+func ErrInvalidAllowance(codespace sdk.CodespaceType, msg string) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeInvalidAllowance),
+		fmt.Sprintf("invalid fee allowance: %s", msg),
+	)
+}
+
+// ErrNoAllowance returns a typed ABCI error when no fee allowance exists from
+// the granter to the grantee.
+func ErrNoAllowance(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeNoAllowance),
+		fmt.Sprintf("no fee allowance from %s to %s", granter, grantee),
+	)
+}
@@ -0,0 +1,32 @@
+package types
+
+// GenesisState contains a set of fee allowances, persisted from the store
+type GenesisState struct {
+	FeeAllowances []FeeAllowanceGrant `json:"fee_allowances" yaml:"fee_allowances"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(feeAllowances []FeeAllowanceGrant) GenesisState {
+	return GenesisState{
+		FeeAllowances: feeAllowances,
+	}
+}
+
+// DefaultGenesisState returns the feegrant module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		FeeAllowances: []FeeAllowanceGrant{},
+	}
+}
+
+// Validate performs basic genesis state validation, returning an error upon
+// any failure.
+func (gs GenesisState) Validate() error {
+	for _, f := range gs.FeeAllowances {
+		if err := f.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+// FeeAllowanceGrant is stored in the KVStore to record a grant with full context
+type FeeAllowanceGrant struct {
+	Granter   sdk.AccAddress         `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Allowance exported.FeeAllowanceI `json:"allowance" yaml:"allowance"`
+}
+
+// NewFeeAllowanceGrant creates a new FeeAllowanceGrant.
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, allowance exported.FeeAllowanceI) FeeAllowanceGrant {
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// ValidateBasic performs basic validation on
+// FeeAllowanceGrant
+func (a FeeAllowanceGrant) ValidateBasic() error {
+	if a.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if a.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if a.Grantee.Equals(a.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+	if a.Allowance == nil {
+		return ErrInvalidAllowance(DefaultCodespace, "missing allowance")
+	}
+
+	return a.Allowance.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (a FeeAllowanceGrant) String() string {
+	return fmt.Sprintf(`Granter:   %s
+Grantee:   %s
+Allowance: %v`, a.Granter, a.Grantee, a.Allowance)
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+// Message types for the feegrant module
+const (
+	TypeMsgGrantFeeAllowance  = "grant_fee_allowance"
+	TypeMsgRevokeFeeAllowance = "revoke_fee_allowance"
+)
+
+var (
+	_ sdk.Msg = MsgGrantFeeAllowance{}
+	_ sdk.Msg = MsgRevokeFeeAllowance{}
+)
+
+// MsgGrantFeeAllowance adds permission for Grantee to spend up to Allowance
+// of fees from the account of Granter.
+// If there was already an existing grant, this overwrites it.
+type MsgGrantFeeAllowance struct {
+	Granter   sdk.AccAddress         `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Allowance exported.FeeAllowanceI `json:"allowance" yaml:"allowance"`
+}
+
+func NewMsgGrantFeeAllowance(granter, grantee sdk.AccAddress, allowance exported.FeeAllowanceI) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{Granter: granter, Grantee: grantee, Allowance: allowance}
+}
+
+// Route returns the MsgGrantFeeAllowance's route.
+func (msg MsgGrantFeeAllowance) Route() string { return RouterKey }
+
+// Type returns the MsgGrantFeeAllowance's type.
+func (msg MsgGrantFeeAllowance) Type() string { return TypeMsgGrantFeeAllowance }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgGrantFeeAllowance.
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdk.Error {
+	return sdk.ConvertError(msg.Grant().ValidateBasic())
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgGrantFeeAllowance message.
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgGrantFeeAllowance.
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// Grant returns the FeeAllowanceGrant created by the message.
+func (msg MsgGrantFeeAllowance) Grant() FeeAllowanceGrant {
+	return NewFeeAllowanceGrant(msg.Granter, msg.Grantee, msg.Allowance)
+}
+
+// MsgRevokeFeeAllowance removes any existing FeeAllowance from Granter to Grantee.
+type MsgRevokeFeeAllowance struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+func NewMsgRevokeFeeAllowance(granter, grantee sdk.AccAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{Granter: granter, Grantee: grantee}
+}
+
+// Route returns the MsgRevokeFeeAllowance's route.
+func (msg MsgRevokeFeeAllowance) Route() string { return RouterKey }
+
+// Type returns the MsgRevokeFeeAllowance's type.
+func (msg MsgRevokeFeeAllowance) Type() string { return TypeMsgRevokeFeeAllowance }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgRevokeFeeAllowance.
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgRevokeFeeAllowance message.
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgRevokeFeeAllowance.
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgGrantFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+
+	cases := map[string]struct {
+		msg   MsgGrantFeeAllowance
+		valid bool
+	}{
+		"valid": {
+			msg:   NewMsgGrantFeeAllowance(granter, grantee, NewBasicFeeAllowance(atom, ExpiresAtHeight(100))),
+			valid: true,
+		},
+		"no granter": {
+			msg:   NewMsgGrantFeeAllowance(nil, grantee, NewBasicFeeAllowance(atom, ExpiresAtHeight(100))),
+			valid: false,
+		},
+		"no grantee": {
+			msg:   NewMsgGrantFeeAllowance(granter, nil, NewBasicFeeAllowance(atom, ExpiresAtHeight(100))),
+			valid: false,
+		},
+		"self grant": {
+			msg:   NewMsgGrantFeeAllowance(granter, granter, NewBasicFeeAllowance(atom, ExpiresAtHeight(100))),
+			valid: false,
+		},
+		"no allowance": {
+			msg:   NewMsgGrantFeeAllowance(granter, grantee, nil),
+			valid: false,
+		},
+		"invalid allowance": {
+			msg:   NewMsgGrantFeeAllowance(granter, grantee, NewBasicFeeAllowance(atom, ExpiresAtHeight(-1))),
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, RouterKey, tc.msg.Route())
+			require.Equal(t, TypeMsgGrantFeeAllowance, tc.msg.Type())
+			require.Equal(t, []sdk.AccAddress{granter}, tc.msg.GetSigners())
+			require.NotPanics(t, func() { tc.msg.GetSignBytes() })
+		})
+	}
+}
+
+func TestMsgRevokeFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+
+	msg := NewMsgRevokeFeeAllowance(granter, grantee)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, TypeMsgRevokeFeeAllowance, msg.Type())
+	require.Equal(t, []sdk.AccAddress{granter}, msg.GetSigners())
+
+	require.Error(t, NewMsgRevokeFeeAllowance(nil, grantee).ValidateBasic())
+	require.Error(t, NewMsgRevokeFeeAllowance(granter, nil).ValidateBasic())
+}
@@ -0,0 +1,149 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+var _ exported.FeeAllowanceI = (*PeriodicFeeAllowance)(nil)
+
+// PeriodicFeeAllowance extends FeeAllowance to allow for both a maximum cap,
+// as well as a limit per time period.
+type PeriodicFeeAllowance struct {
+	Basic BasicFeeAllowance `json:"basic" yaml:"basic"`
+
+	// Period is the duration of one period
+	Period Duration `json:"period" yaml:"period"`
+	// PeriodSpendLimit is the maximum amount of tokens to be spent in this period
+	PeriodSpendLimit sdk.Coins `json:"period_spend_limit" yaml:"period_spend_limit"`
+
+	// PeriodCanSpend is how much is available until PeriodReset
+	PeriodCanSpend sdk.Coins `json:"period_can_spend" yaml:"period_can_spend"`
+
+	// PeriodReset is when the PeriodCanSpend is updated with the
+	// PeriodSpendLimit. It is updated when the period is exhausted.
+	PeriodReset ExpiresAt `json:"period_reset" yaml:"period_reset"`
+}
+
+// NewPeriodicFeeAllowance creates a new PeriodicFeeAllowance. The first period
+// starts with the full PeriodSpendLimit available and ends at periodReset.
+func NewPeriodicFeeAllowance(
+	basic BasicFeeAllowance, period Duration, periodSpendLimit sdk.Coins, periodReset ExpiresAt,
+) *PeriodicFeeAllowance {
+	return &PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      periodReset,
+	}
+}
+
+// Accept can use fee payment requested as well as timestamp/height of the current block
+// to determine whether or not to process this. This is checked in
+// Keeper.UseGrantedFees and the return values should match how it is handled there.
+//
+// If it returns an error, the fee payment is rejected, otherwise it is accepted.
+// The FeeAllowance implementation is expected to update it's internal state
+// and will be saved again after an acceptance.
+//
+// If remove is true (regardless of the error), the FeeAllowance will be deleted from storage
+// (eg. when it is used up). (See call to RevokeFeeAllowance in Keeper.UseGrantedFees)
+func (a *PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64) (bool, error) {
+	if a.Basic.Expiration.IsExpired(blockTime, blockHeight) {
+		return true, ErrFeeLimitExpired(DefaultCodespace)
+	}
+
+	a.tryResetPeriod(blockTime, blockHeight)
+
+	// deduct from both the current period and the max amount
+	var isNeg bool
+	a.PeriodCanSpend, isNeg = a.PeriodCanSpend.SafeSub(fee)
+	if isNeg {
+		return false, ErrFeeLimitExceeded(DefaultCodespace)
+	}
+
+	if a.Basic.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	a.Basic.SpendLimit, isNeg = a.Basic.SpendLimit.SafeSub(fee)
+	if isNeg {
+		return false, ErrFeeLimitExceeded(DefaultCodespace)
+	}
+
+	return a.Basic.SpendLimit.IsZero(), nil
+}
+
+// tryResetPeriod will check if the PeriodReset has been hit. If not, it is a no-op.
+// If we hit the reset period, it will top up the PeriodCanSpend amount to
+// min(PeriodSpendLimit, Basic.SpendLimit) so it is never more than the maximum allowed.
+// It will also update the PeriodReset. If we are within one Period, it will update from the
+// last PeriodReset (eg. if you always do one tx per day, it will always reset the same time)
+// If we are more than one period out (eg. no activity in a week), reset is one Period from the execution of this method
+func (a *PeriodicFeeAllowance) tryResetPeriod(blockTime time.Time, blockHeight int64) {
+	if !a.PeriodReset.IsZero() && !a.PeriodReset.IsExpired(blockTime, blockHeight) {
+		return
+	}
+
+	// set PeriodCanSpend to the lesser of Basic.SpendLimit and PeriodSpendLimit
+	if _, isNeg := a.Basic.SpendLimit.SafeSub(a.PeriodSpendLimit); isNeg && !a.Basic.SpendLimit.Empty() {
+		a.PeriodCanSpend = a.Basic.SpendLimit
+	} else {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	}
+
+	// If we are within the period, step from expiration (eg. if you always do one tx per day, it will always reset the same time)
+	// If we are more then one period out (eg. no activity in a week), reset is one period from this time
+	// A zero PeriodReset starts the first period at the current block
+	if a.PeriodReset.IsZero() {
+		if a.Period.Block != 0 {
+			a.PeriodReset = ExpiresAtHeight(blockHeight)
+		} else {
+			a.PeriodReset = ExpiresAtTime(blockTime)
+		}
+	}
+
+	a.PeriodReset = a.PeriodReset.MustStep(a.Period)
+	if a.PeriodReset.IsExpired(blockTime, blockHeight) {
+		a.PeriodReset = a.PeriodReset.FastForward(blockTime, blockHeight).MustStep(a.Period)
+	}
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a PeriodicFeeAllowance) ValidateBasic() error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !a.PeriodSpendLimit.IsValid() {
+		return ErrInvalidAllowance(DefaultCodespace, "period spend limit must be valid: "+a.PeriodSpendLimit.String())
+	}
+	if !a.PeriodSpendLimit.IsAllPositive() {
+		return ErrInvalidAllowance(DefaultCodespace, "period spend limit must be positive")
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return ErrInvalidAllowance(DefaultCodespace, "can spend amount must be valid: "+a.PeriodCanSpend.String())
+	}
+	// We allow 0 for CanSpend
+	if a.PeriodCanSpend.IsAnyNegative() {
+		return ErrInvalidAllowance(DefaultCodespace, "can spend must not be negative")
+	}
+
+	// ensure PeriodSpendLimit can be subtracted from total (same coin types)
+	if !a.Basic.SpendLimit.Empty() && !a.PeriodSpendLimit.DenomsSubsetOf(a.Basic.SpendLimit) {
+		return ErrInvalidAllowance(DefaultCodespace, "period spend limit has different currency than basic spend limit")
+	}
+
+	// check times
+	if err := a.Period.ValidateBasic(); err != nil {
+		return err
+	}
+	if !a.PeriodReset.IsZero() && !a.PeriodReset.IsCompatible(a.Period) {
+		return ErrInvalidDuration(DefaultCodespace, "period reset and period have different units")
+	}
+
+	return a.PeriodReset.ValidateBasic()
+}
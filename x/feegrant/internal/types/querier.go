@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the feegrant module
+const (
+	QueryGetFeeAllowances = "fees"
+)
+
+// QueryFeeAllowancesParams defines the parameters necessary for querying the
+// fee allowances granted to a grantee.
+type QueryFeeAllowancesParams struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+func NewQueryFeeAllowancesParams(grantee sdk.AccAddress) QueryFeeAllowancesParams {
+	return QueryFeeAllowancesParams{Grantee: grantee}
+}
@@ -0,0 +1,25 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding feegrant type
+func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.FeeAllowanceKeyPrefix):
+		var grantA, grantB types.FeeAllowanceGrant
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &grantA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &grantB)
+		return fmt.Sprintf("%v\n%v", grantA, grantB)
+
+	default:
+		panic(fmt.Sprintf("invalid feegrant key prefix %X", kvA.Key[:1]))
+	}
+}
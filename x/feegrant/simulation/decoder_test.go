@@ -0,0 +1,62 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+var (
+	granterAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	granteeAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func makeTestCodec() (cdc *codec.Codec) {
+	cdc = codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	return
+}
+
+func TestDecodeStore(t *testing.T) {
+	cdc := makeTestCodec()
+
+	grant := types.NewFeeAllowanceGrant(
+		granterAddr, granteeAddr,
+		types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("foo", 100)), types.ExpiresAtHeight(100)),
+	)
+
+	kvPairs := cmn.KVPairs{
+		cmn.KVPair{Key: types.FeeAllowanceKey(granterAddr, granteeAddr), Value: cdc.MustMarshalBinaryBare(grant)},
+		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"FeeAllowanceGrant", fmt.Sprintf("%v\n%v", grant, grant)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { DecodeStore(cdc, kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
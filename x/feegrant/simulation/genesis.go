@@ -0,0 +1,21 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// RandomizedGenState generates a random GenesisState for feegrant. Fee
+// allowances are created through simulated MsgGrantFeeAllowance messages, so
+// the genesis state starts out empty.
+func RandomizedGenState(simState *module.SimulationState) {
+	feegrantGenesis := types.DefaultGenesisState()
+
+	fmt.Printf("Selected randomly generated feegrant parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, feegrantGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feegrantGenesis)
+}
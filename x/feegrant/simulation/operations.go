@@ -0,0 +1,155 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrantFeeAllowance  = "op_weight_msg_grant_fee_allowance"
+	OpWeightMsgRevokeFeeAllowance = "op_weight_msg_revoke_fee_allowance"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var weightMsgGrantFeeAllowance int
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrantFeeAllowance, &weightMsgGrantFeeAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantFeeAllowance = simappparams.DefaultWeightMsgGrantFeeAllowance
+		},
+	)
+
+	var weightMsgRevokeFeeAllowance int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeFeeAllowance, &weightMsgRevokeFeeAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeFeeAllowance = simappparams.DefaultWeightMsgRevokeFeeAllowance
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgGrantFeeAllowance,
+			SimulateMsgGrantFeeAllowance(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeFeeAllowance,
+			SimulateMsgRevokeFeeAllowance(ak, k),
+		),
+	}
+}
+
+// SimulateMsgGrantFeeAllowance generates a MsgGrantFeeAllowance with random values.
+// nolint: funlen
+func SimulateMsgGrantFeeAllowance(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		granter, _ := simulation.RandomAcc(r, accs)
+		grantee, _ := simulation.RandomAcc(r, accs)
+		if granter.Address.Equals(grantee.Address) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+		spendableCoins := account.SpendableCoins(ctx.BlockTime())
+
+		fees, err := simulation.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		spendableCoins = spendableCoins.Sub(fees)
+		if spendableCoins.Empty() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		spendLimit := simulation.RandSubsetCoins(r, spendableCoins)
+		if spendLimit.Empty() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		expiration := types.ExpiresAtTime(ctx.BlockTime().Add(time.Duration(simulation.RandIntBetween(r, 1, 365*24)) * time.Hour))
+		msg := types.NewMsgGrantFeeAllowance(granter.Address, grantee.Address, types.NewBasicFeeAllowance(spendLimit, expiration))
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRevokeFeeAllowance generates a MsgRevokeFeeAllowance for a random
+// existing fee allowance.
+// nolint: funlen
+func SimulateMsgRevokeFeeAllowance(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var grants []types.FeeAllowanceGrant
+		k.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+			grants = append(grants, grant)
+			return false
+		})
+
+		if len(grants) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		grant := grants[r.Intn(len(grants))]
+		granter, found := simulation.FindAccount(accs, grant.Granter)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(grant.Granter, grant.Grantee)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
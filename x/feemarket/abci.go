@@ -0,0 +1,33 @@
+package feemarket
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+)
+
+// EndBlocker records the gas used by the block and updates the base fee of the
+// next block according to it.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	blockGasUsed := ctx.BlockGasMeter().GasConsumedToLimit()
+	k.SetBlockGasUsed(ctx, blockGasUsed)
+
+	// the base fee is only tracked while the fee market is enabled
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		k.SetBaseFee(ctx, sdk.DecCoins{})
+		return
+	}
+
+	baseFee := params.NextBaseFee(k.GetBaseFee(ctx), blockGasUsed, ctx.BlockGasMeter().Limit())
+	k.SetBaseFee(ctx, baseFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFee,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGasUsed, fmt.Sprintf("%d", blockGasUsed)),
+		),
+	)
+}
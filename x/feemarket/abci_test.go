@@ -0,0 +1,38 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	baseFee := sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 8)}
+	app.FeeMarketKeeper.SetBaseFee(ctx, baseFee)
+
+	// a full block raises the base fee by 1/BaseFeeChangeDenominator
+	blockGasMeter := sdk.NewGasMeter(1000)
+	blockGasMeter.ConsumeGas(1000, "test")
+	ctx = ctx.WithBlockGasMeter(blockGasMeter)
+
+	// the base fee is not tracked while the fee market is disabled
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, uint64(1000), app.FeeMarketKeeper.GetBlockGasUsed(ctx))
+	require.Empty(t, app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	params := feemarket.DefaultParams()
+	params.Enabled = true
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	app.FeeMarketKeeper.SetBaseFee(ctx, baseFee)
+
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 9)}, app.FeeMarketKeeper.GetBaseFee(ctx))
+}
@@ -0,0 +1,61 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/feemarket/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/feemarket/internal/types
+package feemarket
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+)
+
+const (
+	ModuleName               = types.ModuleName
+	DefaultParamspace        = types.DefaultParamspace
+	StoreKey                 = types.StoreKey
+	QuerierRoute             = types.QuerierRoute
+	QueryParameters          = types.QueryParameters
+	QueryBaseFee             = types.QueryBaseFee
+	QueryBlockGasUsed        = types.QueryBlockGasUsed
+	EventTypeBaseFee         = types.EventTypeBaseFee
+	AttributeKeyBaseFee      = types.AttributeKeyBaseFee
+	AttributeKeyBlockGasUsed = types.AttributeKeyBlockGasUsed
+)
+
+var (
+	// functions aliases
+	NewKeeper            = keeper.NewKeeper
+	NewQuerier           = keeper.NewQuerier
+	NewQueryServer       = keeper.NewQueryServer
+	NewGenesisState      = types.NewGenesisState
+	DefaultGenesisState  = types.DefaultGenesisState
+	ValidateGenesis      = types.ValidateGenesis
+	ParamKeyTable        = types.ParamKeyTable
+	NewParams            = types.NewParams
+	DefaultParams        = types.DefaultParams
+	RegisterQueryService = types.RegisterQueryService
+	NewQueryClient       = types.NewQueryClient
+
+	// variable aliases
+	ModuleCdc                   = types.ModuleCdc
+	BaseFeeKey                  = types.BaseFeeKey
+	BlockGasUsedKey             = types.BlockGasUsedKey
+	KeyEnabled                  = types.KeyEnabled
+	KeyBaseFeeChangeDenominator = types.KeyBaseFeeChangeDenominator
+	KeyElasticityMultiplier     = types.KeyElasticityMultiplier
+	KeyMinBaseFee               = types.KeyMinBaseFee
+	KeyBurnBaseFee              = types.KeyBurnBaseFee
+)
+
+type (
+	Keeper                    = keeper.Keeper
+	GenesisState              = types.GenesisState
+	Params                    = types.Params
+	QueryServer               = types.QueryServer
+	QueryClient               = types.QueryClient
+	QueryBaseFeeRequest       = types.QueryBaseFeeRequest
+	QueryBaseFeeResponse      = types.QueryBaseFeeResponse
+	QueryBlockGasUsedRequest  = types.QueryBlockGasUsedRequest
+	QueryBlockGasUsedResponse = types.QueryBlockGasUsedResponse
+)
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the fee market module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	feeMarketQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the fee market module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feeMarketQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryParams(cdc),
+			GetCmdQueryBaseFee(cdc),
+			GetCmdQueryBlockGasUsed(cdc),
+		)...,
+	)
+
+	return feeMarketQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current fee market
+// parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current fee market parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryBaseFee implements a command to return the base fee of the
+// next block.
+func GetCmdQueryBaseFee(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "base-fee",
+		Short: "Query the base fee of the next block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBaseFee)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var baseFee sdk.DecCoins
+			if err := cdc.UnmarshalJSON(res, &baseFee); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(baseFee)
+		},
+	}
+}
+
+// GetCmdQueryBlockGasUsed implements a command to return the gas used by the
+// last block.
+func GetCmdQueryBlockGasUsed(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "block-gas-used",
+		Short: "Query the gas used by the last block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBlockGasUsed)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var gasUsed uint64
+			if err := cdc.UnmarshalJSON(res, &gasUsed); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(gasUsed)
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/feemarket/parameters",
+		queryHandlerFn(cliCtx, types.QueryParameters),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/feemarket/base-fee",
+		queryHandlerFn(cliCtx, types.QueryBaseFee),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/feemarket/block-gas-used",
+		queryHandlerFn(cliCtx, types.QueryBlockGasUsed),
+	).Methods("GET")
+}
+
+func queryHandlerFn(cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, endpoint)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -0,0 +1,20 @@
+package feemarket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis new fee market genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetBaseFee(ctx, data.BaseFee)
+	keeper.SetBlockGasUsed(ctx, data.BlockGasUsed)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	params := keeper.GetParams(ctx)
+	baseFee := keeper.GetBaseFee(ctx)
+	blockGasUsed := keeper.GetBlockGasUsed(ctx)
+	return NewGenesisState(params, baseFee, blockGasUsed)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+)
+
+// queryServer implements the fee market gRPC query service on top of a Keeper.
+type queryServer struct {
+	keeper Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the fee market gRPC query
+// service backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{keeper: k}
+}
+
+// BaseFee implements the Query/BaseFee gRPC method.
+func (q queryServer) BaseFee(goCtx context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryBaseFeeResponse{BaseFee: q.keeper.GetBaseFee(ctx)}, nil
+}
+
+// BlockGasUsed implements the Query/BlockGasUsed gRPC method.
+func (q queryServer) BlockGasUsed(goCtx context.Context, req *types.QueryBlockGasUsedRequest) (*types.QueryBlockGasUsedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryBlockGasUsedResponse{BlockGasUsed: q.keeper.GetBlockGasUsed(ctx)}, nil
+}
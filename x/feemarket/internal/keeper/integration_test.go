@@ -0,0 +1,19 @@
+package keeper_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+)
+
+// returns context and an app with updated fee market keeper
+func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(isCheckTx)
+
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
+	app.FeeMarketKeeper.SetParams(ctx, types.DefaultParams())
+
+	return app, ctx
+}
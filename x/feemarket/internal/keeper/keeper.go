@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Keeper of the fee market store
+type Keeper struct {
+	cdc              *codec.Codec
+	storeKey         sdk.StoreKey
+	paramSpace       params.Subspace
+	supplyKeeper     types.SupplyKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates a new fee market Keeper instance
+func NewKeeper(
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
+	supplyKeeper types.SupplyKeeper, feeCollectorName string) Keeper {
+
+	// ensure fee market module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the fee market module account has not been set")
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
+		supplyKeeper:     supplyKeeper,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+//______________________________________________________________________
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetBaseFee returns the base fee of the transactions of the current block.
+// It is empty if the fee market is disabled.
+func (k Keeper) GetBaseFee(ctx sdk.Context) (baseFee sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.BaseFeeKey)
+	if b == nil {
+		return sdk.DecCoins{}
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &baseFee)
+	return
+}
+
+// SetBaseFee sets the base fee of the transactions of the next block.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(baseFee)
+	store.Set(types.BaseFeeKey, b)
+}
+
+// GetBlockGasUsed returns the gas used by the last block.
+func (k Keeper) GetBlockGasUsed(ctx sdk.Context) (gasUsed uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.BlockGasUsedKey)
+	if b == nil {
+		return 0
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &gasUsed)
+	return
+}
+
+// SetBlockGasUsed sets the gas used by the last block.
+func (k Keeper) SetBlockGasUsed(ctx sdk.Context, gasUsed uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(gasUsed)
+	store.Set(types.BlockGasUsedKey, b)
+}
+
+//______________________________________________________________________
+
+// GetParams returns the total set of fee market parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of fee market parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+//______________________________________________________________________
+
+// HandleBaseFee burns the base fee paid by a transaction, which the ante
+// handler already sent to the fee collector, if the base fee is burnt.
+// Otherwise the base fee is left in the fee collector and distributed with
+// the rest of the fees.
+func (k Keeper) HandleBaseFee(ctx sdk.Context, fees sdk.Coins) error {
+	if fees.IsZero() || !k.GetParams(ctx).BurnBaseFee {
+		return nil
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, fees); err != nil {
+		return err
+	}
+
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, fees); err != nil {
+		return err
+	}
+
+	return nil
+}
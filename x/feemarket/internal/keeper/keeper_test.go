@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+)
+
+func TestHandleBaseFee(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40))
+
+	err := app.SupplyKeeper.MintCoins(ctx, mint.ModuleName, fees)
+	require.NoError(t, err)
+	err = app.SupplyKeeper.SendCoinsFromModuleToModule(ctx, mint.ModuleName, auth.FeeCollectorName, fees)
+	require.NoError(t, err)
+
+	feeCollector := func() sdk.Coins {
+		return app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins()
+	}
+	supply := func() sdk.Int {
+		return app.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultBondDenom)
+	}
+	totalSupply := supply()
+
+	// the base fee is left to be distributed if it is not burnt
+	params := types.DefaultParams()
+	params.BurnBaseFee = false
+	app.FeeMarketKeeper.SetParams(ctx, params)
+
+	require.NoError(t, app.FeeMarketKeeper.HandleBaseFee(ctx, baseFee))
+	require.Equal(t, fees, feeCollector())
+	require.Equal(t, totalSupply, supply())
+
+	// the base fee is burnt from the fee collector
+	params.BurnBaseFee = true
+	app.FeeMarketKeeper.SetParams(ctx, params)
+
+	require.NoError(t, app.FeeMarketKeeper.HandleBaseFee(ctx, baseFee))
+	require.Equal(t, fees.Sub(baseFee), feeCollector())
+	require.Equal(t, totalSupply.Sub(baseFee.AmountOf(sdk.DefaultBondDenom)), supply())
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+)
+
+// NewQuerier returns a fee market Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+
+		case types.QueryBaseFee:
+			return queryBaseFee(ctx, k)
+
+		case types.QueryBlockGasUsed:
+			return queryBlockGasUsed(ctx, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown fee market query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, params)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryBaseFee(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	baseFee := k.GetBaseFee(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, baseFee)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryBlockGasUsed(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	gasUsed := k.GetBlockGasUsed(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, gasUsed)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
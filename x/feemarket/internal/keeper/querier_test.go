@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keep "github.com/cosmos/cosmos-sdk/x/feemarket/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestNewQuerier(t *testing.T) {
+	app, ctx := createTestApp(true)
+	querier := keep.NewQuerier(app.FeeMarketKeeper)
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: []byte{},
+	}
+
+	_, err := querier(ctx, []string{types.QueryParameters}, query)
+	require.NoError(t, err)
+
+	_, err = querier(ctx, []string{types.QueryBaseFee}, query)
+	require.NoError(t, err)
+
+	_, err = querier(ctx, []string{types.QueryBlockGasUsed}, query)
+	require.NoError(t, err)
+
+	_, err = querier(ctx, []string{"foo"}, query)
+	require.Error(t, err)
+}
+
+func TestQueryParams(t *testing.T) {
+	app, ctx := createTestApp(true)
+	querier := keep.NewQuerier(app.FeeMarketKeeper)
+
+	var params types.Params
+
+	res, sdkErr := querier(ctx, []string{types.QueryParameters}, abci.RequestQuery{})
+	require.NoError(t, sdkErr)
+
+	err := app.Codec().UnmarshalJSON(res, &params)
+	require.NoError(t, err)
+
+	require.Equal(t, app.FeeMarketKeeper.GetParams(ctx), params)
+}
+
+func TestQueryBaseFee(t *testing.T) {
+	app, ctx := createTestApp(true)
+	querier := keep.NewQuerier(app.FeeMarketKeeper)
+
+	baseFee := sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 10)}
+	app.FeeMarketKeeper.SetBaseFee(ctx, baseFee)
+
+	var res sdk.DecCoins
+
+	bz, sdkErr := querier(ctx, []string{types.QueryBaseFee}, abci.RequestQuery{})
+	require.NoError(t, sdkErr)
+
+	err := app.Codec().UnmarshalJSON(bz, &res)
+	require.NoError(t, err)
+
+	require.Equal(t, baseFee, res)
+}
+
+func TestQueryBlockGasUsed(t *testing.T) {
+	app, ctx := createTestApp(true)
+	querier := keep.NewQuerier(app.FeeMarketKeeper)
+
+	app.FeeMarketKeeper.SetBlockGasUsed(ctx, 100000)
+
+	var gasUsed uint64
+
+	res, sdkErr := querier(ctx, []string{types.QueryBlockGasUsed}, abci.RequestQuery{})
+	require.NoError(t, sdkErr)
+
+	err := app.Codec().UnmarshalJSON(res, &gasUsed)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(100000), gasUsed)
+}
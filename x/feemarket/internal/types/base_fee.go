@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseFee returns the base fee of the next block given the base fee and
+// the gas used by the current block, and the block gas limit.
+//
+// The base fee moves towards the price at which blocks use the target gas,
+// the block gas limit divided by the elasticity multiplier: it increases when
+// blocks use more gas than the target and decreases when they use less, by at
+// most 1/BaseFeeChangeDenominator of its value per block. It never falls
+// below the min base fee. The base fee is left unchanged, apart from the min
+// base fee, if the block gas is unlimited.
+func (p Params) NextBaseFee(baseFee sdk.DecCoins, blockGasUsed, maxBlockGas uint64) sdk.DecCoins {
+	targetGas := maxBlockGas / uint64(p.ElasticityMultiplier)
+	if targetGas > 0 {
+		target := sdk.NewDec(int64(targetGas))
+		used := sdk.NewDec(int64(blockGasUsed))
+
+		// baseFee * (1 + (used - target) / target / denominator)
+		change := used.Sub(target).Quo(target).QuoInt64(int64(p.BaseFeeChangeDenominator))
+		baseFee = baseFee.MulDec(sdk.OneDec().Add(change))
+	}
+
+	// raise the base fee of every denomination to the min base fee
+	var missing sdk.DecCoins
+	for _, minFee := range p.MinBaseFee {
+		if amount := baseFee.AmountOf(minFee.Denom); amount.LT(minFee.Amount) {
+			missing = append(missing, sdk.NewDecCoinFromDec(minFee.Denom, minFee.Amount.Sub(amount)))
+		}
+	}
+
+	return baseFee.Add(missing)
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := DefaultParams()
+
+	decCoins := func(denom string, amount sdk.Dec) sdk.DecCoins {
+		return sdk.DecCoins{sdk.NewDecCoinFromDec(denom, amount)}
+	}
+	baseFee := decCoins("stake", sdk.NewDec(100))
+
+	tests := []struct {
+		name                      string
+		minBaseFee, baseFee, exp  sdk.DecCoins
+		blockGasUsed, maxBlockGas uint64
+	}{
+		// the target gas is half the block gas limit
+		{"target gas used", nil, baseFee, baseFee, 500, 1000},
+		{"full block", nil, baseFee, decCoins("stake", sdk.NewDecWithPrec(1125, 1)), 1000, 1000},
+		{"empty block", nil, baseFee, decCoins("stake", sdk.NewDecWithPrec(875, 1)), 0, 1000},
+		{"three quarters", nil, baseFee, decCoins("stake", sdk.NewDecWithPrec(10625, 2)), 750, 1000},
+
+		// the base fee is left unchanged if the block gas is unlimited
+		{"unlimited block gas", nil, baseFee, baseFee, 1000, 0},
+
+		// the base fee never falls below the min base fee
+		{"min base fee", decCoins("stake", sdk.NewDec(95)), baseFee, decCoins("stake", sdk.NewDec(95)), 0, 1000},
+		{"min base fee of missing denom",
+			sdk.DecCoins{sdk.NewInt64DecCoin("atom", 1), sdk.NewInt64DecCoin("stake", 95)},
+			sdk.DecCoins{},
+			sdk.DecCoins{sdk.NewInt64DecCoin("atom", 1), sdk.NewInt64DecCoin("stake", 95)},
+			1000, 1000,
+		},
+	}
+
+	for _, tc := range tests {
+		params.MinBaseFee = tc.minBaseFee
+
+		next := params.NextBaseFee(tc.baseFee, tc.blockGasUsed, tc.maxBlockGas)
+		require.Equal(t, tc.exp, next, tc.name)
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	genState := DefaultGenesisState()
+	genState.Params.ElasticityMultiplier = 0
+	require.Error(t, ValidateGenesis(genState))
+
+	genState = DefaultGenesisState()
+	genState.BaseFee = sdk.DecCoins{{Denom: "stake", Amount: sdk.NewDec(-1)}}
+	require.Error(t, ValidateGenesis(genState))
+}
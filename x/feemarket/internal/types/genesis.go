@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - fee market state
+type GenesisState struct {
+	Params       Params       `json:"params" yaml:"params"`                 // fee market params
+	BaseFee      sdk.DecCoins `json:"base_fee" yaml:"base_fee"`             // base fee of the next block
+	BlockGasUsed uint64       `json:"block_gas_used" yaml:"block_gas_used"` // gas used by the last block
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseFee sdk.DecCoins, blockGasUsed uint64) GenesisState {
+	return GenesisState{
+		Params:       params,
+		BaseFee:      baseFee,
+		BlockGasUsed: blockGasUsed,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:  DefaultParams(),
+		BaseFee: sdk.DecCoins{},
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if !data.BaseFee.IsValid() {
+		return fmt.Errorf("invalid base fee: %s", data.BaseFee)
+	}
+
+	return nil
+}
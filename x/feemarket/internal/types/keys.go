@@ -0,0 +1,27 @@
+package types
+
+// Keys for the fee market store
+var (
+	BaseFeeKey      = []byte{0x00} // key for the current base fee
+	BlockGasUsedKey = []byte{0x01} // key for the gas used by the last block
+)
+
+// nolint
+const (
+	// module name
+	ModuleName = "feemarket"
+
+	// default paramspace for params keeper
+	DefaultParamspace = ModuleName
+
+	// StoreKey is the default store key for the fee market
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the fee market store.
+	QuerierRoute = StoreKey
+
+	// Query endpoints supported by the fee market querier
+	QueryParameters   = "parameters"
+	QueryBaseFee      = "base_fee"
+	QueryBlockGasUsed = "block_gas_used"
+)
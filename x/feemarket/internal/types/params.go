@@ -0,0 +1,152 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter store keys
+var (
+	KeyEnabled                  = []byte("Enabled")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyElasticityMultiplier     = []byte("ElasticityMultiplier")
+	KeyMinBaseFee               = []byte("MinBaseFee")
+	KeyBurnBaseFee              = []byte("BurnBaseFee")
+)
+
+// fee market parameters
+type Params struct {
+	Enabled                  bool         `json:"enabled" yaml:"enabled"`                                         // whether the base fee is enforced
+	BaseFeeChangeDenominator uint32       `json:"base_fee_change_denominator" yaml:"base_fee_change_denominator"` // bounds the change of the base fee between blocks
+	ElasticityMultiplier     uint32       `json:"elasticity_multiplier" yaml:"elasticity_multiplier"`             // ratio of the block gas limit to the target block gas
+	MinBaseFee               sdk.DecCoins `json:"min_base_fee" yaml:"min_base_fee"`                               // lowest base fee
+	BurnBaseFee              bool         `json:"burn_base_fee" yaml:"burn_base_fee"`                             // burn the base fee instead of distributing it
+}
+
+// ParamTable for fee market module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(
+	enabled bool, baseFeeChangeDenominator, elasticityMultiplier uint32, minBaseFee sdk.DecCoins, burnBaseFee bool,
+) Params {
+
+	return Params{
+		Enabled:                  enabled,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+		MinBaseFee:               minBaseFee,
+		BurnBaseFee:              burnBaseFee,
+	}
+}
+
+// default fee market module parameters
+func DefaultParams() Params {
+	return Params{
+		Enabled:                  false,
+		BaseFeeChangeDenominator: 8,
+		ElasticityMultiplier:     2,
+		MinBaseFee:               sdk.DecCoins{},
+		BurnBaseFee:              true,
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateEnabled(p.Enabled); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+	if err := validateElasticityMultiplier(p.ElasticityMultiplier); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+
+	return validateBurnBaseFee(p.BurnBaseFee)
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Fee Market Params:
+  Enabled:                      %t
+  Base Fee Change Denominator:  %d
+  Elasticity Multiplier:        %d
+  Min Base Fee:                 %s
+  Burn Base Fee:                %t
+`,
+		p.Enabled, p.BaseFeeChangeDenominator, p.ElasticityMultiplier,
+		p.MinBaseFee, p.BurnBaseFee,
+	)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		params.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		params.NewParamSetPair(KeyElasticityMultiplier, &p.ElasticityMultiplier, validateElasticityMultiplier),
+		params.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		params.NewParamSetPair(KeyBurnBaseFee, &p.BurnBaseFee, validateBurnBaseFee),
+	}
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("base fee change denominator must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateElasticityMultiplier(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("elasticity multiplier must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid min base fee: %s", v)
+	}
+
+	return nil
+}
+
+func validateBurnBaseFee(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding fee market type
+func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key, types.BaseFeeKey):
+		var baseFeeA, baseFeeB sdk.DecCoins
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &baseFeeA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &baseFeeB)
+		return fmt.Sprintf("%v\n%v", baseFeeA, baseFeeB)
+
+	case bytes.Equal(kvA.Key, types.BlockGasUsedKey):
+		var gasUsedA, gasUsedB uint64
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &gasUsedA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &gasUsedB)
+		return fmt.Sprintf("%v\n%v", gasUsedA, gasUsedB)
+
+	default:
+		panic(fmt.Sprintf("invalid fee market key %X", kvA.Key))
+	}
+}
@@ -0,0 +1,52 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+)
+
+func makeTestCodec() (cdc *codec.Codec) {
+	cdc = codec.New()
+	sdk.RegisterCodec(cdc)
+	return
+}
+
+func TestDecodeStore(t *testing.T) {
+	cdc := makeTestCodec()
+	baseFee := sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	gasUsed := uint64(100000)
+
+	kvPairs := cmn.KVPairs{
+		cmn.KVPair{Key: types.BaseFeeKey, Value: cdc.MustMarshalBinaryLengthPrefixed(baseFee)},
+		cmn.KVPair{Key: types.BlockGasUsedKey, Value: cdc.MustMarshalBinaryLengthPrefixed(gasUsed)},
+		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"BaseFee", fmt.Sprintf("%v\n%v", baseFee, baseFee)},
+		{"BlockGasUsed", fmt.Sprintf("%v\n%v", gasUsed, gasUsed)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { DecodeStore(cdc, kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
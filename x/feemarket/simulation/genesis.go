@@ -0,0 +1,77 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+)
+
+// Simulation parameter constants
+const (
+	Enabled                  = "enabled"
+	BaseFeeChangeDenominator = "base_fee_change_denominator"
+	ElasticityMultiplier     = "elasticity_multiplier"
+	BurnBaseFee              = "burn_base_fee"
+)
+
+// GenEnabled randomized Enabled
+func GenEnabled(r *rand.Rand) bool {
+	return r.Int63n(2) == 0
+}
+
+// GenBaseFeeChangeDenominator randomized BaseFeeChangeDenominator
+func GenBaseFeeChangeDenominator(r *rand.Rand) uint32 {
+	return uint32(r.Intn(16) + 1)
+}
+
+// GenElasticityMultiplier randomized ElasticityMultiplier
+func GenElasticityMultiplier(r *rand.Rand) uint32 {
+	return uint32(r.Intn(4) + 1)
+}
+
+// GenBurnBaseFee randomized BurnBaseFee
+func GenBurnBaseFee(r *rand.Rand) bool {
+	return r.Int63n(2) == 0
+}
+
+// RandomizedGenState generates a random GenesisState for the fee market. The
+// min base fee is left empty, so that the base fee never rejects the randomly
+// chosen fees of the simulated transactions.
+func RandomizedGenState(simState *module.SimulationState) {
+	var enabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Enabled, &enabled, simState.Rand,
+		func(r *rand.Rand) { enabled = GenEnabled(r) },
+	)
+
+	var baseFeeChangeDenominator uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BaseFeeChangeDenominator, &baseFeeChangeDenominator, simState.Rand,
+		func(r *rand.Rand) { baseFeeChangeDenominator = GenBaseFeeChangeDenominator(r) },
+	)
+
+	var elasticityMultiplier uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ElasticityMultiplier, &elasticityMultiplier, simState.Rand,
+		func(r *rand.Rand) { elasticityMultiplier = GenElasticityMultiplier(r) },
+	)
+
+	var burnBaseFee bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BurnBaseFee, &burnBaseFee, simState.Rand,
+		func(r *rand.Rand) { burnBaseFee = GenBurnBaseFee(r) },
+	)
+
+	params := types.NewParams(enabled, baseFeeChangeDenominator, elasticityMultiplier, sdk.DecCoins{}, burnBaseFee)
+	feeMarketGenesis := types.NewGenesisState(params, sdk.DecCoins{}, 0)
+
+	fmt.Printf("Selected randomly generated fee market parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, feeMarketGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feeMarketGenesis)
+}
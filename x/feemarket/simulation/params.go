@@ -0,0 +1,33 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/feemarket/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+const (
+	keyBaseFeeChangeDenominator = "BaseFeeChangeDenominator"
+	keyElasticityMultiplier     = "ElasticityMultiplier"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, keyBaseFeeChangeDenominator,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenBaseFeeChangeDenominator(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyElasticityMultiplier,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenElasticityMultiplier(r))
+			},
+		),
+	}
+}
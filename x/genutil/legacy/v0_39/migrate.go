@@ -0,0 +1,52 @@
+package v039
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	v038auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_38"
+	v039auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_39"
+	v038bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_38"
+	v039bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_39"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	v038staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_38"
+	v039staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_39"
+)
+
+// Migrate migrates exported state from v0.38 to a v0.39 genesis state.
+func Migrate(appState genutil.AppMap) genutil.AppMap {
+	v038Codec := codec.New()
+	codec.RegisterCrypto(v038Codec)
+	v038auth.RegisterCodec(v038Codec)
+
+	v039Codec := codec.New()
+	codec.RegisterCrypto(v039Codec)
+	v039auth.RegisterCodec(v039Codec)
+
+	// migrate auth state
+	if appState[v038auth.ModuleName] != nil {
+		var authGenState v038auth.GenesisState
+		v038Codec.MustUnmarshalJSON(appState[v038auth.ModuleName], &authGenState)
+
+		delete(appState, v038auth.ModuleName) // delete old key in case the name changed
+		appState[v039auth.ModuleName] = v039Codec.MustMarshalJSON(v039auth.Migrate(authGenState))
+	}
+
+	// migrate bank state
+	if appState[v038bank.ModuleName] != nil {
+		var bankGenState v038bank.GenesisState
+		v038Codec.MustUnmarshalJSON(appState[v038bank.ModuleName], &bankGenState)
+
+		delete(appState, v038bank.ModuleName) // delete old key in case the name changed
+		appState[v039bank.ModuleName] = v039Codec.MustMarshalJSON(v039bank.Migrate(bankGenState))
+	}
+
+	// migrate staking state
+	if appState[v038staking.ModuleName] != nil {
+		var stakingGenState v038staking.GenesisState
+		v038Codec.MustUnmarshalJSON(appState[v038staking.ModuleName], &stakingGenState)
+
+		delete(appState, v038staking.ModuleName) // delete old key in case the name changed
+		appState[v039staking.ModuleName] = v039Codec.MustMarshalJSON(v039staking.Migrate(stakingGenState))
+	}
+
+	return appState
+}
@@ -0,0 +1,104 @@
+package v039_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v038auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_38"
+	v039auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_39"
+	v038bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_38"
+	v039bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_39"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	v039 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v0_39"
+	v038staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_38"
+	v039staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_39"
+)
+
+var genBankState = []byte(`{
+  "send_enabled": true
+}`)
+
+var genAuthState = []byte(`{
+  "params": {
+    "max_memo_characters": "10",
+    "tx_sig_limit": "10",
+    "tx_size_cost_per_byte": "10",
+    "sig_verify_cost_ed25519": "10",
+    "sig_verify_cost_secp256k1": "10"
+  },
+  "accounts": [
+    {
+      "type": "cosmos-sdk/PeriodicVestingAccount",
+      "value": {
+        "BaseVestingAccount": {
+          "BaseAccount": {
+            "address": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh",
+            "coins": [{"denom": "stake", "amount": "100"}],
+            "public_key": null,
+            "account_number": "1",
+            "sequence": "0"
+          },
+          "original_vesting": [{"denom": "stake", "amount": "100"}],
+          "delegated_free": [],
+          "delegated_vesting": [],
+          "end_time": "1600000100"
+        },
+        "start_time": "1600000000",
+        "vesting_periods": [{"length": "100", "amount": [{"denom": "stake", "amount": "100"}]}]
+      }
+    }
+  ]
+}`)
+
+var genStakingState = []byte(`{
+  "params": {
+    "unbonding_time": "1814400000000000",
+    "max_validators": 100,
+    "max_entries": 7,
+    "bond_denom": "stake"
+  },
+  "last_total_power": "0",
+  "last_validator_powers": null,
+  "validators": null,
+  "delegations": null,
+  "unbonding_delegations": null,
+  "redelegations": null,
+  "exported": false
+}`)
+
+func TestMigrate(t *testing.T) {
+	genesis := genutil.AppMap{
+		v038bank.ModuleName:    genBankState,
+		v038auth.ModuleName:    genAuthState,
+		v038staking.ModuleName: genStakingState,
+	}
+
+	var migrated genutil.AppMap
+	require.NotPanics(t, func() { migrated = v039.Migrate(genesis) })
+	require.JSONEq(t,
+		`{"params":{"send_enabled":[],"default_send_enabled":true},"denom_metadata":[]}`,
+		string(migrated[v039bank.ModuleName]),
+	)
+	require.JSONEq(t, string(genAuthState), string(migrated[v039auth.ModuleName]))
+	require.JSONEq(t,
+		`{
+  "params": {
+    "unbonding_time": "1814400000000000",
+    "max_validators": 100,
+    "max_entries": 7,
+    "historical_entries": 100,
+    "bond_denom": "stake",
+    "min_commission_rate": "0.000000000000000000"
+  },
+  "last_total_power": "0",
+  "last_validator_powers": null,
+  "validators": null,
+  "delegations": null,
+  "unbonding_delegations": null,
+  "redelegations": null,
+  "exported": false
+}`,
+		string(migrated[v039staking.ModuleName]),
+	)
+}
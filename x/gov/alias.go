@@ -125,6 +125,10 @@ var (
 	NewVote                       = types.NewVote
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
+	NewQueryServer                = keeper.NewQueryServer
+	RegisterQueryService          = types.RegisterQueryService
+	NewQueryClient                = types.NewQueryClient
+	NewProposalProto              = types.NewProposalProto
 
 	// variable aliases
 	ModuleCdc                   = types.ModuleCdc
@@ -140,31 +144,47 @@ var (
 )
 
 type (
-	Keeper               = keeper.Keeper
-	Content              = types.Content
-	Handler              = types.Handler
-	Deposit              = types.Deposit
-	Deposits             = types.Deposits
-	GenesisState         = types.GenesisState
-	MsgSubmitProposal    = types.MsgSubmitProposal
-	MsgDeposit           = types.MsgDeposit
-	MsgVote              = types.MsgVote
-	DepositParams        = types.DepositParams
-	TallyParams          = types.TallyParams
-	VotingParams         = types.VotingParams
-	Params               = types.Params
-	Proposal             = types.Proposal
-	Proposals            = types.Proposals
-	ProposalQueue        = types.ProposalQueue
-	ProposalStatus       = types.ProposalStatus
-	TextProposal         = types.TextProposal
-	QueryProposalParams  = types.QueryProposalParams
-	QueryDepositParams   = types.QueryDepositParams
-	QueryVoteParams      = types.QueryVoteParams
-	QueryProposalsParams = types.QueryProposalsParams
-	ValidatorGovInfo     = types.ValidatorGovInfo
-	TallyResult          = types.TallyResult
-	Vote                 = types.Vote
-	Votes                = types.Votes
-	VoteOption           = types.VoteOption
+	Keeper                   = keeper.Keeper
+	Content                  = types.Content
+	Handler                  = types.Handler
+	Deposit                  = types.Deposit
+	Deposits                 = types.Deposits
+	GenesisState             = types.GenesisState
+	MsgSubmitProposal        = types.MsgSubmitProposal
+	MsgDeposit               = types.MsgDeposit
+	MsgVote                  = types.MsgVote
+	DepositParams            = types.DepositParams
+	TallyParams              = types.TallyParams
+	VotingParams             = types.VotingParams
+	Params                   = types.Params
+	Proposal                 = types.Proposal
+	Proposals                = types.Proposals
+	ProposalQueue            = types.ProposalQueue
+	ProposalStatus           = types.ProposalStatus
+	TextProposal             = types.TextProposal
+	QueryProposalParams      = types.QueryProposalParams
+	QueryDepositParams       = types.QueryDepositParams
+	QueryVoteParams          = types.QueryVoteParams
+	QueryProposalsParams     = types.QueryProposalsParams
+	ValidatorGovInfo         = types.ValidatorGovInfo
+	TallyResult              = types.TallyResult
+	Vote                     = types.Vote
+	Votes                    = types.Votes
+	VoteOption               = types.VoteOption
+	QueryServer              = types.QueryServer
+	QueryClient              = types.QueryClient
+	QueryProposalRequest     = types.QueryProposalRequest
+	QueryProposalResponse    = types.QueryProposalResponse
+	QueryProposalsRequest    = types.QueryProposalsRequest
+	QueryProposalsResponse   = types.QueryProposalsResponse
+	QueryVoteRequest         = types.QueryVoteRequest
+	QueryVoteResponse        = types.QueryVoteResponse
+	QueryVotesRequest        = types.QueryVotesRequest
+	QueryVotesResponse       = types.QueryVotesResponse
+	QueryDepositRequest      = types.QueryDepositRequest
+	QueryDepositResponse     = types.QueryDepositResponse
+	QueryDepositsRequest     = types.QueryDepositsRequest
+	QueryDepositsResponse    = types.QueryDepositsResponse
+	QueryTallyResultRequest  = types.QueryTallyResultRequest
+	QueryTallyResultResponse = types.QueryTallyResultResponse
 )
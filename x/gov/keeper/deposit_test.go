@@ -38,7 +38,7 @@ func TestDeposits(t *testing.T) {
 	require.False(t, votingStarted)
 	deposit, found = keeper.GetDeposit(ctx, proposalID, TestAddrs[0])
 	require.True(t, found)
-	require.Equal(t, fourStake, deposit.Amount)
+	require.Equal(t, fourStake, sdk.Coins(deposit.Amount))
 	require.Equal(t, TestAddrs[0], deposit.Depositor)
 	proposal, ok = keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -51,7 +51,7 @@ func TestDeposits(t *testing.T) {
 	require.False(t, votingStarted)
 	deposit, found = keeper.GetDeposit(ctx, proposalID, TestAddrs[0])
 	require.True(t, found)
-	require.Equal(t, fourStake.Add(fiveStake), deposit.Amount)
+	require.Equal(t, fourStake.Add(fiveStake), sdk.Coins(deposit.Amount))
 	require.Equal(t, TestAddrs[0], deposit.Depositor)
 	proposal, ok = keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -65,7 +65,7 @@ func TestDeposits(t *testing.T) {
 	deposit, found = keeper.GetDeposit(ctx, proposalID, TestAddrs[1])
 	require.True(t, found)
 	require.Equal(t, TestAddrs[1], deposit.Depositor)
-	require.Equal(t, fourStake, deposit.Amount)
+	require.Equal(t, fourStake, sdk.Coins(deposit.Amount))
 	proposal, ok = keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
 	require.Equal(t, fourStake.Add(fiveStake).Add(fourStake), proposal.TotalDeposit)
@@ -82,14 +82,14 @@ func TestDeposits(t *testing.T) {
 	require.Len(t, deposits, 2)
 	require.Equal(t, deposits, keeper.GetDeposits(ctx, proposalID))
 	require.Equal(t, TestAddrs[0], deposits[0].Depositor)
-	require.Equal(t, fourStake.Add(fiveStake), deposits[0].Amount)
+	require.Equal(t, fourStake.Add(fiveStake), sdk.Coins(deposits[0].Amount))
 	require.Equal(t, TestAddrs[1], deposits[1].Depositor)
-	require.Equal(t, fourStake, deposits[1].Amount)
+	require.Equal(t, fourStake, sdk.Coins(deposits[1].Amount))
 
 	// Test Refund Deposits
 	deposit, found = keeper.GetDeposit(ctx, proposalID, TestAddrs[1])
 	require.True(t, found)
-	require.Equal(t, fourStake, deposit.Amount)
+	require.Equal(t, fourStake, sdk.Coins(deposit.Amount))
 	keeper.RefundDeposits(ctx, proposalID)
 	deposit, found = keeper.GetDeposit(ctx, proposalID, TestAddrs[1])
 	require.False(t, found)
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// queryServer implements the gov gRPC query service on top of a Keeper.
+type queryServer struct {
+	keeper Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the gov gRPC query service
+// backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{keeper: k}
+}
+
+// Proposal implements the Query/Proposal gRPC method.
+func (q queryServer) Proposal(goCtx context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposal, found := q.keeper.GetProposal(ctx, req.ProposalID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalID)
+	}
+
+	pp, err := types.NewProposalProto(proposal)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposalResponse{Proposal: pp}, nil
+}
+
+// Proposals implements the Query/Proposals gRPC method.
+func (q queryServer) Proposals(goCtx context.Context, req *types.QueryProposalsRequest) (*types.QueryProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	page := int(req.Page)
+	if page == 0 {
+		page = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := types.NewQueryProposalsParams(page, int(req.Limit), req.ProposalStatus, req.Voter, req.Depositor)
+	proposals := q.keeper.GetProposalsFiltered(ctx, params)
+
+	pps := make([]types.ProposalProto, len(proposals))
+	for i, proposal := range proposals {
+		pp, err := types.NewProposalProto(proposal)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		pps[i] = pp
+	}
+
+	return &types.QueryProposalsResponse{Proposals: pps}, nil
+}
+
+// Vote implements the Query/Vote gRPC method.
+func (q queryServer) Vote(goCtx context.Context, req *types.QueryVoteRequest) (*types.QueryVoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Voter.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "voter address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	vote, found := q.keeper.GetVote(ctx, req.ProposalID, req.Voter)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "voter %s has not voted on proposal %d", req.Voter, req.ProposalID)
+	}
+
+	return &types.QueryVoteResponse{Vote: vote}, nil
+}
+
+// Votes implements the Query/Votes gRPC method.
+func (q queryServer) Votes(goCtx context.Context, req *types.QueryVotesRequest) (*types.QueryVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryVotesResponse{Votes: q.keeper.GetVotes(ctx, req.ProposalID)}, nil
+}
+
+// Deposit implements the Query/Deposit gRPC method.
+func (q queryServer) Deposit(goCtx context.Context, req *types.QueryDepositRequest) (*types.QueryDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Depositor.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "depositor address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	deposit, found := q.keeper.GetDeposit(ctx, req.ProposalID, req.Depositor)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound, "depositor %s has not deposited on proposal %d", req.Depositor, req.ProposalID,
+		)
+	}
+
+	return &types.QueryDepositResponse{Deposit: deposit}, nil
+}
+
+// Deposits implements the Query/Deposits gRPC method.
+func (q queryServer) Deposits(goCtx context.Context, req *types.QueryDepositsRequest) (*types.QueryDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryDepositsResponse{Deposits: q.keeper.GetDeposits(ctx, req.ProposalID)}, nil
+}
+
+// TallyResult implements the Query/TallyResult gRPC method.
+func (q queryServer) TallyResult(goCtx context.Context, req *types.QueryTallyResultRequest) (*types.QueryTallyResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposal, found := q.keeper.GetProposal(ctx, req.ProposalID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalID)
+	}
+
+	return &types.QueryTallyResultResponse{Tally: q.keeper.currentTally(ctx, proposal)}, nil
+}
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// setupGRPCQueryTest submits two proposals, deposits on the first one from
+// TestAddrs[0] and moves it to its voting period with a vote from
+// TestAddrs[1].
+func setupGRPCQueryTest(t *testing.T) (sdk.Context, Keeper, types.QueryServer) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	_, err = keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4)))
+	err, _ = keeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], fourStake)
+	require.NoError(t, err)
+
+	proposal, _ = keeper.GetProposal(ctx, proposal.ProposalID)
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+	require.NoError(t, keeper.AddVote(ctx, proposal.ProposalID, TestAddrs[1], types.OptionYes))
+
+	return ctx, keeper, NewQueryServer(keeper)
+}
+
+func TestGRPCQueryProposal(t *testing.T) {
+	ctx, _, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryProposalRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"unknown proposal", &types.QueryProposalRequest{ProposalID: 10}, codes.NotFound},
+		{"existing proposal", &types.QueryProposalRequest{ProposalID: 1}, codes.OK},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.Proposal(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Equal(t, uint64(1), res.Proposal.ProposalID, tc.msg)
+			require.Equal(t, types.StatusVotingPeriod, res.Proposal.Status, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryProposals(t *testing.T) {
+	ctx, _, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryProposalsRequest
+		expCode codes.Code
+		expLen  int
+	}{
+		{"nil request", nil, codes.InvalidArgument, 0},
+		{"all proposals", &types.QueryProposalsRequest{}, codes.OK, 2},
+		{"by status", &types.QueryProposalsRequest{ProposalStatus: types.StatusDepositPeriod}, codes.OK, 1},
+		{"by voter", &types.QueryProposalsRequest{Voter: TestAddrs[1]}, codes.OK, 1},
+		{"by depositor", &types.QueryProposalsRequest{Depositor: TestAddrs[0]}, codes.OK, 1},
+		{"unknown depositor", &types.QueryProposalsRequest{Depositor: TestAddrs[2]}, codes.OK, 0},
+		{"first page", &types.QueryProposalsRequest{Page: 1, Limit: 1}, codes.OK, 1},
+		{"page out of bounds", &types.QueryProposalsRequest{Page: 3, Limit: 1}, codes.OK, 0},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.Proposals(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Len(t, res.Proposals, tc.expLen, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryVote(t *testing.T) {
+	ctx, _, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryVoteRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"empty voter", &types.QueryVoteRequest{ProposalID: 1}, codes.InvalidArgument},
+		{"unknown vote", &types.QueryVoteRequest{ProposalID: 1, Voter: TestAddrs[0]}, codes.NotFound},
+		{"existing vote", &types.QueryVoteRequest{ProposalID: 1, Voter: TestAddrs[1]}, codes.OK},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.Vote(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Equal(t, types.NewVote(1, TestAddrs[1], types.OptionYes), res.Vote, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryVotes(t *testing.T) {
+	ctx, _, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryVotesRequest
+		expCode codes.Code
+		expLen  int
+	}{
+		{"nil request", nil, codes.InvalidArgument, 0},
+		{"no votes", &types.QueryVotesRequest{ProposalID: 2}, codes.OK, 0},
+		{"existing votes", &types.QueryVotesRequest{ProposalID: 1}, codes.OK, 1},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.Votes(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Len(t, res.Votes, tc.expLen, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryDeposit(t *testing.T) {
+	ctx, keeper, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryDepositRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"empty depositor", &types.QueryDepositRequest{ProposalID: 1}, codes.InvalidArgument},
+		{"unknown deposit", &types.QueryDepositRequest{ProposalID: 1, Depositor: TestAddrs[1]}, codes.NotFound},
+		{"existing deposit", &types.QueryDepositRequest{ProposalID: 1, Depositor: TestAddrs[0]}, codes.OK},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.Deposit(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			deposit, found := keeper.GetDeposit(ctx, 1, TestAddrs[0])
+			require.True(t, found)
+			require.Equal(t, deposit, res.Deposit, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryDeposits(t *testing.T) {
+	ctx, _, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryDepositsRequest
+		expCode codes.Code
+		expLen  int
+	}{
+		{"nil request", nil, codes.InvalidArgument, 0},
+		{"no deposits", &types.QueryDepositsRequest{ProposalID: 2}, codes.OK, 0},
+		{"existing deposits", &types.QueryDepositsRequest{ProposalID: 1}, codes.OK, 1},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.Deposits(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Len(t, res.Deposits, tc.expLen, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryTallyResult(t *testing.T) {
+	ctx, _, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryTallyResultRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"unknown proposal", &types.QueryTallyResultRequest{ProposalID: 10}, codes.NotFound},
+		{"existing proposal", &types.QueryTallyResultRequest{ProposalID: 1}, codes.OK},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.TallyResult(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			// the voter has no stake, so the vote doesn't weigh in the tally
+			require.Equal(t, types.EmptyTallyResult(), res.Tally, tc.msg)
+		}
+	}
+}
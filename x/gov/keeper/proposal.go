@@ -54,14 +54,14 @@ func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal t
 	if bz == nil {
 		return
 	}
-	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &proposal)
+	proposal = types.MustUnmarshalProposal(keeper.cdc, bz)
 	return proposal, true
 }
 
 // SetProposal set a proposal to store
 func (keeper Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
 	store := ctx.KVStore(keeper.storeKey)
-	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(proposal)
+	bz := types.MustMarshalProposal(keeper.cdc, proposal)
 	store.Set(types.ProposalKey(proposal.ProposalID), bz)
 }
 
@@ -84,8 +84,7 @@ func (keeper Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.Pr
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var proposal types.Proposal
-		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &proposal)
+		proposal := types.MustUnmarshalProposal(keeper.cdc, iterator.Value())
 
 		if cb(proposal) {
 			break
@@ -5,10 +5,11 @@ import (
 	"testing"
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
-	"github.com/stretchr/testify/require"
 )
 
 func TestGetSetProposal(t *testing.T) {
@@ -49,7 +50,9 @@ func TestActivateVotingPeriod(t *testing.T) {
 	activeIterator.Close()
 }
 
-type validProposal struct{}
+// validProposal embeds TextProposal for its protobuf encoding, which is
+// required to persist proposal content.
+type validProposal struct{ types.TextProposal }
 
 func (validProposal) GetTitle() string         { return "title" }
 func (validProposal) GetDescription() string   { return "description" }
@@ -84,21 +87,19 @@ func (invalidProposalValidation) ValidateBasic() sdk.Error {
 	return sdk.NewError(sdk.CodespaceUndefined, sdk.CodeInternal, "")
 }
 
-func registerTestCodec(cdc *codec.Codec) {
-	cdc.RegisterConcrete(validProposal{}, "test/validproposal", nil)
-	cdc.RegisterConcrete(invalidProposalTitle1{}, "test/invalidproposalt1", nil)
-	cdc.RegisterConcrete(invalidProposalTitle2{}, "test/invalidproposalt2", nil)
-	cdc.RegisterConcrete(invalidProposalDesc1{}, "test/invalidproposald1", nil)
-	cdc.RegisterConcrete(invalidProposalDesc2{}, "test/invalidproposald2", nil)
-	cdc.RegisterConcrete(invalidProposalRoute{}, "test/invalidproposalr", nil)
-	cdc.RegisterConcrete(invalidProposalValidation{}, "test/invalidproposalv", nil)
+func init() {
+	proto.RegisterType((*validProposal)(nil), "test.validproposal")
+	proto.RegisterType((*invalidProposalTitle1)(nil), "test.invalidproposalt1")
+	proto.RegisterType((*invalidProposalTitle2)(nil), "test.invalidproposalt2")
+	proto.RegisterType((*invalidProposalDesc1)(nil), "test.invalidproposald1")
+	proto.RegisterType((*invalidProposalDesc2)(nil), "test.invalidproposald2")
+	proto.RegisterType((*invalidProposalRoute)(nil), "test.invalidproposalr")
+	proto.RegisterType((*invalidProposalValidation)(nil), "test.invalidproposalv")
 }
 
 func TestSubmitProposal(t *testing.T) {
 	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
 
-	registerTestCodec(keeper.cdc)
-
 	testCases := []struct {
 		content     types.Content
 		expectedErr sdk.Error
@@ -156,17 +156,7 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 		return nil, types.ErrUnknownProposal(types.DefaultCodespace, proposalID)
 	}
 
-	var tallyResult types.TallyResult
-
-	switch {
-	case proposal.Status == types.StatusDepositPeriod:
-		tallyResult = types.EmptyTallyResult()
-	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected:
-		tallyResult = proposal.FinalTallyResult
-	default:
-		// proposal is in voting period
-		_, _, tallyResult = keeper.Tally(ctx, proposal)
-	}
+	tallyResult := keeper.currentTally(ctx, proposal)
 
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, tallyResult)
 	if err != nil {
@@ -112,3 +112,19 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 	return false, false, tallyResults
 }
+
+// currentTally returns the tally of a proposal for querying purposes. Proposals
+// in their deposit period have an empty tally, finished proposals have their
+// final tally and proposals in their voting period are tallied on the fly.
+func (keeper Keeper) currentTally(ctx sdk.Context, proposal types.Proposal) types.TallyResult {
+	switch {
+	case proposal.Status == types.StatusDepositPeriod:
+		return types.EmptyTallyResult()
+	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected:
+		return proposal.FinalTallyResult
+	default:
+		// proposal is in voting period
+		_, _, tallyResult := keeper.Tally(ctx, proposal)
+		return tallyResult
+	}
+}
@@ -13,11 +13,12 @@ import (
 
 // DecodeStore unmarshals the KVPair's Value to the corresponding gov type
 func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	appCodec := codec.NewHybridCodec(cdc)
+
 	switch {
 	case bytes.Equal(kvA.Key[:1], types.ProposalsKeyPrefix):
-		var proposalA, proposalB types.Proposal
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &proposalA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &proposalB)
+		proposalA := types.MustUnmarshalProposal(appCodec, kvA.Value)
+		proposalB := types.MustUnmarshalProposal(appCodec, kvB.Value)
 		return fmt.Sprintf("%v\n%v", proposalA, proposalB)
 
 	case bytes.Equal(kvA.Key[:1], types.ActiveProposalQueuePrefix),
@@ -29,14 +30,14 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 
 	case bytes.Equal(kvA.Key[:1], types.DepositsKeyPrefix):
 		var depositA, depositB types.Deposit
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &depositA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &depositB)
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &depositA)
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &depositB)
 		return fmt.Sprintf("%v\n%v", depositA, depositB)
 
 	case bytes.Equal(kvA.Key[:1], types.VotesKeyPrefix):
 		var voteA, voteB types.Vote
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &voteA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &voteB)
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &voteA)
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &voteB)
 		return fmt.Sprintf("%v\n%v", voteA, voteB)
 
 	default:
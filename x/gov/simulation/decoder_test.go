@@ -31,6 +31,7 @@ func makeTestCodec() (cdc *codec.Codec) {
 
 func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
+	appCodec := codec.NewHybridCodec(cdc)
 
 	endTime := time.Now().UTC()
 
@@ -42,10 +43,10 @@ func TestDecodeStore(t *testing.T) {
 	vote := types.NewVote(1, delAddr1, types.OptionYes)
 
 	kvPairs := cmn.KVPairs{
-		cmn.KVPair{Key: types.ProposalKey(1), Value: cdc.MustMarshalBinaryLengthPrefixed(proposal)},
+		cmn.KVPair{Key: types.ProposalKey(1), Value: types.MustMarshalProposal(appCodec, proposal)},
 		cmn.KVPair{Key: types.InactiveProposalQueueKey(1, endTime), Value: proposalIDBz},
-		cmn.KVPair{Key: types.DepositKey(1, delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(deposit)},
-		cmn.KVPair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(vote)},
+		cmn.KVPair{Key: types.DepositKey(1, delAddr1), Value: appCodec.MustMarshalBinaryLengthPrefixed(&deposit)},
+		cmn.KVPair{Key: types.VoteKey(1, delAddr1), Value: appCodec.MustMarshalBinaryLengthPrefixed(&vote)},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
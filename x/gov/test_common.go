@@ -15,6 +15,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -54,6 +55,7 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
 	keyGov := sdk.NewKVStoreKey(types.StoreKey)
 	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
 
 	govAcc := supply.NewEmptyModuleAccount(types.ModuleName, supply.Burner)
 	notBondedPool := supply.NewEmptyModuleAccount(staking.NotBondedPoolName, supply.Burner, supply.Staking)
@@ -69,7 +71,7 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 	rtr := types.NewRouter().
 		AddRoute(types.RouterKey, handler)
 
-	bk := bank.NewBaseKeeper(mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	bk := bank.NewBaseKeeper(mApp.Cdc, keyBank, mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs, blacklistedAddrs)
 
 	maccPerms := map[string][]string{
 		types.ModuleName:          {supply.Burner},
@@ -77,12 +79,13 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 	}
 	supplyKeeper := supply.NewKeeper(mApp.Cdc, keySupply, mApp.AccountKeeper, bk, maccPerms)
+	appCodec := codec.NewHybridCodec(mApp.Cdc)
 	sk := staking.NewKeeper(
-		mApp.Cdc, keyStaking, supplyKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace,
+		appCodec, keyStaking, mApp.AccountKeeper, supplyKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace,
 	)
 
 	keeper := keep.NewKeeper(
-		mApp.Cdc, keyGov, pk.Subspace(DefaultParamspace).WithKeyTable(ParamKeyTable()), supplyKeeper, sk, types.DefaultCodespace, rtr,
+		appCodec, keyGov, pk.Subspace(DefaultParamspace).WithKeyTable(ParamKeyTable()), supplyKeeper, sk, types.DefaultCodespace, rtr,
 	)
 
 	mApp.Router().AddRoute(types.RouterKey, NewHandler(keeper))
@@ -92,7 +95,7 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 	mApp.SetInitChainer(getInitChainer(mApp, keeper, sk, supplyKeeper, genAccs, genState,
 		[]supplyexported.ModuleAccountI{govAcc, notBondedPool, bondPool}))
 
-	require.NoError(t, mApp.CompleteSetup(keyStaking, keyGov, keySupply))
+	require.NoError(t, mApp.CompleteSetup(keyStaking, keyGov, keySupply, keyBank))
 
 	var (
 		addrs    []sdk.AccAddress
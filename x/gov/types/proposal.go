@@ -3,9 +3,14 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -60,6 +65,126 @@ func (p Proposal) String() string {
 	)
 }
 
+// MustMarshalProposal encodes a proposal using its protobuf representation,
+// panicking on failure.
+func MustMarshalProposal(cdc codec.Marshaler, proposal Proposal) []byte {
+	bz, err := MarshalProposal(cdc, proposal)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// MarshalProposal encodes a proposal using its protobuf representation. The
+// proposal content must be a protobuf message.
+func MarshalProposal(cdc codec.Marshaler, proposal Proposal) ([]byte, error) {
+	pp, err := NewProposalProto(proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalBinaryLengthPrefixed(&pp)
+}
+
+// NewProposalProto returns the protobuf representation of a proposal. The
+// proposal content must be a protobuf message.
+func NewProposalProto(proposal Proposal) (ProposalProto, error) {
+	content, err := packContent(proposal.Content)
+	if err != nil {
+		return ProposalProto{}, err
+	}
+
+	return ProposalProto{
+		ProposalID:       proposal.ProposalID,
+		Content:          content,
+		Status:           proposal.Status,
+		FinalTallyResult: proposal.FinalTallyResult,
+		SubmitTime:       proposal.SubmitTime,
+		DepositEndTime:   proposal.DepositEndTime,
+		TotalDeposit:     proposal.TotalDeposit,
+		VotingStartTime:  proposal.VotingStartTime,
+		VotingEndTime:    proposal.VotingEndTime,
+	}, nil
+}
+
+// MustUnmarshalProposal decodes a proposal encoded by MarshalProposal,
+// panicking on failure.
+func MustUnmarshalProposal(cdc codec.Marshaler, value []byte) Proposal {
+	proposal, err := UnmarshalProposal(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return proposal
+}
+
+// UnmarshalProposal decodes a proposal encoded by MarshalProposal.
+func UnmarshalProposal(cdc codec.Marshaler, value []byte) (proposal Proposal, err error) {
+	var pp ProposalProto
+	if err = cdc.UnmarshalBinaryLengthPrefixed(value, &pp); err != nil {
+		return proposal, err
+	}
+
+	content, err := unpackContent(pp.Content)
+	if err != nil {
+		return proposal, err
+	}
+
+	return Proposal{
+		Content:          content,
+		ProposalID:       pp.ProposalID,
+		Status:           pp.Status,
+		FinalTallyResult: pp.FinalTallyResult,
+		SubmitTime:       pp.SubmitTime,
+		DepositEndTime:   pp.DepositEndTime,
+		TotalDeposit:     pp.TotalDeposit,
+		VotingStartTime:  pp.VotingStartTime,
+		VotingEndTime:    pp.VotingEndTime,
+	}, nil
+}
+
+// packContent packs proposal content into an Any. Content types are
+// registered with Amino as values while their protobuf methods are defined on
+// pointers, so value content is addressed before packing.
+func packContent(content Content) (*gogotypes.Any, error) {
+	if content == nil {
+		return nil, nil
+	}
+
+	v := reflect.ValueOf(content)
+	if v.Kind() != reflect.Ptr {
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		v = ptr
+	}
+
+	msg, ok := v.Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("proposal content %T is not a protobuf message", content)
+	}
+
+	return codec.NewAnyWithValue(msg)
+}
+
+// unpackContent unpacks proposal content from an Any, returning it as the
+// value type it was packed from.
+func unpackContent(any *gogotypes.Any) (Content, error) {
+	if any == nil {
+		return nil, nil
+	}
+
+	msg, err := codec.UnpackAny(any)
+	if err != nil {
+		return nil, err
+	}
+
+	content, ok := reflect.ValueOf(msg).Elem().Interface().(Content)
+	if !ok {
+		return nil, fmt.Errorf("%T does not implement proposal content", msg)
+	}
+
+	return content, nil
+}
+
 // Proposals is an array of proposal
 type Proposals []Proposal
 
@@ -204,13 +329,6 @@ const (
 	ProposalTypeText string = "Text"
 )
 
-// TextProposal defines a standard text proposal whose changes need to be
-// manually updated in case of approval
-type TextProposal struct {
-	Title       string `json:"title" yaml:"title"`
-	Description string `json:"description" yaml:"description"`
-}
-
 // NewTextProposal creates a text proposal Content
 func NewTextProposal(title, description string) Content {
 	return TextProposal{title, description}
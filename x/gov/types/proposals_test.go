@@ -3,8 +3,12 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -22,3 +26,25 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestMarshalProposal(t *testing.T) {
+	cdc := codec.NewHybridCodec(ModuleCdc)
+
+	now := time.Now().UTC()
+	proposal := NewProposal(NewTextProposal("title", "description"), 1, now, now.Add(time.Hour))
+	proposal.TotalDeposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	bz, err := MarshalProposal(cdc, proposal)
+	require.NoError(t, err)
+
+	res, err := UnmarshalProposal(cdc, bz)
+	require.NoError(t, err)
+	require.Equal(t, proposal.Content, res.Content)
+	require.Equal(t, proposal.String(), res.String())
+
+	_, err = MarshalProposal(cdc, NewProposal(invalidContent{}, 2, now, now))
+	require.Error(t, err)
+}
+
+// invalidContent is proposal content without a protobuf definition.
+type invalidContent struct{ TextProposal }
@@ -34,6 +34,9 @@ var (
 	ParamKeyTable        = types.ParamKeyTable
 	NewParams            = types.NewParams
 	DefaultParams        = types.DefaultParams
+	NewQueryServer       = keeper.NewQueryServer
+	RegisterQueryService = types.RegisterQueryService
+	NewQueryClient       = types.NewQueryClient
 
 	// variable aliases
 	ModuleCdc              = types.ModuleCdc
@@ -47,8 +50,14 @@ var (
 )
 
 type (
-	Keeper       = keeper.Keeper
-	GenesisState = types.GenesisState
-	Minter       = types.Minter
-	Params       = types.Params
+	Keeper                        = keeper.Keeper
+	GenesisState                  = types.GenesisState
+	Minter                        = types.Minter
+	Params                        = types.Params
+	QueryServer                   = types.QueryServer
+	QueryClient                   = types.QueryClient
+	QueryInflationRequest         = types.QueryInflationRequest
+	QueryInflationResponse        = types.QueryInflationResponse
+	QueryAnnualProvisionsRequest  = types.QueryAnnualProvisionsRequest
+	QueryAnnualProvisionsResponse = types.QueryAnnualProvisionsResponse
 )
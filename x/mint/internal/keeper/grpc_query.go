@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+// queryServer implements the mint gRPC query service on top of a Keeper.
+type queryServer struct {
+	keeper Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the mint gRPC query service
+// backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{keeper: k}
+}
+
+// Inflation implements the Query/Inflation gRPC method.
+func (q queryServer) Inflation(goCtx context.Context, req *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryInflationResponse{Inflation: q.keeper.GetMinter(ctx).Inflation}, nil
+}
+
+// AnnualProvisions implements the Query/AnnualProvisions gRPC method.
+func (q queryServer) AnnualProvisions(goCtx context.Context, req *types.QueryAnnualProvisionsRequest) (*types.QueryAnnualProvisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: q.keeper.GetMinter(ctx).AnnualProvisions}, nil
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keep "github.com/cosmos/cosmos-sdk/x/mint/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+func TestGRPCQueryInflation(t *testing.T) {
+	app, ctx := createTestApp(true)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keep.NewQueryServer(app.MintKeeper)
+
+	_, err := queryServer.Inflation(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := queryServer.Inflation(goCtx, &types.QueryInflationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, app.MintKeeper.GetMinter(ctx).Inflation, res.Inflation)
+}
+
+func TestGRPCQueryAnnualProvisions(t *testing.T) {
+	app, ctx := createTestApp(true)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keep.NewQueryServer(app.MintKeeper)
+
+	_, err := queryServer.AnnualProvisions(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	minter := types.NewMinter(sdk.NewDecWithPrec(13, 2), sdk.NewDec(1000))
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	res, err := queryServer.AnnualProvisions(goCtx, &types.QueryAnnualProvisionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, minter.AnnualProvisions, res.AnnualProvisions)
+}
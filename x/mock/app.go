@@ -67,7 +67,7 @@ func NewApp() *App {
 	app.ParamsKeeper = params.NewKeeper(app.Cdc, app.KeyParams, app.TKeyParams, params.DefaultCodespace)
 
 	app.AccountKeeper = auth.NewAccountKeeper(
-		app.Cdc,
+		codec.NewHybridCodec(app.Cdc),
 		app.KeyAccount,
 		app.ParamsKeeper.Subspace(auth.DefaultParamspace),
 		auth.ProtoBaseAccount,
@@ -78,7 +78,10 @@ func NewApp() *App {
 	// Initialize the app. The chainers and blockers can be overwritten before
 	// calling complete setup.
 	app.SetInitChainer(app.InitChainer)
-	app.SetAnteHandler(auth.NewAnteHandler(app.AccountKeeper, supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(auth.NewAnteHandler(
+		app.AccountKeeper, supplyKeeper, nil, nil, auth.DefaultSigVerificationGasConsumer,
+		auth.DefaultSignModeHandler(auth.DefaultTxEncoder(app.Cdc)),
+	))
 
 	// Not sealing for custom extension
 
@@ -217,7 +220,7 @@ func GenTx(msgs []sdk.Msg, accnums []uint64, seq []uint64, priv ...crypto.PrivKe
 	memo := "testmemotestmemo"
 
 	for i, p := range priv {
-		sig, err := p.Sign(auth.StdSignBytes(chainID, accnums[i], seq[i], fee, msgs, memo))
+		sig, err := p.Sign(auth.StdSignBytes(chainID, accnums[i], seq[i], 0, fee, msgs, memo))
 		if err != nil {
 			panic(err)
 		}
@@ -70,6 +70,9 @@ var (
 	NewQuerySigningInfoParams                = types.NewQuerySigningInfoParams
 	NewQuerySigningInfosParams               = types.NewQuerySigningInfosParams
 	NewValidatorSigningInfo                  = types.NewValidatorSigningInfo
+	NewQueryServer                           = keeper.NewQueryServer
+	RegisterQueryService                     = types.RegisterQueryService
+	NewQueryClient                           = types.NewQueryClient
 
 	// variable aliases
 	ModuleCdc                       = types.ModuleCdc
@@ -87,14 +90,20 @@ var (
 )
 
 type (
-	Hooks                   = keeper.Hooks
-	Keeper                  = keeper.Keeper
-	CodeType                = types.CodeType
-	GenesisState            = types.GenesisState
-	MissedBlock             = types.MissedBlock
-	MsgUnjail               = types.MsgUnjail
-	Params                  = types.Params
-	QuerySigningInfoParams  = types.QuerySigningInfoParams
-	QuerySigningInfosParams = types.QuerySigningInfosParams
-	ValidatorSigningInfo    = types.ValidatorSigningInfo
+	Hooks                     = keeper.Hooks
+	Keeper                    = keeper.Keeper
+	CodeType                  = types.CodeType
+	GenesisState              = types.GenesisState
+	MissedBlock               = types.MissedBlock
+	MsgUnjail                 = types.MsgUnjail
+	Params                    = types.Params
+	QuerySigningInfoParams    = types.QuerySigningInfoParams
+	QuerySigningInfosParams   = types.QuerySigningInfosParams
+	ValidatorSigningInfo      = types.ValidatorSigningInfo
+	QueryServer               = types.QueryServer
+	QueryClient               = types.QueryClient
+	QuerySigningInfoRequest   = types.QuerySigningInfoRequest
+	QuerySigningInfoResponse  = types.QuerySigningInfoResponse
+	QuerySigningInfosRequest  = types.QuerySigningInfosRequest
+	QuerySigningInfosResponse = types.QuerySigningInfosResponse
 )
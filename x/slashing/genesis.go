@@ -1,6 +1,8 @@
 package slashing
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
@@ -15,6 +17,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, stakingKeeper types.StakingKeep
 			return false
 		},
 	)
+	stakingKeeper.IterateRotatedConsPubKeys(ctx,
+		func(_ sdk.ValAddress, pubKey crypto.PubKey) bool {
+			keeper.AddPubkey(ctx, pubKey)
+			return false
+		},
+	)
 
 	for addr, info := range data.SigningInfos {
 		address, err := sdk.ConsAddressFromBech32(addr)
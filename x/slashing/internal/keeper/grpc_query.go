@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+)
+
+// queryServer implements the slashing gRPC query service on top of a Keeper.
+type queryServer struct {
+	keeper Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the slashing gRPC query service
+// backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{keeper: k}
+}
+
+// SigningInfo implements the Query/SigningInfo gRPC method.
+func (q queryServer) SigningInfo(goCtx context.Context, req *types.QuerySigningInfoRequest) (*types.QuerySigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ConsAddress.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "consensus address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	info, found := q.keeper.GetValidatorSigningInfo(ctx, req.ConsAddress)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "signing info not found for %s", req.ConsAddress)
+	}
+
+	return &types.QuerySigningInfoResponse{ValSigningInfo: info}, nil
+}
+
+// SigningInfos implements the Query/SigningInfos gRPC method.
+func (q queryServer) SigningInfos(goCtx context.Context, req *types.QuerySigningInfosRequest) (*types.QuerySigningInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var infos []types.ValidatorSigningInfo
+	q.keeper.IterateValidatorSigningInfos(ctx, func(_ sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
+		infos = append(infos, info)
+		return false
+	})
+
+	return &types.QuerySigningInfosResponse{Info: infos}, nil
+}
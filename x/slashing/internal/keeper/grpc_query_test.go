@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+)
+
+func TestGRPCQuerySigningInfo(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, types.DefaultParams())
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := NewQueryServer(keeper)
+
+	consAddr := sdk.ConsAddress(Addrs[0])
+	info := types.NewValidatorSigningInfo(consAddr, 4, 3, time.Unix(2, 0).UTC(), false, 10)
+	keeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QuerySigningInfoRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"empty address", &types.QuerySigningInfoRequest{}, codes.InvalidArgument},
+		{"unknown signing info", &types.QuerySigningInfoRequest{ConsAddress: sdk.ConsAddress(Addrs[1])}, codes.NotFound},
+		{"existing signing info", &types.QuerySigningInfoRequest{ConsAddress: consAddr}, codes.OK},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.SigningInfo(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Equal(t, info, res.ValSigningInfo, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQuerySigningInfos(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, types.DefaultParams())
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := NewQueryServer(keeper)
+
+	_, err := queryServer.SigningInfos(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := queryServer.SigningInfos(goCtx, &types.QuerySigningInfosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Info)
+
+	for i := 0; i < 3; i++ {
+		consAddr := sdk.ConsAddress(Addrs[i])
+		keeper.SetValidatorSigningInfo(ctx, consAddr, types.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0).UTC(), false, 0))
+	}
+
+	res, err = queryServer.SigningInfos(goCtx, &types.QuerySigningInfosRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Info, 3)
+}
@@ -20,6 +20,12 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 		panic(fmt.Sprintf("Validator consensus-address %s not found", consAddr))
 	}
 
+	// the block may have been signed with a consensus pubkey the validator has
+	// since rotated away from, the signing info is kept for the current one
+	if validator := k.sk.ValidatorByConsAddr(ctx, consAddr); validator != nil {
+		consAddr = validator.GetConsAddr()
+	}
+
 	// fetch signing info
 	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !found {
@@ -3,6 +3,8 @@
 package types
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -43,6 +45,10 @@ type StakingKeeper interface {
 
 	// MaxValidators returns the maximum amount of bonded validators
 	MaxValidators(sdk.Context) uint16
+
+	// iterate through the consensus pubkeys validators rotated away from
+	IterateRotatedConsPubKeys(sdk.Context,
+		func(valAddr sdk.ValAddress, pubKey crypto.PubKey) (stop bool))
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -51,4 +57,6 @@ type StakingHooks interface {
 	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator is deleted
 
 	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator is bonded
+
+	AfterConsPubKeyRotated(ctx sdk.Context, valAddr sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) // Must be called when a validator's consensus pubkey rotation is applied
 }
@@ -34,3 +34,9 @@ type QuerySigningInfosParams struct {
 func NewQuerySigningInfosParams(page, limit int) QuerySigningInfosParams {
 	return QuerySigningInfosParams{page, limit}
 }
+
+// RegisterQueryService registers the slashing gRPC query service with the
+// given server.
+func RegisterQueryService(server sdk.GRPCServer, srv QueryServer) {
+	server.RegisterService(&_Query_serviceDesc, srv)
+}
@@ -0,0 +1,507 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: x/slashing/internal/types/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorSigningInfo defines the signing info for a validator
+type ValidatorSigningInfo struct {
+	// validator consensus address
+	Address github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"address" yaml:"address"`
+	// height at which validator was first a candidate OR was unjailed
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height" yaml:"start_height"`
+	// index offset into signed block bit array
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset" yaml:"index_offset"`
+	// timestamp validator cannot be unjailed until
+	JailedUntil time.Time `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until" yaml:"jailed_until"`
+	// whether or not a validator has been tombstoned (killed out of validator set)
+	Tombstoned bool `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned" yaml:"tombstoned"`
+	// missed blocks counter (to avoid scanning the array every time)
+	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter" yaml:"missed_blocks_counter"`
+}
+
+func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
+func (*ValidatorSigningInfo) ProtoMessage() {}
+func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b882c3b0cdd6f57, []int{0}
+}
+func (m *ValidatorSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSigningInfo.Merge(m, src)
+}
+func (m *ValidatorSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSigningInfo proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos_sdk.x.slashing.v1.ValidatorSigningInfo")
+}
+
+func init() {
+	proto.RegisterFile("x/slashing/internal/types/types.proto", fileDescriptor_2b882c3b0cdd6f57)
+}
+
+var fileDescriptor_2b882c3b0cdd6f57 = []byte{
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x8b, 0xd3, 0x40,
+	0x1c, 0xc5, 0x13, 0x77, 0x5d, 0x25, 0x2d, 0x82, 0xd9, 0x15, 0x42, 0x91, 0x4c, 0x89, 0x88, 0xbd,
+	0x38, 0x41, 0x05, 0x41, 0x6f, 0xa6, 0x17, 0xf5, 0xb2, 0x10, 0x7f, 0x20, 0x5e, 0xc2, 0xa4, 0x33,
+	0x9d, 0x8e, 0x4d, 0x66, 0x4a, 0x66, 0x2a, 0xdd, 0xff, 0x62, 0xff, 0x21, 0xef, 0x3d, 0xee, 0x71,
+	0x4f, 0xa3, 0xdb, 0xde, 0x7a, 0xec, 0xd1, 0x93, 0x64, 0xa6, 0xa1, 0x11, 0xaa, 0x97, 0x24, 0xef,
+	0x93, 0x37, 0xef, 0x9b, 0x3c, 0xbe, 0xde, 0xe3, 0x45, 0x2c, 0x0b, 0x24, 0x27, 0x8c, 0xd3, 0x98,
+	0x71, 0x45, 0x2a, 0x8e, 0x8a, 0x58, 0x5d, 0xcc, 0x88, 0xb4, 0x57, 0x38, 0xab, 0x84, 0x12, 0x7e,
+	0x30, 0x12, 0xb2, 0x14, 0x32, 0x93, 0x78, 0x0a, 0x17, 0xb0, 0x39, 0x01, 0xbf, 0x3f, 0xeb, 0x9d,
+	0x51, 0x41, 0x85, 0x31, 0xc5, 0xf5, 0x93, 0xf5, 0xf7, 0x00, 0x15, 0x82, 0x16, 0x24, 0x36, 0x2a,
+	0x9f, 0x8f, 0x63, 0xc5, 0x4a, 0x22, 0x15, 0x2a, 0x67, 0xd6, 0x10, 0xfd, 0x38, 0xf6, 0xce, 0x3e,
+	0xa3, 0x82, 0x61, 0xa4, 0x44, 0xf5, 0x81, 0x51, 0xce, 0x38, 0x7d, 0xc7, 0xc7, 0xc2, 0x67, 0xde,
+	0x1d, 0x84, 0x71, 0x45, 0xa4, 0x0c, 0xdc, 0xbe, 0x3b, 0xe8, 0x26, 0xe7, 0x1b, 0x0d, 0x1a, 0xb4,
+	0xd5, 0xe0, 0xde, 0x05, 0x2a, 0x8b, 0xd7, 0xd1, 0x0e, 0x44, 0xbf, 0x35, 0x80, 0x94, 0xa9, 0xc9,
+	0x3c, 0x87, 0x23, 0x51, 0xc6, 0xf6, 0x33, 0x77, 0xb7, 0xa7, 0x12, 0x4f, 0x77, 0x7f, 0x31, 0x14,
+	0x5c, 0xbe, 0xb1, 0x47, 0xd2, 0x26, 0xcc, 0x7f, 0xef, 0x75, 0xa5, 0x42, 0x95, 0xca, 0x26, 0x84,
+	0xd1, 0x89, 0x0a, 0x6e, 0xf5, 0xdd, 0xc1, 0x51, 0xf2, 0x64, 0xa3, 0xc1, 0x5f, 0x7c, 0xab, 0xc1,
+	0xa9, 0x1d, 0xda, 0xa6, 0x51, 0xda, 0x31, 0xf2, 0xad, 0x51, 0x75, 0x16, 0xe3, 0x98, 0x2c, 0x32,
+	0x31, 0x1e, 0x4b, 0xa2, 0x82, 0xa3, 0x7d, 0x56, 0x9b, 0xef, 0xb3, 0xda, 0x34, 0x4a, 0x3b, 0x46,
+	0x9e, 0x1b, 0xe5, 0x73, 0xaf, 0xfb, 0x0d, 0xb1, 0x82, 0xe0, 0x6c, 0xce, 0x15, 0x2b, 0x82, 0xe3,
+	0xbe, 0x3b, 0xe8, 0x3c, 0xef, 0x41, 0xdb, 0x29, 0x6c, 0x3a, 0x85, 0x1f, 0x9b, 0x4e, 0x93, 0x78,
+	0xa9, 0x81, 0x53, 0xcf, 0x6a, 0x9f, 0xdb, 0xcf, 0x6a, 0xd3, 0xe8, 0xf2, 0x27, 0x70, 0xd3, 0x8e,
+	0x45, 0x9f, 0x6a, 0xe2, 0x0f, 0x3d, 0x4f, 0x89, 0x32, 0x97, 0x4a, 0x70, 0x82, 0x83, 0xdb, 0x7d,
+	0x77, 0x70, 0x37, 0x79, 0xb4, 0xd1, 0xa0, 0x45, 0xb7, 0x1a, 0xdc, 0xb7, 0x59, 0x7b, 0x16, 0xa5,
+	0x2d, 0x83, 0x5f, 0x7a, 0x0f, 0x4a, 0x26, 0x25, 0xc1, 0x59, 0x5e, 0x88, 0xd1, 0x54, 0x66, 0x23,
+	0x31, 0xaf, 0xf7, 0x29, 0x38, 0x31, 0x4d, 0xbc, 0xda, 0x68, 0x70, 0xd8, 0xb0, 0xd5, 0xe0, 0xa1,
+	0x8d, 0x3e, 0xf8, 0x3a, 0x4a, 0x4f, 0x2d, 0x4f, 0x0c, 0x1e, 0x5a, 0x9a, 0x7c, 0x59, 0xde, 0x84,
+	0xce, 0xf5, 0x4d, 0xe8, 0x2c, 0x57, 0xa1, 0x7b, 0xb5, 0x0a, 0xdd, 0x5f, 0xab, 0xd0, 0xbd, 0x5c,
+	0x87, 0xce, 0xd5, 0x3a, 0x74, 0xae, 0xd7, 0xa1, 0xf3, 0xf5, 0xe5, 0x7f, 0xd7, 0xe2, 0x9f, 0x6b,
+	0x9f, 0x9f, 0x98, 0x7e, 0x5f, 0xfc, 0x19, 0x00, 0x2d, 0x22, 0xa0, 0x5b, 0x1a, 0x03, 0x00, 0x00,
+}
+
+func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.IndexOffset != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovTypes(uint64(m.IndexOffset))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovTypes(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
@@ -31,8 +31,10 @@ const (
 	DefaultUnbondingTime               = types.DefaultUnbondingTime
 	DefaultMaxValidators               = types.DefaultMaxValidators
 	DefaultMaxEntries                  = types.DefaultMaxEntries
+	DefaultHistoricalEntries           = types.DefaultHistoricalEntries
 	NotBondedPoolName                  = types.NotBondedPoolName
 	BondedPoolName                     = types.BondedPoolName
+	TokenizeSharePoolName              = types.TokenizeSharePoolName
 	QueryValidators                    = types.QueryValidators
 	QueryValidator                     = types.QueryValidator
 	QueryDelegatorDelegations          = types.QueryDelegatorDelegations
@@ -47,6 +49,9 @@ const (
 	QueryDelegatorValidator            = types.QueryDelegatorValidator
 	QueryPool                          = types.QueryPool
 	QueryParameters                    = types.QueryParameters
+	QueryTokenizeShareRecords          = types.QueryTokenizeShareRecords
+	QueryHistoricalInfo                = types.QueryHistoricalInfo
+	TokenizeShareDenomPrefix           = types.TokenizeShareDenomPrefix
 	MaxMonikerLength                   = types.MaxMonikerLength
 	MaxIdentityLength                  = types.MaxIdentityLength
 	MaxWebsiteLength                   = types.MaxWebsiteLength
@@ -62,6 +67,7 @@ var (
 	NonNegativePowerInvariant          = keeper.NonNegativePowerInvariant
 	PositiveDelegationInvariant        = keeper.PositiveDelegationInvariant
 	DelegatorSharesInvariant           = keeper.DelegatorSharesInvariant
+	TokenizeSharesInvariant            = keeper.TokenizeSharesInvariant
 	NewKeeper                          = keeper.NewKeeper
 	ParamKeyTable                      = keeper.ParamKeyTable
 	NewQuerier                         = keeper.NewQuerier
@@ -98,6 +104,7 @@ var (
 	ErrCommissionNegative              = types.ErrCommissionNegative
 	ErrCommissionHuge                  = types.ErrCommissionHuge
 	ErrCommissionGTMaxRate             = types.ErrCommissionGTMaxRate
+	ErrCommissionLTMinRate             = types.ErrCommissionLTMinRate
 	ErrCommissionUpdateTime            = types.ErrCommissionUpdateTime
 	ErrCommissionChangeRateNegative    = types.ErrCommissionChangeRateNegative
 	ErrCommissionChangeRateGTMaxRate   = types.ErrCommissionChangeRateGTMaxRate
@@ -179,6 +186,39 @@ var (
 	MustUnmarshalValidator             = types.MustUnmarshalValidator
 	UnmarshalValidator                 = types.UnmarshalValidator
 	NewDescription                     = types.NewDescription
+	NewQueryServer                     = keeper.NewQueryServer
+	RegisterQueryService               = types.RegisterQueryService
+	NewQueryClient                     = types.NewQueryClient
+	ErrTokenizeSelfDelegation          = types.ErrTokenizeSelfDelegation
+	ErrTokenizeVestingDelegation       = types.ErrTokenizeVestingDelegation
+	ErrTokenizeRedelegationInProgress  = types.ErrTokenizeRedelegationInProgress
+	ErrTinyTokenizeShares              = types.ErrTinyTokenizeShares
+	ErrNoTokenizeShareRecord           = types.ErrNoTokenizeShareRecord
+	GetTokenizeShareDenomKey           = types.GetTokenizeShareDenomKey
+	GetTokenizeShareValidatorKey       = types.GetTokenizeShareValidatorKey
+	NewMsgTokenizeShares               = types.NewMsgTokenizeShares
+	NewMsgRedeemTokensForShares        = types.NewMsgRedeemTokensForShares
+	NewTokenizeShareRecord             = types.NewTokenizeShareRecord
+	TokenizeShareDenom                 = types.TokenizeShareDenom
+	ParseTokenizeShareDenom            = types.ParseTokenizeShareDenom
+	ErrNoUnbondingDelegationEntry      = types.ErrNoUnbondingDelegationEntry
+	ErrInvalidCreationHeight           = types.ErrInvalidCreationHeight
+	ErrUnbondingDelegationEntryMature  = types.ErrUnbondingDelegationEntryMature
+	NewMsgCancelUnbondingDelegation    = types.NewMsgCancelUnbondingDelegation
+	NewMsgChangeValidatorOperator      = types.NewMsgChangeValidatorOperator
+	NewMsgRotateConsPubKey             = types.NewMsgRotateConsPubKey
+	NewConsPubKeyRotation              = types.NewConsPubKeyRotation
+	GetRotatedConsPubKeyKey            = types.GetRotatedConsPubKeyKey
+	GetPendingConsPubKeyRotationKey    = types.GetPendingConsPubKeyRotationKey
+	ErrSameValidatorOperator           = types.ErrSameValidatorOperator
+	ErrNewOperatorHasDelegation        = types.ErrNewOperatorHasDelegation
+	NewHistoricalInfo                  = types.NewHistoricalInfo
+	MustMarshalHistoricalInfo          = types.MustMarshalHistoricalInfo
+	MustUnmarshalHistoricalInfo        = types.MustUnmarshalHistoricalInfo
+	UnmarshalHistoricalInfo            = types.UnmarshalHistoricalInfo
+	GetHistoricalInfoKey               = types.GetHistoricalInfoKey
+	NewQueryHistoricalInfoParams       = types.NewQueryHistoricalInfoParams
+	ErrNoHistoricalInfo                = types.ErrNoHistoricalInfo
 
 	// variable aliases
 	ModuleCdc                        = types.ModuleCdc
@@ -196,50 +236,88 @@ var (
 	UnbondingQueueKey                = types.UnbondingQueueKey
 	RedelegationQueueKey             = types.RedelegationQueueKey
 	ValidatorQueueKey                = types.ValidatorQueueKey
+	TokenizeShareDenomKey            = types.TokenizeShareDenomKey
+	TokenizeShareValidatorKey        = types.TokenizeShareValidatorKey
+	LastTokenizeShareIDKey           = types.LastTokenizeShareIDKey
+	RotatedConsPubKeyKey             = types.RotatedConsPubKeyKey
+	PendingConsPubKeyRotationKey     = types.PendingConsPubKeyRotationKey
+	HistoricalInfoKey                = types.HistoricalInfoKey
 	KeyUnbondingTime                 = types.KeyUnbondingTime
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
+	KeyHistoricalEntries             = types.KeyHistoricalEntries
 	KeyBondDenom                     = types.KeyBondDenom
+	KeyMinCommissionRate             = types.KeyMinCommissionRate
+	DefaultMinCommissionRate         = types.DefaultMinCommissionRate
 )
 
 type (
-	Keeper                    = keeper.Keeper
-	Commission                = types.Commission
-	CommissionRates           = types.CommissionRates
-	DVPair                    = types.DVPair
-	DVVTriplet                = types.DVVTriplet
-	Delegation                = types.Delegation
-	Delegations               = types.Delegations
-	UnbondingDelegation       = types.UnbondingDelegation
-	UnbondingDelegationEntry  = types.UnbondingDelegationEntry
-	UnbondingDelegations      = types.UnbondingDelegations
-	Redelegation              = types.Redelegation
-	RedelegationEntry         = types.RedelegationEntry
-	Redelegations             = types.Redelegations
-	DelegationResponse        = types.DelegationResponse
-	DelegationResponses       = types.DelegationResponses
-	RedelegationResponse      = types.RedelegationResponse
-	RedelegationEntryResponse = types.RedelegationEntryResponse
-	RedelegationResponses     = types.RedelegationResponses
-	CodeType                  = types.CodeType
-	GenesisState              = types.GenesisState
-	LastValidatorPower        = types.LastValidatorPower
-	MultiStakingHooks         = types.MultiStakingHooks
-	MsgCreateValidator        = types.MsgCreateValidator
-	MsgEditValidator          = types.MsgEditValidator
-	MsgDelegate               = types.MsgDelegate
-	MsgBeginRedelegate        = types.MsgBeginRedelegate
-	MsgUndelegate             = types.MsgUndelegate
-	Params                    = types.Params
-	Pool                      = types.Pool
-	QueryDelegatorParams      = types.QueryDelegatorParams
-	QueryValidatorParams      = types.QueryValidatorParams
-	QueryBondsParams          = types.QueryBondsParams
-	QueryRedelegationParams   = types.QueryRedelegationParams
-	QueryValidatorsParams     = types.QueryValidatorsParams
-	Validator                 = types.Validator
-	Validators                = types.Validators
-	Description               = types.Description
-	DelegationI               = exported.DelegationI
-	ValidatorI                = exported.ValidatorI
+	Keeper                                     = keeper.Keeper
+	Commission                                 = types.Commission
+	CommissionRates                            = types.CommissionRates
+	DVPair                                     = types.DVPair
+	DVVTriplet                                 = types.DVVTriplet
+	Delegation                                 = types.Delegation
+	Delegations                                = types.Delegations
+	UnbondingDelegation                        = types.UnbondingDelegation
+	UnbondingDelegationEntry                   = types.UnbondingDelegationEntry
+	UnbondingDelegations                       = types.UnbondingDelegations
+	Redelegation                               = types.Redelegation
+	RedelegationEntry                          = types.RedelegationEntry
+	Redelegations                              = types.Redelegations
+	DelegationResponse                         = types.DelegationResponse
+	DelegationResponses                        = types.DelegationResponses
+	RedelegationResponse                       = types.RedelegationResponse
+	RedelegationEntryResponse                  = types.RedelegationEntryResponse
+	RedelegationResponses                      = types.RedelegationResponses
+	CodeType                                   = types.CodeType
+	GenesisState                               = types.GenesisState
+	LastValidatorPower                         = types.LastValidatorPower
+	MultiStakingHooks                          = types.MultiStakingHooks
+	MsgCreateValidator                         = types.MsgCreateValidator
+	MsgEditValidator                           = types.MsgEditValidator
+	MsgDelegate                                = types.MsgDelegate
+	MsgBeginRedelegate                         = types.MsgBeginRedelegate
+	MsgUndelegate                              = types.MsgUndelegate
+	Params                                     = types.Params
+	Pool                                       = types.Pool
+	QueryDelegatorParams                       = types.QueryDelegatorParams
+	QueryValidatorParams                       = types.QueryValidatorParams
+	QueryBondsParams                           = types.QueryBondsParams
+	QueryRedelegationParams                    = types.QueryRedelegationParams
+	QueryValidatorsParams                      = types.QueryValidatorsParams
+	Validator                                  = types.Validator
+	Validators                                 = types.Validators
+	Description                                = types.Description
+	DelegationI                                = exported.DelegationI
+	ValidatorI                                 = exported.ValidatorI
+	QueryServer                                = types.QueryServer
+	QueryClient                                = types.QueryClient
+	QueryValidatorsRequest                     = types.QueryValidatorsRequest
+	QueryValidatorsResponse                    = types.QueryValidatorsResponse
+	QueryValidatorRequest                      = types.QueryValidatorRequest
+	QueryValidatorResponse                     = types.QueryValidatorResponse
+	QueryValidatorDelegationsRequest           = types.QueryValidatorDelegationsRequest
+	QueryValidatorDelegationsResponse          = types.QueryValidatorDelegationsResponse
+	QueryValidatorUnbondingDelegationsRequest  = types.QueryValidatorUnbondingDelegationsRequest
+	QueryValidatorUnbondingDelegationsResponse = types.QueryValidatorUnbondingDelegationsResponse
+	QueryDelegationRequest                     = types.QueryDelegationRequest
+	QueryDelegationResponse                    = types.QueryDelegationResponse
+	QueryUnbondingDelegationRequest            = types.QueryUnbondingDelegationRequest
+	QueryUnbondingDelegationResponse           = types.QueryUnbondingDelegationResponse
+	QueryDelegatorDelegationsRequest           = types.QueryDelegatorDelegationsRequest
+	QueryDelegatorDelegationsResponse          = types.QueryDelegatorDelegationsResponse
+	QueryDelegatorUnbondingDelegationsRequest  = types.QueryDelegatorUnbondingDelegationsRequest
+	QueryDelegatorUnbondingDelegationsResponse = types.QueryDelegatorUnbondingDelegationsResponse
+	QueryPoolRequest                           = types.QueryPoolRequest
+	QueryPoolResponse                          = types.QueryPoolResponse
+	MsgTokenizeShares                          = types.MsgTokenizeShares
+	MsgRedeemTokensForShares                   = types.MsgRedeemTokensForShares
+	TokenizeShareRecord                        = types.TokenizeShareRecord
+	MsgCancelUnbondingDelegation               = types.MsgCancelUnbondingDelegation
+	MsgChangeValidatorOperator                 = types.MsgChangeValidatorOperator
+	MsgRotateConsPubKey                        = types.MsgRotateConsPubKey
+	ConsPubKeyRotation                         = types.ConsPubKeyRotation
+	HistoricalInfo                             = types.HistoricalInfo
+	QueryHistoricalInfoParams                  = types.QueryHistoricalInfoParams
 )
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
@@ -25,6 +26,7 @@ func getMockApp(t *testing.T) (*mock.App, Keeper) {
 
 	keyStaking := sdk.NewKVStoreKey(StoreKey)
 	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
 
 	feeCollector := supply.NewEmptyModuleAccount(auth.FeeCollectorName)
 	notBondedPool := supply.NewEmptyModuleAccount(types.NotBondedPoolName, supply.Burner, supply.Staking)
@@ -35,21 +37,21 @@ func getMockApp(t *testing.T) (*mock.App, Keeper) {
 	blacklistedAddrs[notBondedPool.GetAddress().String()] = true
 	blacklistedAddrs[bondPool.GetAddress().String()] = true
 
-	bankKeeper := bank.NewBaseKeeper(mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	bankKeeper := bank.NewBaseKeeper(mApp.Cdc, keyBank, mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs, blacklistedAddrs)
 	maccPerms := map[string][]string{
 		auth.FeeCollectorName:   nil,
 		types.NotBondedPoolName: {supply.Burner, supply.Staking},
 		types.BondedPoolName:    {supply.Burner, supply.Staking},
 	}
 	supplyKeeper := supply.NewKeeper(mApp.Cdc, keySupply, mApp.AccountKeeper, bankKeeper, maccPerms)
-	keeper := NewKeeper(mApp.Cdc, keyStaking, supplyKeeper, mApp.ParamsKeeper.Subspace(DefaultParamspace), DefaultCodespace)
+	keeper := NewKeeper(codec.NewHybridCodec(mApp.Cdc), keyStaking, mApp.AccountKeeper, supplyKeeper, mApp.ParamsKeeper.Subspace(DefaultParamspace), DefaultCodespace)
 
 	mApp.Router().AddRoute(RouterKey, NewHandler(keeper))
 	mApp.SetEndBlocker(getEndBlocker(keeper))
 	mApp.SetInitChainer(getInitChainer(mApp, keeper, mApp.AccountKeeper, supplyKeeper,
 		[]supplyexported.ModuleAccountI{feeCollector, notBondedPool, bondPool}))
 
-	require.NoError(t, mApp.CompleteSetup(keyStaking, keySupply))
+	require.NoError(t, mApp.CompleteSetup(keyStaking, keySupply, keyBank))
 	return mApp, keeper
 }
 
@@ -186,3 +188,33 @@ func TestStakingMsgs(t *testing.T) {
 	// balance should be the same because bonding not yet complete
 	mock.CheckBalance(t, mApp, addr2, sdk.Coins{genCoin.Sub(bondCoin)})
 }
+
+func TestChangeValidatorOperatorSigners(t *testing.T) {
+	mApp, keeper := getMockApp(t)
+
+	genCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(42))
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))
+
+	acc1 := &auth.BaseAccount{Address: addr1, Coins: sdk.Coins{genCoin}}
+	acc2 := &auth.BaseAccount{Address: addr2, Coins: sdk.Coins{genCoin}}
+	mock.SetGenesis(mApp, []authexported.Account{acc1, acc2})
+
+	createValidatorMsg := NewMsgCreateValidator(
+		sdk.ValAddress(addr1), priv1.PubKey(), bondCoin, NewDescription("foo_moniker", "", "", "", ""), commissionRates, sdk.OneInt(),
+	)
+	header := abci.Header{Height: mApp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mApp.Cdc, mApp.BaseApp, header, []sdk.Msg{createValidatorMsg}, []uint64{0}, []uint64{0}, true, true, priv1)
+
+	// the msg is rejected without the signature of the new operator
+	changeOperatorMsg := NewMsgChangeValidatorOperator(sdk.ValAddress(addr1), addr2)
+	header = abci.Header{Height: mApp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mApp.Cdc, mApp.BaseApp, header, []sdk.Msg{changeOperatorMsg}, []uint64{0}, []uint64{1}, false, false, priv1)
+	checkValidator(t, mApp, keeper, sdk.ValAddress(addr1), true)
+	checkValidator(t, mApp, keeper, sdk.ValAddress(addr2), false)
+
+	// the msg is accepted once both operators signed it
+	header = abci.Header{Height: mApp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mApp.Cdc, mApp.BaseApp, header, []sdk.Msg{changeOperatorMsg}, []uint64{0, 1}, []uint64{1, 0}, true, true, priv1, priv2)
+	checkValidator(t, mApp, keeper, sdk.ValAddress(addr1), false)
+	checkValidator(t, mApp, keeper, sdk.ValAddress(addr2), true)
+}
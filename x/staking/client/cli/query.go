@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -36,7 +37,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorUnbondingDelegations(queryRoute, cdc),
 		GetCmdQueryValidatorRedelegations(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
-		GetCmdQueryPool(queryRoute, cdc))...)
+		GetCmdQueryPool(queryRoute, cdc),
+		GetCmdQueryTokenizeShareRecords(queryRoute, cdc),
+		GetCmdQueryHistoricalInfo(queryRoute, cdc))...)
 
 	return stakingQueryCmd
 
@@ -74,7 +77,7 @@ $ %s query staking validator cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhff
 				return fmt.Errorf("no validator found with address %s", addr)
 			}
 
-			return cliCtx.PrintOutput(types.MustUnmarshalValidator(cdc, res))
+			return cliCtx.PrintOutput(types.MustUnmarshalValidator(codec.NewHybridCodec(cdc), res))
 		},
 	}
 }
@@ -104,7 +107,7 @@ $ %s query staking validators
 
 			var validators types.Validators
 			for _, kv := range resKVs {
-				validators = append(validators, types.MustUnmarshalValidator(cdc, kv.Value))
+				validators = append(validators, types.MustUnmarshalValidator(codec.NewHybridCodec(cdc), kv.Value))
 			}
 
 			return cliCtx.PrintOutput(validators)
@@ -377,7 +380,12 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 				return err
 			}
 
-			return cliCtx.PrintOutput(types.MustUnmarshalUBD(cdc, res))
+			var ubd types.UnbondingDelegation
+			if err = cdc.UnmarshalJSON(res, &ubd); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(ubd)
 		},
 	}
 }
@@ -590,3 +598,81 @@ $ %s query staking params
 		},
 	}
 }
+
+// GetCmdQueryTokenizeShareRecords implements the tokenize share records query command.
+func GetCmdQueryTokenizeShareRecords(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-share-records",
+		Args:  cobra.NoArgs,
+		Short: "Query the share token denoms of all validators with tokenized shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the share token denom assigned to each validator whose delegation
+shares have been tokenized.
+
+Example:
+$ %s query staking tokenize-share-records
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenizeShareRecords)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var records []types.TokenizeShareRecord
+			cdc.MustUnmarshalJSON(bz, &records)
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
+
+// GetCmdQueryHistoricalInfo implements the historical info query command
+func GetCmdQueryHistoricalInfo(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "historical-info [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query historical info at given height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the header and the validator set stored by the staking module at a
+given height. Only the recent heights set by the historical_entries param are
+kept.
+
+Example:
+$ %s query staking historical-info 5
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || height < 0 {
+				return fmt.Errorf("height argument provided must be a non-negative integer: %v", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryHistoricalInfoParams(height))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryHistoricalInfo)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var resp types.HistoricalInfo
+			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(resp)
+		},
+	}
+}
@@ -87,6 +87,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 		}
 	}
 
+	keeper.SetLastTokenizeShareID(ctx, data.LastTokenizeShareID)
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+	}
+
+	for _, rotation := range data.ConsPubKeyRotations {
+		keeper.SetConsPubKeyRotation(ctx, rotation)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -169,6 +178,9 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+		LastTokenizeShareID:  keeper.GetLastTokenizeShareID(ctx),
+		TokenizeShareRecords: keeper.GetAllTokenizeShareRecords(ctx),
+		ConsPubKeyRotations:  keeper.GetAllConsPubKeyRotations(ctx),
 	}
 }
 
@@ -198,6 +210,14 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	err = validateGenesisStateTokenizeShareRecords(data.LastTokenizeShareID, data.TokenizeShareRecords)
+	if err != nil {
+		return err
+	}
+	err = validateGenesisStateConsPubKeyRotations(data.Validators, data.ConsPubKeyRotations)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -206,7 +226,7 @@ func validateGenesisStateValidators(validators []types.Validator) (err error) {
 	addrMap := make(map[string]bool, len(validators))
 	for i := 0; i < len(validators); i++ {
 		val := validators[i]
-		strKey := string(val.ConsPubKey.Bytes())
+		strKey := string(val.GetConsPubKey().Bytes())
 		if _, ok := addrMap[strKey]; ok {
 			return fmt.Errorf("duplicate validator in genesis state: moniker %v, address %v", val.Description.Moniker, val.ConsAddress())
 		}
@@ -220,3 +240,49 @@ func validateGenesisStateValidators(validators []types.Validator) (err error) {
 	}
 	return
 }
+
+func validateGenesisStateTokenizeShareRecords(lastID uint64, records []types.TokenizeShareRecord) error {
+	valMap := make(map[string]bool, len(records))
+	denomMap := make(map[string]bool, len(records))
+	for _, record := range records {
+		if record.ValidatorAddress.Empty() {
+			return fmt.Errorf("tokenize share record %s has an empty validator address", record.Denom)
+		}
+		id, err := types.ParseTokenizeShareDenom(record.Denom)
+		if err != nil {
+			return err
+		}
+		if id > lastID {
+			return fmt.Errorf("tokenize share denom %s is greater than the last tokenize share id %d", record.Denom, lastID)
+		}
+		if valMap[record.ValidatorAddress.String()] {
+			return fmt.Errorf("duplicate tokenize share record for validator %s", record.ValidatorAddress)
+		}
+		if denomMap[record.Denom] {
+			return fmt.Errorf("duplicate tokenize share record for denom %s", record.Denom)
+		}
+		valMap[record.ValidatorAddress.String()] = true
+		denomMap[record.Denom] = true
+	}
+	return nil
+}
+
+func validateGenesisStateConsPubKeyRotations(validators []types.Validator, rotations []types.ConsPubKeyRotation) error {
+	pubKeyMap := make(map[string]bool, len(validators)+len(rotations))
+	for _, val := range validators {
+		pubKeyMap[val.ConsPubKey] = true
+	}
+	for _, rotation := range rotations {
+		if rotation.ValidatorAddress.Empty() {
+			return fmt.Errorf("consensus pubkey rotation %s has an empty validator address", rotation.ConsPubKey)
+		}
+		if _, err := sdk.GetConsPubKeyBech32(rotation.ConsPubKey); err != nil {
+			return err
+		}
+		if pubKeyMap[rotation.ConsPubKey] {
+			return fmt.Errorf("duplicate consensus pubkey in genesis state: %s", rotation.ConsPubKey)
+		}
+		pubKeyMap[rotation.ConsPubKey] = true
+	}
+	return nil
+}
@@ -27,13 +27,13 @@ func TestInitGenesis(t *testing.T) {
 
 	// initialize the validators
 	validators[0].OperatorAddress = sdk.ValAddress(keep.Addrs[0])
-	validators[0].ConsPubKey = keep.PKs[0]
+	validators[0].ConsPubKey = sdk.MustBech32ifyConsPub(keep.PKs[0])
 	validators[0].Description = NewDescription("hoop", "", "", "", "")
 	validators[0].Status = sdk.Bonded
 	validators[0].Tokens = valTokens
 	validators[0].DelegatorShares = valTokens.ToDec()
 	validators[1].OperatorAddress = sdk.ValAddress(keep.Addrs[1])
-	validators[1].ConsPubKey = keep.PKs[1]
+	validators[1].ConsPubKey = sdk.MustBech32ifyConsPub(keep.PKs[1])
 	validators[1].Description = NewDescription("bloop", "", "", "", "")
 	validators[1].Status = sdk.Bonded
 	validators[1].Tokens = valTokens
@@ -126,6 +126,31 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = sdk.Bonded
 		}, true},
+		// validate tokenize share records
+		{"valid tokenize share record", func(data *types.GenesisState) {
+			data.LastTokenizeShareID = 1
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{
+				types.NewTokenizeShareRecord(genValidators1[0].OperatorAddress, types.TokenizeShareDenom(1)),
+			}
+		}, false},
+		{"tokenize share id above last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{
+				types.NewTokenizeShareRecord(genValidators1[0].OperatorAddress, types.TokenizeShareDenom(1)),
+			}
+		}, true},
+		{"invalid tokenize share denom", func(data *types.GenesisState) {
+			data.LastTokenizeShareID = 1
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{
+				types.NewTokenizeShareRecord(genValidators1[0].OperatorAddress, sdk.DefaultBondDenom),
+			}
+		}, true},
+		{"duplicate tokenize share validator", func(data *types.GenesisState) {
+			data.LastTokenizeShareID = 2
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{
+				types.NewTokenizeShareRecord(genValidators1[0].OperatorAddress, types.TokenizeShareDenom(1)),
+				types.NewTokenizeShareRecord(genValidators1[0].OperatorAddress, types.TokenizeShareDenom(2)),
+			}
+		}, true},
 	}
 
 	for _, tt := range tests {
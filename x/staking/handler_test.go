@@ -117,7 +117,7 @@ func TestDuplicatesMsgCreateValidator(t *testing.T) {
 	require.True(t, found)
 	assert.Equal(t, sdk.Bonded, validator.Status)
 	assert.Equal(t, addr1, validator.OperatorAddress)
-	assert.Equal(t, pk1, validator.ConsPubKey)
+	assert.Equal(t, pk1, validator.GetConsPubKey())
 	assert.Equal(t, valTokens, validator.BondedTokens())
 	assert.Equal(t, valTokens.ToDec(), validator.DelegatorShares)
 	assert.Equal(t, Description{}, validator.Description)
@@ -146,7 +146,7 @@ func TestDuplicatesMsgCreateValidator(t *testing.T) {
 	require.True(t, found)
 	assert.Equal(t, sdk.Bonded, validator.Status)
 	assert.Equal(t, addr2, validator.OperatorAddress)
-	assert.Equal(t, pk2, validator.ConsPubKey)
+	assert.Equal(t, pk2, validator.GetConsPubKey())
 	assert.True(sdk.IntEq(t, valTokens, validator.Tokens))
 	assert.True(sdk.DecEq(t, valTokens.ToDec(), validator.DelegatorShares))
 	assert.Equal(t, Description{}, validator.Description)
@@ -330,6 +330,53 @@ func TestIncrementsMsgDelegate(t *testing.T) {
 	}
 }
 
+func TestCreateValidatorBelowMinCommissionRate(t *testing.T) {
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 100)
+
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	keeper.SetParams(ctx, params)
+
+	msgCreateValidator := NewTestMsgCreateValidatorWithCommission(
+		validatorAddr, keep.PKs[0], sdk.TokensFromConsensusPower(10), sdk.NewDecWithPrec(4, 2),
+	)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.False(t, got.IsOK(), "should not be able to create a validator below the min commission rate")
+
+	msgCreateValidator = NewTestMsgCreateValidatorWithCommission(
+		validatorAddr, keep.PKs[0], sdk.TokensFromConsensusPower(10), sdk.NewDecWithPrec(5, 2),
+	)
+	got = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+}
+
+func TestEditValidatorBelowMinCommissionRate(t *testing.T) {
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 100)
+
+	msgCreateValidator := NewTestMsgCreateValidatorWithCommission(
+		validatorAddr, keep.PKs[0], sdk.TokensFromConsensusPower(10), sdk.NewDecWithPrec(1, 1),
+	)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(25 * time.Hour))
+	newRate := sdk.NewDecWithPrec(4, 2)
+	msgEditValidator := NewMsgEditValidator(validatorAddr, Description{}, &newRate, nil)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.False(t, got.IsOK(), "should not be able to edit the commission below the min commission rate")
+
+	newRate = sdk.NewDecWithPrec(5, 2)
+	msgEditValidator = NewMsgEditValidator(validatorAddr, Description{}, &newRate, nil)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.True(t, got.IsOK(), "expected edit-validator to be ok, got %v", got)
+}
+
 func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 	validatorAddr := sdk.ValAddress(keep.Addrs[0])
 
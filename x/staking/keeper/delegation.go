@@ -217,14 +217,15 @@ func (k Keeper) GetUBDQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (dvPa
 	if bz == nil {
 		return []types.DVPair{}
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &dvPairs)
-	return dvPairs
+	pairs := types.DVPairs{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pairs)
+	return pairs.Pairs
 }
 
 // Sets a specific unbonding queue timeslice.
 func (k Keeper) SetUBDQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []types.DVPair) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(keys)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&types.DVPairs{Pairs: keys})
 	store.Set(types.GetUnbondingDelegationTimeKey(timestamp), bz)
 }
 
@@ -242,6 +243,28 @@ func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
 	}
 }
 
+// removeUBDQueueEntry removes a single occurrence of the unbonding delegation
+// from the timeslice it was inserted into, deleting the timeslice once empty
+func (k Keeper) removeUBDQueueEntry(ctx sdk.Context, ubd types.UnbondingDelegation,
+	completionTime time.Time) {
+
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress.Equals(ubd.DelegatorAddress) &&
+			dvPair.ValidatorAddress.Equals(ubd.ValidatorAddress) {
+
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	} else {
+		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // Returns all the unbonding queue timeslices from time 0 until endTime
 func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -258,10 +281,10 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context,
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
 	unbondingTimesliceIterator := k.UBDQueueIterator(ctx, ctx.BlockHeader().Time)
 	for ; unbondingTimesliceIterator.Valid(); unbondingTimesliceIterator.Next() {
-		timeslice := []types.DVPair{}
+		timeslice := types.DVPairs{}
 		value := unbondingTimesliceIterator.Value()
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &timeslice)
-		matureUnbonds = append(matureUnbonds, timeslice...)
+		matureUnbonds = append(matureUnbonds, timeslice.Pairs...)
 		store.Delete(unbondingTimesliceIterator.Key())
 	}
 	return matureUnbonds
@@ -316,6 +339,21 @@ func (k Keeper) GetRedelegationsFromSrcValidator(ctx sdk.Context, valAddr sdk.Va
 	return reds
 }
 
+// return all redelegations to a particular validator
+func (k Keeper) GetRedelegationsToDstValidator(ctx sdk.Context, valAddr sdk.ValAddress) (reds []types.Redelegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetREDsToValDstIndexKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := types.GetREDKeyFromValDstIndexKey(iterator.Key())
+		value := store.Get(key)
+		red := types.MustUnmarshalRED(k.cdc, value)
+		reds = append(reds, red)
+	}
+	return reds
+}
+
 // check if validator is receiving a redelegation
 func (k Keeper) HasReceivingRedelegation(ctx sdk.Context,
 	delAddr sdk.AccAddress, valDstAddr sdk.ValAddress) bool {
@@ -328,6 +366,29 @@ func (k Keeper) HasReceivingRedelegation(ctx sdk.Context,
 	return iterator.Valid()
 }
 
+// HasDelegatorStake returns true if the delegator has any delegation,
+// unbonding delegation or redelegation, i.e. if tokens are staked or being
+// unbonded on its behalf.
+func (k Keeper) HasDelegatorStake(ctx sdk.Context, delegator sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, prefix := range [][]byte{
+		types.GetDelegationsKey(delegator),
+		types.GetUBDsKey(delegator),
+		types.GetREDsKey(delegator),
+	} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		found := iterator.Valid()
+		iterator.Close()
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
 // HasMaxRedelegationEntries - redelegation has maximum number of entries
 func (k Keeper) HasMaxRedelegationEntries(ctx sdk.Context,
 	delegatorAddr sdk.AccAddress, validatorSrcAddr,
@@ -403,14 +464,15 @@ func (k Keeper) GetRedelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Ti
 	if bz == nil {
 		return []types.DVVTriplet{}
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &dvvTriplets)
-	return dvvTriplets
+	triplets := types.DVVTriplets{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &triplets)
+	return triplets.Triplets
 }
 
 // Sets a specific redelegation queue timeslice.
 func (k Keeper) SetRedelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []types.DVVTriplet) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(keys)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&types.DVVTriplets{Triplets: keys})
 	store.Set(types.GetRedelegationTimeKey(timestamp), bz)
 }
 
@@ -432,6 +494,29 @@ func (k Keeper) InsertRedelegationQueue(ctx sdk.Context, red types.Redelegation,
 	}
 }
 
+// removeRedelegationQueueEntry removes a single occurrence of the redelegation
+// from the timeslice it was inserted into, deleting the timeslice once empty
+func (k Keeper) removeRedelegationQueueEntry(ctx sdk.Context, red types.Redelegation,
+	completionTime time.Time) {
+
+	timeSlice := k.GetRedelegationQueueTimeSlice(ctx, completionTime)
+	for i, dvvTriplet := range timeSlice {
+		if dvvTriplet.DelegatorAddress.Equals(red.DelegatorAddress) &&
+			dvvTriplet.ValidatorSrcAddress.Equals(red.ValidatorSrcAddress) &&
+			dvvTriplet.ValidatorDstAddress.Equals(red.ValidatorDstAddress) {
+
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.GetRedelegationTimeKey(completionTime))
+	} else {
+		k.SetRedelegationQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // Returns all the redelegation queue timeslices from time 0 until endTime
 func (k Keeper) RedelegationQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -445,10 +530,10 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
 	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, ctx.BlockHeader().Time)
 	for ; redelegationTimesliceIterator.Valid(); redelegationTimesliceIterator.Next() {
-		timeslice := []types.DVVTriplet{}
+		timeslice := types.DVVTriplets{}
 		value := redelegationTimesliceIterator.Value()
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &timeslice)
-		matureRedelegations = append(matureRedelegations, timeslice...)
+		matureRedelegations = append(matureRedelegations, timeslice.Triplets...)
 		store.Delete(redelegationTimesliceIterator.Key())
 	}
 	return matureRedelegations
@@ -630,18 +715,32 @@ func (k Keeper) Undelegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
 ) (time.Time, sdk.Error) {
 
+	_, completionTime, err := k.UndelegateTo(ctx, delAddr, valAddr, delAddr, sharesAmount)
+	return completionTime, err
+}
+
+// UndelegateTo unbonds an amount of delegator shares from a given validator
+// like Undelegate, except that the unbonding object is created for the
+// recipient, which receives the unbonded tokens once the unbonding period has
+// elapsed. It returns the amount of unbonding tokens along with the completion
+// time.
+func (k Keeper) UndelegateTo(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, recipientAddr sdk.AccAddress,
+	sharesAmount sdk.Dec,
+) (sdk.Int, time.Time, sdk.Error) {
+
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
-		return time.Time{}, types.ErrNoDelegatorForAddress(k.Codespace())
+		return sdk.ZeroInt(), time.Time{}, types.ErrNoDelegatorForAddress(k.Codespace())
 	}
 
-	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
-		return time.Time{}, types.ErrMaxUnbondingDelegationEntries(k.Codespace())
+	if k.HasMaxUnbondingDelegationEntries(ctx, recipientAddr, valAddr) {
+		return sdk.ZeroInt(), time.Time{}, types.ErrMaxUnbondingDelegationEntries(k.Codespace())
 	}
 
 	returnAmount, err := k.unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
-		return time.Time{}, err
+		return sdk.ZeroInt(), time.Time{}, err
 	}
 
 	// transfer the validator tokens to the not bonded pool
@@ -650,10 +749,10 @@ func (k Keeper) Undelegate(
 	}
 
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
-	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	ubd := k.SetUnbondingDelegationEntry(ctx, recipientAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
-	return completionTime, nil
+	return returnAmount, completionTime, nil
 }
 
 // CompleteUnbonding completes the unbonding of all mature entries in the
@@ -696,6 +795,60 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress,
 	return nil
 }
 
+// CancelUnbondingDelegation cancels the unbonding delegation entry created at
+// the given height and delegates its remaining balance back to the validator it
+// was unbonded from. Since the entry balance already accounts for any slashing
+// applied to it, only the tokens still held for the entry are re-delegated. It
+// returns the amount of tokens re-delegated.
+func (k Keeper) CancelUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, creationHeight int64) (sdk.Int, sdk.Error) {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroInt(), types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.ZeroInt(), types.ErrNoUnbondingDelegation(k.Codespace())
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return sdk.ZeroInt(), types.ErrNoUnbondingDelegationEntry(k.Codespace(), creationHeight)
+	}
+
+	// mature entries are completed by the EndBlocker of the current block
+	entry := ubd.Entries[entryIndex]
+	if entry.IsMature(ctx.BlockHeader().Time) {
+		return sdk.ZeroInt(), types.ErrUnbondingDelegationEntryMature(k.Codespace())
+	}
+
+	// the tokens are held by the not bonded pool until the entry matures
+	if entry.Balance.IsPositive() {
+		_, err := k.Delegate(ctx, delAddr, entry.Balance, sdk.Unbonding, validator, false)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+	}
+
+	ubd.RemoveEntry(int64(entryIndex))
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+	k.removeUBDQueueEntry(ctx, ubd, entry.CompletionTime)
+
+	return entry.Balance, nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec) (
@@ -166,6 +166,29 @@ func TestUnbondingDelegation(t *testing.T) {
 
 }
 
+func TestHasDelegatorStake(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 0)
+
+	require.False(t, keeper.HasDelegatorStake(ctx, addrDels[0]))
+
+	delegation := types.NewDelegation(addrDels[0], addrVals[0], sdk.NewDec(5))
+	keeper.SetDelegation(ctx, delegation)
+	require.True(t, keeper.HasDelegatorStake(ctx, addrDels[0]))
+	keeper.RemoveDelegation(ctx, delegation)
+	require.False(t, keeper.HasDelegatorStake(ctx, addrDels[0]))
+
+	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 0, time.Unix(0, 0), sdk.NewInt(5))
+	keeper.SetUnbondingDelegation(ctx, ubd)
+	require.True(t, keeper.HasDelegatorStake(ctx, addrDels[0]))
+	keeper.RemoveUnbondingDelegation(ctx, ubd)
+	require.False(t, keeper.HasDelegatorStake(ctx, addrDels[0]))
+
+	red := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0, time.Unix(0, 0), sdk.NewInt(5), sdk.NewDec(5))
+	keeper.SetRedelegation(ctx, red)
+	require.True(t, keeper.HasDelegatorStake(ctx, addrDels[0]))
+	require.False(t, keeper.HasDelegatorStake(ctx, addrDels[1]))
+}
+
 func TestUnbondDelegation(t *testing.T) {
 	ctx, _, keeper, _ := CreateTestInput(t, false, 0)
 
@@ -943,3 +966,77 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 100)
+	delTokens := sdk.TokensFromConsensusPower(10)
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+
+	_, err := keeper.Delegate(ctx, addrDels[0], delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	// create two unbonding delegation entries at different heights
+	unbondTokens := sdk.TokensFromConsensusPower(4)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(100, 0))
+	completionTime1, err := keeper.Undelegate(ctx, addrDels[0], addrVals[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(time.Unix(200, 0))
+	completionTime2, err := keeper.Undelegate(ctx, addrDels[0], addrVals[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+
+	bondedTokens := keeper.GetBondedPool(ctx).GetCoins().AmountOf(keeper.BondDenom(ctx))
+	notBondedTokens := keeper.GetNotBondedPool(ctx).GetCoins().AmountOf(keeper.BondDenom(ctx))
+
+	// an unknown creation height cannot be cancelled
+	_, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 12)
+	require.Equal(t, types.ErrNoUnbondingDelegationEntry(keeper.Codespace(), 12).Code(), err.Code())
+
+	// cancel the first entry
+	amount, err := keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 10)
+	require.NoError(t, err)
+	require.Equal(t, unbondTokens, amount)
+
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(unbondTokens).ToDec(), delegation.Shares)
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, int64(11), ubd.Entries[0].CreationHeight)
+
+	require.Empty(t, keeper.GetUBDQueueTimeSlice(ctx, completionTime1))
+	require.Len(t, keeper.GetUBDQueueTimeSlice(ctx, completionTime2), 1)
+
+	// the re-delegated tokens move back to the bonded pool
+	require.Equal(t, bondedTokens.Add(unbondTokens), keeper.GetBondedPool(ctx).GetCoins().AmountOf(keeper.BondDenom(ctx)))
+	require.Equal(t, notBondedTokens.Sub(unbondTokens), keeper.GetNotBondedPool(ctx).GetCoins().AmountOf(keeper.BondDenom(ctx)))
+
+	// only the balance left after slashing is re-delegated
+	slashAmount := keeper.slashUnbondingDelegation(ctx, ubd, 11, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, unbondTokens.QuoRaw(2), slashAmount)
+
+	amount, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 11)
+	require.NoError(t, err)
+	require.Equal(t, unbondTokens.QuoRaw(2), amount)
+
+	_, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+	require.Empty(t, keeper.GetUBDQueueTimeSlice(ctx, completionTime2))
+
+	// mature entries cannot be cancelled
+	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(keeper.UnbondingTime(ctx)))
+	_, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 11)
+	require.Equal(t, types.ErrUnbondingDelegationEntryMature(keeper.Codespace()).Code(), err.Code())
+}
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// queryServer implements the staking gRPC query service on top of a Keeper.
+type queryServer struct {
+	keeper Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the staking gRPC query service
+// backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{keeper: k}
+}
+
+// defaultQueryLimit is the page size used by the list queries other than
+// Validators when a request doesn't set a limit.
+const defaultQueryLimit = 100
+
+// pageBounds returns the bounds of the requested page of a list of numObjs
+// objects. An unset page defaults to the first one and an unset limit to
+// defLimit. An empty range is returned when the page is out of bounds.
+func pageBounds(numObjs int, page, limit uint32, defLimit int) (start, end int) {
+	if page == 0 {
+		page = 1
+	}
+
+	start, end = client.Paginate(numObjs, int(page), int(limit), defLimit)
+	if start < 0 || end < 0 {
+		return 0, 0
+	}
+
+	return start, end
+}
+
+// Validators implements the Query/Validators gRPC method.
+func (q queryServer) Validators(goCtx context.Context, req *types.QueryValidatorsRequest) (*types.QueryValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	validators := q.keeper.GetAllValidators(ctx)
+
+	filtered := make([]types.Validator, 0, len(validators))
+	for _, val := range validators {
+		if req.Status == "" || strings.EqualFold(val.GetStatus().String(), req.Status) {
+			filtered = append(filtered, val)
+		}
+	}
+
+	start, end := pageBounds(len(filtered), req.Page, req.Limit, int(q.keeper.MaxValidators(ctx)))
+
+	return &types.QueryValidatorsResponse{Validators: filtered[start:end]}, nil
+}
+
+// Validator implements the Query/Validator gRPC method.
+func (q queryServer) Validator(goCtx context.Context, req *types.QueryValidatorRequest) (*types.QueryValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ValidatorAddr.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	validator, found := q.keeper.GetValidator(ctx, req.ValidatorAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorResponse{Validator: validator}, nil
+}
+
+// ValidatorDelegations implements the Query/ValidatorDelegations gRPC method.
+func (q queryServer) ValidatorDelegations(goCtx context.Context, req *types.QueryValidatorDelegationsRequest) (*types.QueryValidatorDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ValidatorAddr.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegations := q.keeper.GetValidatorDelegations(ctx, req.ValidatorAddr)
+	start, end := pageBounds(len(delegations), req.Page, req.Limit, defaultQueryLimit)
+
+	return &types.QueryValidatorDelegationsResponse{Delegations: delegations[start:end]}, nil
+}
+
+// ValidatorUnbondingDelegations implements the
+// Query/ValidatorUnbondingDelegations gRPC method.
+func (q queryServer) ValidatorUnbondingDelegations(goCtx context.Context, req *types.QueryValidatorUnbondingDelegationsRequest) (*types.QueryValidatorUnbondingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ValidatorAddr.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	unbonds := q.keeper.GetUnbondingDelegationsFromValidator(ctx, req.ValidatorAddr)
+	start, end := pageBounds(len(unbonds), req.Page, req.Limit, defaultQueryLimit)
+
+	return &types.QueryValidatorUnbondingDelegationsResponse{UnbondingDelegations: unbonds[start:end]}, nil
+}
+
+// Delegation implements the Query/Delegation gRPC method.
+func (q queryServer) Delegation(goCtx context.Context, req *types.QueryDelegationRequest) (*types.QueryDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.DelegatorAddr.Empty() || req.ValidatorAddr.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "delegator and validator addresses cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegation, found := q.keeper.GetDelegation(ctx, req.DelegatorAddr, req.ValidatorAddr)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound, "delegation with delegator %s not found for validator %s", req.DelegatorAddr, req.ValidatorAddr,
+		)
+	}
+
+	validator, found := q.keeper.GetValidator(ctx, req.ValidatorAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	balance := sdk.NewCoin(q.keeper.BondDenom(ctx), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	return &types.QueryDelegationResponse{Delegation: delegation, Balance: balance}, nil
+}
+
+// UnbondingDelegation implements the Query/UnbondingDelegation gRPC method.
+func (q queryServer) UnbondingDelegation(goCtx context.Context, req *types.QueryUnbondingDelegationRequest) (*types.QueryUnbondingDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.DelegatorAddr.Empty() || req.ValidatorAddr.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "delegator and validator addresses cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	unbond, found := q.keeper.GetUnbondingDelegation(ctx, req.DelegatorAddr, req.ValidatorAddr)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound, "unbonding delegation with delegator %s not found for validator %s", req.DelegatorAddr, req.ValidatorAddr,
+		)
+	}
+
+	return &types.QueryUnbondingDelegationResponse{Unbond: unbond}, nil
+}
+
+// DelegatorDelegations implements the Query/DelegatorDelegations gRPC method.
+func (q queryServer) DelegatorDelegations(goCtx context.Context, req *types.QueryDelegatorDelegationsRequest) (*types.QueryDelegatorDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.DelegatorAddr.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegations := q.keeper.GetAllDelegatorDelegations(ctx, req.DelegatorAddr)
+	start, end := pageBounds(len(delegations), req.Page, req.Limit, defaultQueryLimit)
+
+	return &types.QueryDelegatorDelegationsResponse{Delegations: delegations[start:end]}, nil
+}
+
+// DelegatorUnbondingDelegations implements the
+// Query/DelegatorUnbondingDelegations gRPC method.
+func (q queryServer) DelegatorUnbondingDelegations(goCtx context.Context, req *types.QueryDelegatorUnbondingDelegationsRequest) (*types.QueryDelegatorUnbondingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.DelegatorAddr.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	unbonds := q.keeper.GetAllUnbondingDelegations(ctx, req.DelegatorAddr)
+	start, end := pageBounds(len(unbonds), req.Page, req.Limit, defaultQueryLimit)
+
+	return &types.QueryDelegatorUnbondingDelegationsResponse{UnbondingDelegations: unbonds[start:end]}, nil
+}
+
+// Pool implements the Query/Pool gRPC method.
+func (q queryServer) Pool(goCtx context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	bondDenom := q.keeper.BondDenom(ctx)
+	bondedPool := q.keeper.GetBondedPool(ctx)
+	notBondedPool := q.keeper.GetNotBondedPool(ctx)
+	if bondedPool == nil || notBondedPool == nil {
+		return nil, status.Error(codes.Internal, "pool accounts haven't been set")
+	}
+
+	return &types.QueryPoolResponse{
+		NotBondedTokens: notBondedPool.GetCoins().AmountOf(bondDenom),
+		BondedTokens:    bondedPool.GetCoins().AmountOf(bondDenom),
+	}, nil
+}
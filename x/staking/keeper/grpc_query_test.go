@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupGRPCQueryTest creates two validators, delegates from addrAcc2 to both
+// of them and starts unbonding part of the delegation to the first one.
+func setupGRPCQueryTest(t *testing.T) (sdk.Context, Keeper, types.QueryServer) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 10000)
+
+	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
+	keeper.SetValidator(ctx, val1)
+	keeper.SetValidatorByPowerIndex(ctx, val1)
+
+	val2 := types.NewValidator(addrVal2, pk2, types.Description{})
+	keeper.SetValidator(ctx, val2)
+	keeper.SetValidatorByPowerIndex(ctx, val2)
+
+	delTokens := sdk.TokensFromConsensusPower(20)
+	_, err := keeper.Delegate(ctx, addrAcc2, delTokens, sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+	val2, _ = keeper.GetValidator(ctx, addrVal2)
+	_, err = keeper.Delegate(ctx, addrAcc2, delTokens, sdk.Unbonded, val2, true)
+	require.NoError(t, err)
+
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	_, err = keeper.Undelegate(ctx, addrAcc2, addrVal1, sdk.TokensFromConsensusPower(5).ToDec())
+	require.NoError(t, err)
+
+	return ctx, keeper, NewQueryServer(keeper)
+}
+
+func TestGRPCQueryValidators(t *testing.T) {
+	ctx, _, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryValidatorsRequest
+		expCode codes.Code
+		expLen  int
+	}{
+		{"nil request", nil, codes.InvalidArgument, 0},
+		{"all statuses", &types.QueryValidatorsRequest{}, codes.OK, 2},
+		{"bonded only", &types.QueryValidatorsRequest{Status: sdk.BondStatusBonded}, codes.OK, 2},
+		{"unbonded only", &types.QueryValidatorsRequest{Status: sdk.BondStatusUnbonded}, codes.OK, 0},
+		{"first page", &types.QueryValidatorsRequest{Page: 1, Limit: 1}, codes.OK, 1},
+		{"second page", &types.QueryValidatorsRequest{Page: 2, Limit: 1}, codes.OK, 1},
+		{"page out of bounds", &types.QueryValidatorsRequest{Page: 3, Limit: 1}, codes.OK, 0},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.Validators(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Len(t, res.Validators, tc.expLen, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryValidator(t *testing.T) {
+	ctx, keeper, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryValidatorRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"empty address", &types.QueryValidatorRequest{}, codes.InvalidArgument},
+		{"unknown validator", &types.QueryValidatorRequest{ValidatorAddr: sdk.ValAddress(Addrs[2])}, codes.NotFound},
+		{"existing validator", &types.QueryValidatorRequest{ValidatorAddr: addrVal1}, codes.OK},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.Validator(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			validator, found := keeper.GetValidator(ctx, addrVal1)
+			require.True(t, found)
+			require.True(ValEq(t, validator, res.Validator))
+		}
+	}
+}
+
+func TestGRPCQueryValidatorDelegations(t *testing.T) {
+	ctx, _, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryValidatorDelegationsRequest
+		expCode codes.Code
+		expLen  int
+	}{
+		{"nil request", nil, codes.InvalidArgument, 0},
+		{"empty address", &types.QueryValidatorDelegationsRequest{}, codes.InvalidArgument, 0},
+		{"unknown validator", &types.QueryValidatorDelegationsRequest{ValidatorAddr: sdk.ValAddress(Addrs[2])}, codes.OK, 0},
+		{"existing validator", &types.QueryValidatorDelegationsRequest{ValidatorAddr: addrVal1}, codes.OK, 1},
+		{"page out of bounds", &types.QueryValidatorDelegationsRequest{ValidatorAddr: addrVal1, Page: 2}, codes.OK, 0},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.ValidatorDelegations(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Len(t, res.Delegations, tc.expLen, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryValidatorUnbondingDelegations(t *testing.T) {
+	ctx, _, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryValidatorUnbondingDelegationsRequest
+		expCode codes.Code
+		expLen  int
+	}{
+		{"nil request", nil, codes.InvalidArgument, 0},
+		{"empty address", &types.QueryValidatorUnbondingDelegationsRequest{}, codes.InvalidArgument, 0},
+		{"no unbonding", &types.QueryValidatorUnbondingDelegationsRequest{ValidatorAddr: addrVal2}, codes.OK, 0},
+		{"existing unbonding", &types.QueryValidatorUnbondingDelegationsRequest{ValidatorAddr: addrVal1}, codes.OK, 1},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.ValidatorUnbondingDelegations(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Len(t, res.UnbondingDelegations, tc.expLen, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryDelegation(t *testing.T) {
+	ctx, keeper, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryDelegationRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"empty delegator", &types.QueryDelegationRequest{ValidatorAddr: addrVal1}, codes.InvalidArgument},
+		{"empty validator", &types.QueryDelegationRequest{DelegatorAddr: addrAcc2}, codes.InvalidArgument},
+		{"unknown delegation", &types.QueryDelegationRequest{DelegatorAddr: addrAcc1, ValidatorAddr: addrVal1}, codes.NotFound},
+		{"existing delegation", &types.QueryDelegationRequest{DelegatorAddr: addrAcc2, ValidatorAddr: addrVal1}, codes.OK},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.Delegation(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			delegation, found := keeper.GetDelegation(ctx, addrAcc2, addrVal1)
+			require.True(t, found)
+			require.Equal(t, delegation, res.Delegation, tc.msg)
+			require.Equal(t, sdk.NewCoin(keeper.BondDenom(ctx), sdk.TokensFromConsensusPower(15)), res.Balance, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryUnbondingDelegation(t *testing.T) {
+	ctx, keeper, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryUnbondingDelegationRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"empty delegator", &types.QueryUnbondingDelegationRequest{ValidatorAddr: addrVal1}, codes.InvalidArgument},
+		{"empty validator", &types.QueryUnbondingDelegationRequest{DelegatorAddr: addrAcc2}, codes.InvalidArgument},
+		{"unknown unbonding", &types.QueryUnbondingDelegationRequest{DelegatorAddr: addrAcc2, ValidatorAddr: addrVal2}, codes.NotFound},
+		{"existing unbonding", &types.QueryUnbondingDelegationRequest{DelegatorAddr: addrAcc2, ValidatorAddr: addrVal1}, codes.OK},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.UnbondingDelegation(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			unbond, found := keeper.GetUnbondingDelegation(ctx, addrAcc2, addrVal1)
+			require.True(t, found)
+			require.Equal(t, unbond, res.Unbond, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryDelegatorDelegations(t *testing.T) {
+	ctx, _, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryDelegatorDelegationsRequest
+		expCode codes.Code
+		expLen  int
+	}{
+		{"nil request", nil, codes.InvalidArgument, 0},
+		{"empty address", &types.QueryDelegatorDelegationsRequest{}, codes.InvalidArgument, 0},
+		{"no delegations", &types.QueryDelegatorDelegationsRequest{DelegatorAddr: addrAcc1}, codes.OK, 0},
+		{"all delegations", &types.QueryDelegatorDelegationsRequest{DelegatorAddr: addrAcc2}, codes.OK, 2},
+		{"first page", &types.QueryDelegatorDelegationsRequest{DelegatorAddr: addrAcc2, Page: 1, Limit: 1}, codes.OK, 1},
+		{"page out of bounds", &types.QueryDelegatorDelegationsRequest{DelegatorAddr: addrAcc2, Page: 2, Limit: 2}, codes.OK, 0},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.DelegatorDelegations(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Len(t, res.Delegations, tc.expLen, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryDelegatorUnbondingDelegations(t *testing.T) {
+	ctx, _, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryDelegatorUnbondingDelegationsRequest
+		expCode codes.Code
+		expLen  int
+	}{
+		{"nil request", nil, codes.InvalidArgument, 0},
+		{"empty address", &types.QueryDelegatorUnbondingDelegationsRequest{}, codes.InvalidArgument, 0},
+		{"no unbondings", &types.QueryDelegatorUnbondingDelegationsRequest{DelegatorAddr: addrAcc1}, codes.OK, 0},
+		{"existing unbonding", &types.QueryDelegatorUnbondingDelegationsRequest{DelegatorAddr: addrAcc2}, codes.OK, 1},
+		{"page out of bounds", &types.QueryDelegatorUnbondingDelegationsRequest{DelegatorAddr: addrAcc2, Page: 2}, codes.OK, 0},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.DelegatorUnbondingDelegations(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Len(t, res.UnbondingDelegations, tc.expLen, tc.msg)
+		}
+	}
+}
+
+func TestGRPCQueryPool(t *testing.T) {
+	ctx, keeper, queryServer := setupGRPCQueryTest(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := queryServer.Pool(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := queryServer.Pool(goCtx, &types.QueryPoolRequest{})
+	require.NoError(t, err)
+
+	bondDenom := keeper.BondDenom(ctx)
+	require.Equal(t, keeper.GetBondedPool(ctx).GetCoins().AmountOf(bondDenom), res.BondedTokens)
+	require.Equal(t, keeper.GetNotBondedPool(ctx).GetCoins().AmountOf(bondDenom), res.NotBondedTokens)
+	require.True(t, res.NotBondedTokens.GTE(sdk.TokensFromConsensusPower(5)))
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetHistoricalInfo gets the historical info of a given height
+func (k Keeper) GetHistoricalInfo(ctx sdk.Context, height int64) (hi types.HistoricalInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetHistoricalInfoKey(height))
+	if value == nil {
+		return hi, false
+	}
+
+	hi = types.MustUnmarshalHistoricalInfo(k.cdc, value)
+	return hi, true
+}
+
+// SetHistoricalInfo sets the historical info of a given height
+func (k Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi types.HistoricalInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHistoricalInfoKey(height), types.MustMarshalHistoricalInfo(k.cdc, hi))
+}
+
+// DeleteHistoricalInfo deletes the historical info of a given height
+func (k Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHistoricalInfoKey(height))
+}
+
+// TrackHistoricalInfo saves the header and the last validator set of the
+// current height and prunes the historical infos older than the number of
+// recent heights set by the HistoricalEntries param.
+func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
+	entryNum := k.HistoricalEntries(ctx)
+
+	// Prune the historical infos falling out of the kept heights, starting from
+	// the oldest one. This removes a single entry, unless the HistoricalEntries
+	// param was lowered or heights were skipped.
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HistoricalInfoKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		height := int64(binary.BigEndian.Uint64(iterator.Key()[len(types.HistoricalInfoKey):]))
+		if height > ctx.BlockHeight()-int64(entryNum) {
+			break
+		}
+		store.Delete(iterator.Key())
+	}
+
+	// historical infos are disabled
+	if entryNum == 0 {
+		return
+	}
+
+	lastVals := k.GetLastValidators(ctx)
+	historicalEntry := types.NewHistoricalInfo(ctx.BlockHeader(), lastVals)
+	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), historicalEntry)
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestHistoricalInfo(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 10)
+	validators := make([]types.Validator, len(addrVals))
+
+	for i, valAddr := range addrVals {
+		validators[i] = types.NewValidator(valAddr, PKs[i], types.Description{})
+	}
+
+	hi := types.NewHistoricalInfo(ctx.BlockHeader(), validators)
+
+	keeper.SetHistoricalInfo(ctx, 2, hi)
+
+	recv, found := keeper.GetHistoricalInfo(ctx, 2)
+	require.True(t, found, "HistoricalInfo not found after set")
+	require.Equal(t, hi.Header, recv.Header, "HistoricalInfo not equal")
+	require.Len(t, recv.Valset, len(hi.Valset))
+	require.True(t, sort.IsSorted(types.Validators(recv.Valset)), "HistoricalInfo validators is not sorted")
+
+	keeper.DeleteHistoricalInfo(ctx, 2)
+
+	recv, found = keeper.GetHistoricalInfo(ctx, 2)
+	require.False(t, found, "HistoricalInfo found after delete")
+	require.Equal(t, types.HistoricalInfo{}, recv, "HistoricalInfo is not empty")
+}
+
+func TestTrackHistoricalInfo(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 10)
+
+	// set historical entries in params to 5
+	params := types.DefaultParams()
+	params.HistoricalEntries = 5
+	keeper.SetParams(ctx, params)
+
+	// set historical info at 5, 4 which should be pruned
+	// and check that it has been stored
+	h4 := abci.Header{
+		ChainID: "HelloChain",
+		Height:  4,
+	}
+	h5 := abci.Header{
+		ChainID: "HelloChain",
+		Height:  5,
+	}
+	valSet := []types.Validator{
+		types.NewValidator(sdk.ValAddress(Addrs[0]), PKs[0], types.Description{}),
+		types.NewValidator(sdk.ValAddress(Addrs[1]), PKs[1], types.Description{}),
+	}
+	hi4 := types.NewHistoricalInfo(h4, valSet)
+	hi5 := types.NewHistoricalInfo(h5, valSet)
+	keeper.SetHistoricalInfo(ctx, 4, hi4)
+	keeper.SetHistoricalInfo(ctx, 5, hi5)
+	recv, found := keeper.GetHistoricalInfo(ctx, 4)
+	require.True(t, found)
+	require.Equal(t, hi4.Header, recv.Header)
+	recv, found = keeper.GetHistoricalInfo(ctx, 5)
+	require.True(t, found)
+	require.Equal(t, hi5.Header, recv.Header)
+
+	// set last validators in keeper
+	val1 := types.NewValidator(sdk.ValAddress(Addrs[2]), PKs[2], types.Description{})
+	keeper.SetValidator(ctx, val1)
+	keeper.SetLastValidatorPower(ctx, val1.OperatorAddress, 10)
+	val2 := types.NewValidator(sdk.ValAddress(Addrs[3]), PKs[3], types.Description{})
+	vals := []types.Validator{val1, val2}
+	sort.Sort(types.Validators(vals))
+	keeper.SetValidator(ctx, val2)
+	keeper.SetLastValidatorPower(ctx, val2.OperatorAddress, 8)
+
+	// set validators that will not be stored
+	val3 := types.NewValidator(sdk.ValAddress(Addrs[4]), PKs[4], types.Description{})
+	keeper.SetValidator(ctx, val3)
+
+	// Set Header for BeginBlock context
+	header := abci.Header{
+		ChainID: "HelloChain",
+		Height:  10,
+	}
+	ctx = ctx.WithBlockHeader(header)
+
+	keeper.TrackHistoricalInfo(ctx)
+
+	// Check HistoricalInfo at height 10 is persisted
+	recv, found = keeper.GetHistoricalInfo(ctx, 10)
+	require.True(t, found, "GetHistoricalInfo failed after BeginBlock")
+	require.Equal(t, header, recv.Header, "GetHistoricalInfo returned unexpected header")
+	require.Len(t, recv.Valset, len(vals), "GetHistoricalInfo returned unexpected validator set")
+	for i := range vals {
+		require.Equal(t, vals[i].OperatorAddress, recv.Valset[i].OperatorAddress)
+	}
+
+	// Check HistoricalInfo at height 5, 4 is pruned
+	recv, found = keeper.GetHistoricalInfo(ctx, 4)
+	require.False(t, found, "GetHistoricalInfo did not prune earlier height")
+	require.Equal(t, types.HistoricalInfo{}, recv, "GetHistoricalInfo at height 4 is not empty after prune")
+	recv, found = keeper.GetHistoricalInfo(ctx, 5)
+	require.False(t, found, "GetHistoricalInfo did not prune first prune height")
+	require.Equal(t, types.HistoricalInfo{}, recv, "GetHistoricalInfo at height 5 is not empty after prune")
+
+	// disabling historical infos prunes the entry of the height itself
+	params.HistoricalEntries = 0
+	keeper.SetParams(ctx, params)
+	keeper.TrackHistoricalInfo(ctx)
+	_, found = keeper.GetHistoricalInfo(ctx, 10)
+	require.False(t, found, "GetHistoricalInfo stored info with historical entries disabled")
+
+	// the historical infos are pruned from the oldest one, even past the
+	// heights without historical info
+	params.HistoricalEntries = 3
+	keeper.SetParams(ctx, params)
+	for _, height := range []int64{2, 7, 11} {
+		keeper.SetHistoricalInfo(ctx, height, types.NewHistoricalInfo(abci.Header{Height: height}, valSet))
+	}
+
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "HelloChain", Height: 13})
+	keeper.TrackHistoricalInfo(ctx)
+
+	for _, height := range []int64{2, 7} {
+		_, found = keeper.GetHistoricalInfo(ctx, height)
+		require.False(t, found, "GetHistoricalInfo did not prune height %d", height)
+	}
+	for _, height := range []int64{11, 13} {
+		_, found = keeper.GetHistoricalInfo(ctx, height)
+		require.True(t, found, "GetHistoricalInfo pruned height %d", height)
+	}
+}
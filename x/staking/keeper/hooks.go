@@ -77,3 +77,17 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 		k.hooks.BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+
+// AfterValidatorOperatorChanged - call hook if registered
+func (k Keeper) AfterValidatorOperatorChanged(ctx sdk.Context, oldValAddr, newValAddr sdk.ValAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorOperatorChanged(ctx, oldValAddr, newValAddr)
+	}
+}
+
+// AfterConsPubKeyRotated - call hook if registered
+func (k Keeper) AfterConsPubKeyRotated(ctx sdk.Context, valAddr sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterConsPubKeyRotated(ctx, valAddr, oldConsAddr, newConsAddr)
+	}
+}
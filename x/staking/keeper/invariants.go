@@ -20,6 +20,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "tokenize-shares",
+		TokenizeSharesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -41,7 +43,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return TokenizeSharesInvariant(k)(ctx)
 	}
 }
 
@@ -179,3 +186,59 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// TokenizeSharesInvariant checks that the supply of each tokenized share denom
+// is backed by at least as many shares of the delegation held by the tokenize
+// share pool to the validator of that denom, and that the rewards of the pool
+// delegations do not build up in the tokenize share pool.
+func TokenizeSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken bool
+
+		supply := k.supplyKeeper.GetSupply(ctx).GetTotal()
+		poolAddr := k.supplyKeeper.GetModuleAddress(types.TokenizeSharePoolName)
+
+		var poolValAddrs []string
+		poolShares := make(map[string]sdk.Dec)
+		k.IterateDelegations(ctx, poolAddr, func(_ int64, delegation exported.DelegationI) bool {
+			valAddr := delegation.GetValidatorAddr().String()
+			poolValAddrs = append(poolValAddrs, valAddr)
+			poolShares[valAddr] = delegation.GetShares()
+			return false
+		})
+
+		k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+			valAddr := record.ValidatorAddress.String()
+			shares, ok := poolShares[valAddr]
+			if !ok {
+				shares = sdk.ZeroDec()
+			}
+			delete(poolShares, valAddr)
+
+			// the re-staked rewards add pool shares without minting share tokens
+			tokenized := supply.AmountOf(record.Denom).ToDec()
+			if tokenized.GT(shares) || tokenized.IsZero() != shares.IsZero() {
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %s: %s supply %v, tokenize share pool delegation shares %v\n",
+					valAddr, record.Denom, tokenized, shares)
+			}
+			return false
+		})
+
+		for _, valAddr := range poolValAddrs {
+			if shares, ok := poolShares[valAddr]; ok {
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %s: tokenize share pool delegation shares %v without a share denom\n",
+					valAddr, shares)
+			}
+		}
+
+		if poolAcc := k.authKeeper.GetAccount(ctx, poolAddr); poolAcc != nil && !poolAcc.GetCoins().IsZero() {
+			broken = true
+			msg += fmt.Sprintf("\ttokenize share pool holds %v\n", poolAcc.GetCoins())
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "tokenize shares", msg), broken
+	}
+}
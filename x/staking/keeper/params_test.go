@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestHistoricalEntriesMissingParam(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 10})
+
+	// upgraded chains do not hold the param until it is set
+	store := ctx.KVStore(app.GetKey(params.StoreKey))
+	store.Delete(append([]byte(staking.DefaultParamspace+"/"), staking.KeyHistoricalEntries...))
+
+	require.Equal(t, uint16(0), app.StakingKeeper.HistoricalEntries(ctx))
+	require.NotPanics(t, func() { app.StakingKeeper.TrackHistoricalInfo(ctx) })
+
+	_, found := app.StakingKeeper.GetHistoricalInfo(ctx, 10)
+	require.False(t, found)
+}
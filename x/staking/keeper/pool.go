@@ -16,6 +16,12 @@ func (k Keeper) GetNotBondedPool(ctx sdk.Context) (notBondedPool exported.Module
 	return k.supplyKeeper.GetModuleAccount(ctx, types.NotBondedPoolName)
 }
 
+// GetTokenizeSharePool returns the module account holding the delegations
+// backing tokenized shares
+func (k Keeper) GetTokenizeSharePool(ctx sdk.Context) (tokenizeSharePool exported.ModuleAccountI) {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.TokenizeSharePoolName)
+}
+
 // bondedTokensToNotBonded transfers coins from the bonded to the not bonded pool within staking
 func (k Keeper) bondedTokensToNotBonded(ctx sdk.Context, tokens sdk.Int) {
 	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), tokens))
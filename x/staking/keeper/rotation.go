@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// HasRotatedConsPubKey returns whether a validator rotated away from the
+// consensus pubkey with the given address
+func (k Keeper) HasRotatedConsPubKey(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetRotatedConsPubKeyKey(consAddr))
+}
+
+// SetConsPubKeyRotation records a consensus pubkey a validator rotated away
+// from, which keeps resolving to the validator by consensus address
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	consAddr := sdk.GetConsAddress(rotation.GetConsPubKey())
+	store.Set(types.GetRotatedConsPubKeyKey(consAddr), []byte(rotation.ConsPubKey))
+	store.Set(types.GetValidatorByConsAddrKey(consAddr), rotation.ValidatorAddress)
+}
+
+// IterateConsPubKeyRotations iterates over all the consensus pubkeys
+// validators rotated away from
+func (k Keeper) IterateConsPubKeyRotations(ctx sdk.Context, cb func(rotation types.ConsPubKeyRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RotatedConsPubKeyKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		consAddr := sdk.ConsAddress(iterator.Key()[1:])
+		rotation := types.ConsPubKeyRotation{
+			ValidatorAddress: store.Get(types.GetValidatorByConsAddrKey(consAddr)),
+			ConsPubKey:       string(iterator.Value()),
+		}
+		if cb(rotation) {
+			break
+		}
+	}
+}
+
+// GetAllConsPubKeyRotations returns all the consensus pubkeys validators
+// rotated away from
+func (k Keeper) GetAllConsPubKeyRotations(ctx sdk.Context) (rotations []types.ConsPubKeyRotation) {
+	k.IterateConsPubKeyRotations(ctx, func(rotation types.ConsPubKeyRotation) bool {
+		rotations = append(rotations, rotation)
+		return false
+	})
+	return rotations
+}
+
+// IterateRotatedConsPubKeys iterates over all the consensus pubkeys validators
+// rotated away from along with the operator address of their validator
+func (k Keeper) IterateRotatedConsPubKeys(ctx sdk.Context, cb func(valAddr sdk.ValAddress, pubKey crypto.PubKey) (stop bool)) {
+	k.IterateConsPubKeyRotations(ctx, func(rotation types.ConsPubKeyRotation) bool {
+		return cb(rotation.ValidatorAddress, rotation.GetConsPubKey())
+	})
+}
+
+// applyPendingConsPubKeyRotations calls the AfterConsPubKeyRotated hook for the
+// validators which rotated their consensus pubkey during the current block and
+// returns the pubkeys they rotated away from, by operator address. Only the
+// first pubkey of a validator rotating several times within a block is kept,
+// as it is the one Tendermint and the other modules know the validator by.
+func (k Keeper) applyPendingConsPubKeyRotations(ctx sdk.Context) map[[sdk.AddrLen]byte]crypto.PubKey {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	rotations := make(map[[sdk.AddrLen]byte]crypto.PubKey)
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[1:])
+		oldPubKey := sdk.MustGetConsPubKeyBech32(string(iterator.Value()))
+		store.Delete(iterator.Key())
+
+		// the validator may have been removed since it rotated its pubkey
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+		k.AfterConsPubKeyRotated(ctx, valAddr, sdk.GetConsAddress(oldPubKey), validator.GetConsAddr())
+
+		var valAddrBytes [sdk.AddrLen]byte
+		copy(valAddrBytes[:], valAddr)
+		rotations[valAddrBytes] = oldPubKey
+	}
+	return rotations
+}
+
+// RotateConsPubKey replaces the consensus pubkey of a validator. The rotated
+// pubkey keeps resolving to the validator. The rotation is applied by the next
+// ApplyAndReturnValidatorSetUpdates, which notifies Tendermint and calls the
+// AfterConsPubKeyRotated hook.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, valAddr sdk.ValAddress, pubKey crypto.PubKey) sdk.Error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	newConsAddr := sdk.GetConsAddress(pubKey)
+	if _, found := k.GetValidatorByConsAddr(ctx, newConsAddr); found || k.HasRotatedConsPubKey(ctx, newConsAddr) {
+		return types.ErrValidatorPubKeyExists(k.Codespace())
+	}
+
+	k.SetConsPubKeyRotation(ctx, types.NewConsPubKeyRotation(valAddr, validator.GetConsPubKey()))
+
+	store := ctx.KVStore(k.storeKey)
+	pendingKey := types.GetPendingConsPubKeyRotationKey(valAddr)
+	if !store.Has(pendingKey) {
+		store.Set(pendingKey, []byte(validator.ConsPubKey))
+	}
+
+	validator.ConsPubKey = sdk.MustBech32ifyConsPub(pubKey)
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+	return nil
+}
+
+// ChangeValidatorOperator moves a validator to the operator address of a new
+// account. All the delegations, unbonding delegations and redelegations of the
+// validator are moved to the new operator address, and its self-delegation is
+// moved to the new account. The new account must not already have a
+// delegation or a redelegation with the validator, and neither account can be
+// a vesting account as the self-delegation would leave the vesting schedule
+// it is tracked by.
+func (k Keeper) ChangeValidatorOperator(ctx sdk.Context, valAddr sdk.ValAddress, newOperator sdk.AccAddress) sdk.Error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	newValAddr := sdk.ValAddress(newOperator)
+	if newValAddr.Equals(valAddr) {
+		return types.ErrSameValidatorOperator(k.Codespace())
+	}
+	if _, found := k.GetValidator(ctx, newValAddr); found {
+		return types.ErrValidatorOwnerExists(k.Codespace())
+	}
+
+	for _, addr := range []sdk.AccAddress{sdk.AccAddress(valAddr), newOperator} {
+		if _, ok := k.authKeeper.GetAccount(ctx, addr).(vestexported.VestingAccount); ok {
+			return types.ErrChangeOperatorVestingAccount(k.Codespace())
+		}
+	}
+
+	if _, found := k.GetDelegation(ctx, newOperator, valAddr); found {
+		return types.ErrNewOperatorHasDelegation(k.Codespace())
+	}
+	if len(k.GetAllRedelegations(ctx, newOperator, valAddr, nil)) > 0 ||
+		len(k.GetAllRedelegations(ctx, newOperator, nil, valAddr)) > 0 {
+		return types.ErrNewOperatorHasDelegation(k.Codespace())
+	}
+
+	k.changeValidatorOperator(ctx, validator, newValAddr)
+	k.changeDelegationsValidator(ctx, valAddr, newValAddr)
+	k.changeUnbondingDelegationsValidator(ctx, valAddr, newValAddr)
+	k.changeRedelegationsValidator(ctx, valAddr, newValAddr)
+
+	k.AfterValidatorOperatorChanged(ctx, valAddr, newValAddr)
+	return nil
+}
+
+// move the validator and its indexes to the new operator address
+func (k Keeper) changeValidatorOperator(ctx sdk.Context, validator types.Validator, newValAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	oldValAddr := validator.OperatorAddress
+
+	if validator.IsUnbonding() {
+		k.DeleteValidatorQueue(ctx, validator)
+	}
+	k.DeleteValidatorByPowerIndex(ctx, validator)
+	store.Delete(types.GetValidatorKey(oldValAddr))
+
+	validator.OperatorAddress = newValAddr
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+	if !validator.Jailed {
+		k.SetValidatorByPowerIndex(ctx, validator)
+	}
+	if validator.IsUnbonding() {
+		k.InsertValidatorQueue(ctx, validator)
+	}
+
+	if store.Has(types.GetLastValidatorPowerKey(oldValAddr)) {
+		k.SetLastValidatorPower(ctx, newValAddr, k.GetLastValidatorPower(ctx, oldValAddr))
+		k.DeleteLastValidatorPower(ctx, oldValAddr)
+	}
+
+	pendingKey := types.GetPendingConsPubKeyRotationKey(oldValAddr)
+	if bz := store.Get(pendingKey); bz != nil {
+		store.Delete(pendingKey)
+		store.Set(types.GetPendingConsPubKeyRotationKey(newValAddr), bz)
+	}
+
+	// the rotated consensus pubkeys keep resolving to the validator
+	for _, rotation := range k.GetAllConsPubKeyRotations(ctx) {
+		if rotation.ValidatorAddress.Equals(oldValAddr) {
+			rotation.ValidatorAddress = newValAddr
+			k.SetConsPubKeyRotation(ctx, rotation)
+		}
+	}
+
+	if denom, found := k.GetTokenizeShareDenom(ctx, oldValAddr); found {
+		store.Delete(types.GetTokenizeShareDenomKey(oldValAddr))
+		k.SetTokenizeShareRecord(ctx, types.NewTokenizeShareRecord(newValAddr, denom))
+	}
+}
+
+// move the delegations to the new operator address, the self-delegation is
+// moved to the new operator account
+func (k Keeper) changeDelegationsValidator(ctx sdk.Context, oldValAddr, newValAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, delegation := range k.GetValidatorDelegations(ctx, oldValAddr) {
+		store.Delete(types.GetDelegationKey(delegation.DelegatorAddress, oldValAddr))
+
+		if delegation.DelegatorAddress.Equals(sdk.AccAddress(oldValAddr)) {
+			delegation.DelegatorAddress = sdk.AccAddress(newValAddr)
+		}
+		delegation.ValidatorAddress = newValAddr
+		k.SetDelegation(ctx, delegation)
+	}
+}
+
+// move the unbonding delegations and their queue entries to the new operator
+// address, the unbonding tokens are still returned to their delegator
+func (k Keeper) changeUnbondingDelegationsValidator(ctx sdk.Context, oldValAddr, newValAddr sdk.ValAddress) {
+	for _, ubd := range k.GetUnbondingDelegationsFromValidator(ctx, oldValAddr) {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+		for _, entry := range ubd.Entries {
+			k.removeUBDQueueEntry(ctx, ubd, entry.CompletionTime)
+		}
+
+		ubd.ValidatorAddress = newValAddr
+		k.SetUnbondingDelegation(ctx, ubd)
+		for _, entry := range ubd.Entries {
+			k.InsertUBDQueue(ctx, ubd, entry.CompletionTime)
+		}
+	}
+}
+
+// move the redelegations from and to the validator and their queue entries to
+// the new operator address. Redelegations of the self-delegation are moved to
+// the new operator account along with the delegation.
+func (k Keeper) changeRedelegationsValidator(ctx sdk.Context, oldValAddr, newValAddr sdk.ValAddress) {
+	reds := append(
+		k.GetRedelegationsFromSrcValidator(ctx, oldValAddr),
+		k.GetRedelegationsToDstValidator(ctx, oldValAddr)...,
+	)
+
+	for _, red := range reds {
+		k.RemoveRedelegation(ctx, red)
+		for _, entry := range red.Entries {
+			k.removeRedelegationQueueEntry(ctx, red, entry.CompletionTime)
+		}
+
+		if red.ValidatorSrcAddress.Equals(oldValAddr) {
+			red.ValidatorSrcAddress = newValAddr
+		}
+		if red.ValidatorDstAddress.Equals(oldValAddr) {
+			red.ValidatorDstAddress = newValAddr
+			if bytes.Equal(red.DelegatorAddress, oldValAddr) {
+				red.DelegatorAddress = sdk.AccAddress(newValAddr)
+			}
+		}
+
+		k.SetRedelegation(ctx, red)
+		for _, entry := range red.Entries {
+			k.InsertRedelegationQueue(ctx, red, entry.CompletionTime)
+		}
+	}
+}
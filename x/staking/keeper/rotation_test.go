@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestChangeValidatorOperator(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 100)
+	selfDelAddr := sdk.AccAddress(addrVals[0])
+	newOperator := Addrs[10]
+	newValAddr := sdk.ValAddress(newOperator)
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+
+	_, err := keeper.Delegate(ctx, selfDelAddr, sdk.TokensFromConsensusPower(10), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	_, err = keeper.Delegate(ctx, addrDels[0], sdk.TokensFromConsensusPower(10), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+	require.Len(t, keeper.ApplyAndReturnValidatorSetUpdates(ctx), 1)
+
+	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.TokensFromConsensusPower(2).ToDec())
+	require.NoError(t, err)
+	require.Len(t, keeper.ApplyAndReturnValidatorSetUpdates(ctx), 1)
+
+	// the new operator account cannot already delegate to the validator
+	require.NotNil(t, keeper.ChangeValidatorOperator(ctx, addrVals[0], addrDels[0]))
+	require.NotNil(t, keeper.ChangeValidatorOperator(ctx, addrVals[0], selfDelAddr))
+	require.NotNil(t, keeper.ChangeValidatorOperator(ctx, addrVals[1], newOperator))
+
+	require.Nil(t, keeper.ChangeValidatorOperator(ctx, addrVals[0], newOperator))
+
+	_, found := keeper.GetValidator(ctx, addrVals[0])
+	require.False(t, found)
+	validator, found = keeper.GetValidator(ctx, newValAddr)
+	require.True(t, found)
+	require.Equal(t, newValAddr, validator.OperatorAddress)
+
+	validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
+	require.True(t, found)
+	require.Equal(t, newValAddr, validator.OperatorAddress)
+	require.Equal(t, int64(18), keeper.GetLastValidatorPower(ctx, newValAddr))
+	require.Equal(t, int64(0), keeper.GetLastValidatorPower(ctx, addrVals[0]))
+
+	// the self-delegation moves to the new operator account
+	_, found = keeper.GetDelegation(ctx, selfDelAddr, addrVals[0])
+	require.False(t, found)
+	_, found = keeper.GetDelegation(ctx, selfDelAddr, newValAddr)
+	require.False(t, found)
+	delegation, found := keeper.GetDelegation(ctx, newOperator, newValAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(10).ToDec(), delegation.Shares)
+
+	// the other delegations and unbonding delegations move to the new address
+	delegation, found = keeper.GetDelegation(ctx, addrDels[0], newValAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(8).ToDec(), delegation.Shares)
+	_, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+	ubd, found := keeper.GetUnbondingDelegation(ctx, addrDels[0], newValAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+
+	// the validator set is left untouched
+	require.Empty(t, keeper.ApplyAndReturnValidatorSetUpdates(ctx))
+}
+
+func TestChangeValidatorOperatorVesting(t *testing.T) {
+	ctx, ak, keeper, _ := CreateTestInput(t, false, 100)
+	selfDelAddr := sdk.AccAddress(addrVals[0])
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+
+	_, err := keeper.Delegate(ctx, selfDelAddr, sdk.TokensFromConsensusPower(10), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	setVesting := func(addr sdk.AccAddress) {
+		baseAcc := ak.GetAccount(ctx, addr).(*authtypes.BaseAccount)
+		vacc := vestingtypes.NewContinuousVestingAccount(baseAcc, ctx.BlockTime().Unix(), ctx.BlockTime().Unix()+1000)
+		ak.SetAccount(ctx, vacc)
+	}
+
+	// the new operator account cannot be a vesting account
+	setVesting(Addrs[10])
+	err = keeper.ChangeValidatorOperator(ctx, addrVals[0], Addrs[10])
+	require.Equal(t, types.ErrChangeOperatorVestingAccount(keeper.Codespace()).Code(), err.Code())
+
+	// neither can the current operator account
+	setVesting(selfDelAddr)
+	err = keeper.ChangeValidatorOperator(ctx, addrVals[0], Addrs[11])
+	require.Equal(t, types.ErrChangeOperatorVestingAccount(keeper.Codespace()).Code(), err.Code())
+
+	_, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	_, found = keeper.GetDelegation(ctx, selfDelAddr, addrVals[0])
+	require.True(t, found)
+}
+
+func TestRotateConsPubKey(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 100)
+
+	for i := 0; i < 2; i++ {
+		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		validator, _ = validator.AddTokensFromDel(sdk.TokensFromConsensusPower(10))
+		keeper.SetValidatorByConsAddr(ctx, validator)
+		TestingUpdateValidator(keeper, ctx, validator, true)
+	}
+
+	// the new pubkey cannot be used by another validator
+	require.NotNil(t, keeper.RotateConsPubKey(ctx, addrVals[0], PKs[1]))
+	require.NotNil(t, keeper.RotateConsPubKey(ctx, addrVals[2], PKs[10]))
+
+	require.Nil(t, keeper.RotateConsPubKey(ctx, addrVals[0], PKs[10]))
+
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, PKs[10], validator.GetConsPubKey())
+
+	// both the new and the rotated pubkey resolve to the validator
+	for _, pk := range []int{0, 10} {
+		validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[pk]))
+		require.True(t, found)
+		require.Equal(t, addrVals[0], validator.OperatorAddress)
+	}
+	require.True(t, keeper.HasRotatedConsPubKey(ctx, sdk.GetConsAddress(PKs[0])))
+
+	// the rotated pubkey can never be used again
+	require.NotNil(t, keeper.RotateConsPubKey(ctx, addrVals[1], PKs[0]))
+
+	// rotating again within the block keeps replacing the first pubkey
+	require.Nil(t, keeper.RotateConsPubKey(ctx, addrVals[0], PKs[11]))
+
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Len(t, updates, 2)
+	require.Equal(t, tmtypes.TM2PB.PubKey(PKs[0]), updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+	require.Equal(t, tmtypes.TM2PB.PubKey(PKs[11]), updates[1].PubKey)
+	require.Equal(t, int64(10), updates[1].Power)
+
+	require.Empty(t, keeper.ApplyAndReturnValidatorSetUpdates(ctx))
+	require.Len(t, keeper.GetAllConsPubKeyRotations(ctx), 2)
+}
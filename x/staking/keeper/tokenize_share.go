@@ -0,0 +1,312 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTokenizeShareDenom returns the tokenized share denom of a validator
+func (k Keeper) GetTokenizeShareDenom(ctx sdk.Context, valAddr sdk.ValAddress) (denom string, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareDenomKey(valAddr))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// GetTokenizeShareValidator returns the validator backing a tokenized share denom
+func (k Keeper) GetTokenizeShareValidator(ctx sdk.Context, denom string) (valAddr sdk.ValAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareValidatorKey(denom))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.ValAddress(bz), true
+}
+
+// SetTokenizeShareRecord stores the mapping between a validator and its
+// tokenized share denom in both directions
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareDenomKey(record.ValidatorAddress), []byte(record.Denom))
+	store.Set(types.GetTokenizeShareValidatorKey(record.Denom), record.ValidatorAddress)
+}
+
+// IterateTokenizeShareRecords iterates over all tokenize share records by
+// validator operator address
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, cb func(record types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareDenomKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[1:])
+		if cb(types.NewTokenizeShareRecord(valAddr, string(iterator.Value()))) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizeShareRecords returns all tokenize share records
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// GetLastTokenizeShareID returns the id of the last assigned tokenized share denom
+func (k Keeper) GetLastTokenizeShareID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareIDKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLastTokenizeShareID sets the id of the last assigned tokenized share denom
+func (k Keeper) SetLastTokenizeShareID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// getOrCreateTokenizeShareDenom returns the tokenized share denom of a
+// validator, assigning a new one if none exists yet
+func (k Keeper) getOrCreateTokenizeShareDenom(ctx sdk.Context, valAddr sdk.ValAddress) string {
+	if denom, found := k.GetTokenizeShareDenom(ctx, valAddr); found {
+		return denom
+	}
+
+	id := k.GetLastTokenizeShareID(ctx) + 1
+	k.SetLastTokenizeShareID(ctx, id)
+
+	denom := types.TokenizeShareDenom(id)
+	k.SetTokenizeShareRecord(ctx, types.NewTokenizeShareRecord(valAddr, denom))
+	return denom
+}
+
+// TokenizeShares moves the shares corresponding to the given amount of tokens
+// from a delegation to the delegation held by the tokenize share pool, and
+// mints the validator's share tokens to the delegator. Only whole shares are
+// tokenized. Share tokens are minted at the rate of the pool delegation shares
+// backing each share token, which grows as the rewards of the pool delegation
+// are re-staked, so that the share tokens already minted keep their value.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Int,
+) (sdk.Coin, sdk.Error) {
+
+	if delAddr.Equals(valAddr) {
+		return sdk.Coin{}, types.ErrTokenizeSelfDelegation(k.Codespace())
+	}
+
+	// tokenized shares are freely transferable, so they must not be backed by
+	// coins that are still vesting
+	if _, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+		return sdk.Coin{}, types.ErrTokenizeVestingDelegation(k.Codespace())
+	}
+
+	// shares received through a redelegation may still be slashed for an
+	// infraction of the source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.ErrTokenizeRedelegationInProgress(k.Codespace())
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shares = shares.TruncateDec()
+	if !shares.IsPositive() {
+		return sdk.Coin{}, types.ErrTinyTokenizeShares(k.Codespace())
+	}
+
+	if err := k.restakeTokenizeSharePoolRewards(ctx, valAddr); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the first share tokens of a validator are backed by one share each
+	tokenAmount := shares.TruncateInt()
+	if denom, found := k.GetTokenizeShareDenom(ctx, valAddr); found {
+		if poolShares, supply := k.tokenizeSharePool(ctx, valAddr, denom); supply.IsPositive() {
+			tokenAmount = shares.MulInt(supply).QuoTruncate(poolShares).TruncateInt()
+		}
+	}
+
+	if !tokenAmount.IsPositive() {
+		return sdk.Coin{}, types.ErrTinyTokenizeShares(k.Codespace())
+	}
+
+	poolAddr := k.supplyKeeper.GetModuleAddress(types.TokenizeSharePoolName)
+	if err := k.transferDelegationShares(ctx, delAddr, poolAddr, valAddr, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareTokens := sdk.NewCoin(k.getOrCreateTokenizeShareDenom(ctx, valAddr), tokenAmount)
+	if err := k.supplyKeeper.MintCoins(ctx, types.TokenizeSharePoolName, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.TokenizeSharePoolName, delAddr, sdk.NewCoins(shareTokens),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareTokens, nil
+}
+
+// RedeemTokensForShares burns share tokens and moves the delegation shares
+// backing them, including the shares of the re-staked rewards, from the
+// tokenize share pool back to the delegator. It returns the validator of the
+// redeemed shares along with their current value in tokens, which reflects any
+// slashing since the shares were tokenized.
+func (k Keeper) RedeemTokensForShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, shareTokens sdk.Coin,
+) (sdk.ValAddress, sdk.Int, sdk.Error) {
+
+	valAddr, found := k.GetTokenizeShareValidator(ctx, shareTokens.Denom)
+	if !found {
+		return nil, sdk.ZeroInt(), types.ErrNoTokenizeShareRecord(k.Codespace())
+	}
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, sdk.ZeroInt(), types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	if _, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+		return nil, sdk.ZeroInt(), types.ErrTokenizeVestingDelegation(k.Codespace())
+	}
+
+	if err := k.restakeTokenizeSharePoolRewards(ctx, valAddr); err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
+
+	coins := sdk.NewCoins(shareTokens)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.TokenizeSharePoolName, coins); err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
+
+	// the redeemed share tokens are part of the supply until they are burned
+	poolShares, supply := k.tokenizeSharePool(ctx, valAddr, shareTokens.Denom)
+	shares := poolShares.MulInt(shareTokens.Amount).QuoTruncate(supply.ToDec())
+
+	if err := k.supplyKeeper.BurnCoins(ctx, types.TokenizeSharePoolName, coins); err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
+
+	poolAddr := k.supplyKeeper.GetModuleAddress(types.TokenizeSharePoolName)
+	if err := k.transferDelegationShares(ctx, poolAddr, delAddr, valAddr, shares); err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
+
+	validator, _ := k.GetValidator(ctx, valAddr)
+	return valAddr, validator.TokensFromShares(shares).TruncateInt(), nil
+}
+
+// tokenizeSharePool returns the shares of the delegation held by the tokenize
+// share pool to a validator along with the supply of its share tokens.
+func (k Keeper) tokenizeSharePool(ctx sdk.Context, valAddr sdk.ValAddress, denom string) (sdk.Dec, sdk.Int) {
+	poolShares := sdk.ZeroDec()
+	poolAddr := k.supplyKeeper.GetModuleAddress(types.TokenizeSharePoolName)
+	if delegation, found := k.GetDelegation(ctx, poolAddr, valAddr); found {
+		poolShares = delegation.Shares
+	}
+
+	return poolShares, k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)
+}
+
+// restakeTokenizeSharePoolRewards withdraws the rewards accrued by the
+// delegation held by the tokenize share pool to a validator and delegates them
+// back to the validator, so that they accrue to the holders of its share
+// tokens. The rewards which cannot be staked, such as those which are not in the
+// bond denom, are returned to the fee collector to be distributed again, so that
+// nothing is left in the tokenize share pool.
+func (k Keeper) restakeTokenizeSharePoolRewards(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Error {
+	poolAddr := k.supplyKeeper.GetModuleAddress(types.TokenizeSharePoolName)
+	if _, found := k.GetDelegation(ctx, poolAddr, valAddr); !found {
+		return nil
+	}
+
+	// the delegation hooks settle the rewards of the pool delegation, which are
+	// withdrawn to the tokenize share pool
+	k.BeforeDelegationSharesModified(ctx, poolAddr, valAddr)
+	k.AfterDelegationModified(ctx, poolAddr, valAddr)
+
+	rewards := k.authKeeper.GetAccount(ctx, poolAddr).GetCoins()
+	if rewards.IsZero() {
+		return nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// no tokens can be delegated to a validator which lost all its tokens
+	bondDenom := k.BondDenom(ctx)
+	if amount := rewards.AmountOf(bondDenom); amount.IsPositive() && !validator.InvalidExRate() {
+		if _, err := k.Delegate(ctx, poolAddr, amount, sdk.Unbonded, validator, true); err != nil {
+			return err
+		}
+
+		rewards = rewards.Sub(sdk.NewCoins(sdk.NewCoin(bondDenom, amount)))
+	}
+
+	if !rewards.IsZero() {
+		return k.supplyKeeper.SendCoinsFromModuleToModule(
+			ctx, types.TokenizeSharePoolName, authtypes.FeeCollectorName, rewards,
+		)
+	}
+
+	return nil
+}
+
+// transferDelegationShares moves delegation shares of a validator between two
+// delegators. The validator's tokens and shares are left untouched, while the
+// delegation hooks are called for both delegations so that distribution
+// settles the rewards accrued so far and keeps accruing them afterwards.
+func (k Keeper) transferDelegationShares(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) sdk.Error {
+
+	fromDelegation, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegation(k.Codespace())
+	}
+
+	if fromDelegation.Shares.LT(shares) {
+		return types.ErrNotEnoughDelegationShares(k.Codespace(), fromDelegation.Shares.String())
+	}
+
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+
+	toDelegation, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		toDelegation = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	fromDelegation.Shares = fromDelegation.Shares.Sub(shares)
+	if fromDelegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, fromDelegation)
+	} else {
+		k.SetDelegation(ctx, fromDelegation)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	toDelegation.Shares = toDelegation.Shares.Add(shares)
+	k.SetDelegation(ctx, toDelegation)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	return nil
+}
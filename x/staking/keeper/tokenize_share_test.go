@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	ctx, ak, keeper, sk := CreateTestInput(t, false, 100)
+	delTokens := sdk.TokensFromConsensusPower(10)
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+
+	_, err := keeper.Delegate(ctx, addrDels[0], delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// tokenize part of the delegation
+	tokenizeAmt := sdk.TokensFromConsensusPower(4)
+	shareTokens, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], tokenizeAmt)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(types.TokenizeShareDenom(1), tokenizeAmt), shareTokens)
+	require.Equal(t, tokenizeAmt, ak.GetAccount(ctx, addrDels[0]).GetCoins().AmountOf(shareTokens.Denom))
+	require.Equal(t, tokenizeAmt, sk.GetSupply(ctx).GetTotal().AmountOf(shareTokens.Denom))
+
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(tokenizeAmt).ToDec(), delegation.Shares)
+
+	poolAddr := sk.GetModuleAddress(types.TokenizeSharePoolName)
+	poolDelegation, found := keeper.GetDelegation(ctx, poolAddr, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, tokenizeAmt.ToDec(), poolDelegation.Shares)
+
+	// the validator's tokens and shares are left untouched
+	validator, found = keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delTokens, validator.Tokens)
+	require.Equal(t, delTokens.ToDec(), validator.DelegatorShares)
+
+	denom, found := keeper.GetTokenizeShareDenom(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, shareTokens.Denom, denom)
+
+	// tokenizing again reuses the validator's share denom
+	shareTokens, err = keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], tokenizeAmt)
+	require.NoError(t, err)
+	require.Equal(t, types.TokenizeShareDenom(1), shareTokens.Denom)
+	require.Equal(t, uint64(1), keeper.GetLastTokenizeShareID(ctx))
+
+	_, broken := TokenizeSharesInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	// share tokens can be redeemed by anyone holding them
+	acc := ak.GetAccount(ctx, addrDels[0])
+	transfer := sdk.NewCoins(sdk.NewCoin(shareTokens.Denom, tokenizeAmt))
+	require.NoError(t, acc.SetCoins(acc.GetCoins().Sub(transfer)))
+	ak.SetAccount(ctx, acc)
+	acc = ak.GetAccount(ctx, addrDels[1])
+	require.NoError(t, acc.SetCoins(acc.GetCoins().Add(transfer)))
+	ak.SetAccount(ctx, acc)
+
+	// slashing lowers the value of the redeemed shares
+	validator = keeper.RemoveValidatorTokens(ctx, validator, delTokens.QuoRaw(2))
+
+	valAddr, amount, err := keeper.RedeemTokensForShares(ctx, addrDels[1], transfer[0])
+	require.NoError(t, err)
+	require.Equal(t, addrVals[0], valAddr)
+	require.Equal(t, validator.TokensFromShares(tokenizeAmt.ToDec()).TruncateInt(), amount)
+	require.Equal(t, tokenizeAmt.QuoRaw(2), amount)
+
+	delegation, found = keeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, tokenizeAmt.ToDec(), delegation.Shares)
+	require.True(t, ak.GetAccount(ctx, addrDels[1]).GetCoins().AmountOf(shareTokens.Denom).IsZero())
+
+	_, broken = TokenizeSharesInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	// redeeming the remaining share tokens removes the pool delegation
+	_, _, err = keeper.RedeemTokensForShares(ctx, addrDels[0], sdk.NewCoin(shareTokens.Denom, tokenizeAmt))
+	require.NoError(t, err)
+
+	_, found = keeper.GetDelegation(ctx, poolAddr, addrVals[0])
+	require.False(t, found)
+	require.True(t, sk.GetSupply(ctx).GetTotal().AmountOf(shareTokens.Denom).IsZero())
+
+	_, broken = TokenizeSharesInvariant(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestTokenizeSharesRestakeRewards(t *testing.T) {
+	ctx, ak, keeper, sk := CreateTestInput(t, false, 100)
+	delTokens := sdk.TokensFromConsensusPower(10)
+	bondDenom := keeper.BondDenom(ctx)
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+
+	_, err := keeper.Delegate(ctx, addrDels[0], delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	_, err = keeper.Delegate(ctx, addrDels[1], delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	shareTokens, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.TokensFromConsensusPower(4))
+	require.NoError(t, err)
+
+	// the rewards of the pool delegation are withdrawn to the tokenize share pool
+	rewards := sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(2)), sdk.NewInt64Coin("photon", 10))
+	poolAddr := sk.GetModuleAddress(types.TokenizeSharePoolName)
+	poolAcc := ak.GetAccount(ctx, poolAddr)
+	require.NoError(t, poolAcc.SetCoins(rewards))
+	ak.SetAccount(ctx, poolAcc)
+
+	_, broken := TokenizeSharesInvariant(keeper)(ctx)
+	require.True(t, broken)
+
+	// the bond denom rewards are re-staked before new share tokens are minted,
+	// which are worth as many shares as the share tokens minted before
+	newShareTokens, err := keeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.TokensFromConsensusPower(3))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(shareTokens.Denom, sdk.TokensFromConsensusPower(2)), newShareTokens)
+
+	poolDelegation, found := keeper.GetDelegation(ctx, poolAddr, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(9).ToDec(), poolDelegation.Shares)
+
+	// the rewards which cannot be staked are returned to the fee collector
+	require.True(t, ak.GetAccount(ctx, poolAddr).GetCoins().IsZero())
+	feeCollector := sk.GetModuleAccount(ctx, authtypes.FeeCollectorName)
+	require.Equal(t, sdk.NewInt(10), feeCollector.GetCoins().AmountOf("photon"))
+
+	_, broken = TokenizeSharesInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	// the redeemed share tokens are worth their part of the re-staked rewards
+	_, amount, err := keeper.RedeemTokensForShares(ctx, addrDels[0], shareTokens)
+	require.NoError(t, err)
+	require.Equal(t, sdk.TokensFromConsensusPower(6), amount)
+
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(12).ToDec(), delegation.Shares)
+
+	_, amount, err = keeper.RedeemTokensForShares(ctx, addrDels[1], newShareTokens)
+	require.NoError(t, err)
+	require.Equal(t, sdk.TokensFromConsensusPower(3), amount)
+
+	_, found = keeper.GetDelegation(ctx, poolAddr, addrVals[0])
+	require.False(t, found)
+
+	_, broken = TokenizeSharesInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	// the re-staked rewards were added to the validator's tokens
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.Equal(t, sdk.TokensFromConsensusPower(22), validator.Tokens)
+}
+
+func TestTokenizeSharesInvalid(t *testing.T) {
+	ctx, ak, keeper, _ := CreateTestInput(t, false, 100)
+	delTokens := sdk.TokensFromConsensusPower(10)
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+
+	valAccAddr := sdk.AccAddress(addrVals[0])
+	_, err := keeper.Delegate(ctx, valAccAddr, delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+
+	// self-delegations cannot be tokenized
+	_, err = keeper.TokenizeShares(ctx, valAccAddr, addrVals[0], delTokens)
+	require.Equal(t, types.ErrTokenizeSelfDelegation(keeper.Codespace()).Code(), err.Code())
+
+	// delegations of vesting accounts cannot be tokenized
+	baseAcc := ak.GetAccount(ctx, addrDels[0]).(*authtypes.BaseAccount)
+	vacc := vestingtypes.NewContinuousVestingAccount(baseAcc, ctx.BlockTime().Unix(), ctx.BlockTime().Unix()+1000)
+	ak.SetAccount(ctx, vacc)
+
+	_, err = keeper.Delegate(ctx, addrDels[0], delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	_, err = keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], delTokens)
+	require.Equal(t, types.ErrTokenizeVestingDelegation(keeper.Codespace()).Code(), err.Code())
+
+	// amounts worth less than one share cannot be tokenized
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	_, err = keeper.Delegate(ctx, addrDels[1], delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	_, err = keeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.ZeroInt())
+	require.Equal(t, types.ErrTinyTokenizeShares(keeper.Codespace()).Code(), err.Code())
+
+	// only known share denoms can be redeemed
+	_, _, err = keeper.RedeemTokensForShares(ctx, addrDels[1], sdk.NewCoin(types.TokenizeShareDenom(1), sdk.OneInt()))
+	require.Equal(t, types.ErrNoTokenizeShareRecord(keeper.Codespace()).Code(), err.Code())
+
+	_, broken := TokenizeSharesInvariant(keeper)(ctx)
+	require.False(t, broken)
+}
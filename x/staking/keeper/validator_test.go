@@ -1045,6 +1045,10 @@ func TestUpdateValidatorCommission(t *testing.T) {
 	keeper.SetValidator(ctx, val1)
 	keeper.SetValidator(ctx, val2)
 
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	keeper.SetParams(ctx, params)
+
 	testCases := []struct {
 		validator   types.Validator
 		newRate     sdk.Dec
@@ -1054,6 +1058,7 @@ func TestUpdateValidatorCommission(t *testing.T) {
 		{val2, sdk.NewDecWithPrec(-1, 1), true},
 		{val2, sdk.NewDecWithPrec(4, 1), true},
 		{val2, sdk.NewDecWithPrec(3, 1), true},
+		{val2, sdk.NewDecWithPrec(1, 2), true},
 		{val2, sdk.NewDecWithPrec(2, 1), false},
 	}
 
@@ -1082,3 +1087,37 @@ func TestUpdateValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+func TestMigrateMinCommissionRate(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 1000)
+	updateTime := time.Now().UTC()
+
+	rates := []struct {
+		rate, maxRate                 sdk.Dec
+		expectedRate, expectedMaxRate sdk.Dec
+	}{
+		{sdk.ZeroDec(), sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 2)},
+		{sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(3, 1)},
+		{sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1)},
+	}
+
+	for i, r := range rates {
+		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		validator, err := validator.SetInitialCommission(
+			types.NewCommissionWithTime(r.rate, r.maxRate, sdk.ZeroDec(), updateTime),
+		)
+		require.NoError(t, err)
+		keeper.SetValidator(ctx, validator)
+	}
+
+	keeper.MigrateMinCommissionRate(ctx, sdk.NewDecWithPrec(5, 2))
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), keeper.MinCommissionRate(ctx))
+
+	for i, r := range rates {
+		validator, found := keeper.GetValidator(ctx, addrVals[i])
+		require.True(t, found)
+		require.Equal(t, r.expectedRate, validator.Commission.Rate, "unexpected rate for validator #%d", i)
+		require.Equal(t, r.expectedMaxRate, validator.Commission.MaxRate, "unexpected max rate for validator #%d", i)
+		require.Equal(t, updateTime, validator.Commission.UpdateTime, "unexpected update time for validator #%d", i)
+	}
+}
@@ -0,0 +1,30 @@
+package v039
+
+import (
+	v038staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_38"
+)
+
+// Migrate accepts exported genesis state from v0.38 and migrates it to v0.39
+// genesis state. The params hold the new HistoricalEntries and
+// MinCommissionRate with their default values, which leave the commission of
+// the validators unrestricted. No share is tokenized and no consensus pubkey
+// is rotated yet.
+func Migrate(oldGenState v038staking.GenesisState) GenesisState {
+	return GenesisState{
+		Params: Params{
+			UnbondingTime:     oldGenState.Params.UnbondingTime,
+			MaxValidators:     oldGenState.Params.MaxValidators,
+			MaxEntries:        oldGenState.Params.MaxEntries,
+			HistoricalEntries: DefaultHistoricalEntries,
+			BondDenom:         oldGenState.Params.BondDenom,
+			MinCommissionRate: DefaultMinCommissionRate,
+		},
+		LastTotalPower:       oldGenState.LastTotalPower,
+		LastValidatorPowers:  oldGenState.LastValidatorPowers,
+		Validators:           oldGenState.Validators,
+		Delegations:          oldGenState.Delegations,
+		UnbondingDelegations: oldGenState.UnbondingDelegations,
+		Redelegations:        oldGenState.Redelegations,
+		Exported:             oldGenState.Exported,
+	}
+}
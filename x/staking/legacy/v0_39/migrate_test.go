@@ -0,0 +1,45 @@
+package v039_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v034staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_34"
+	v038staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_38"
+	v039staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_39"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+
+	oldGenState := v038staking.GenesisState{
+		Params: v034staking.Params{
+			UnbondingTime: time.Hour,
+			MaxValidators: 10,
+			MaxEntries:    7,
+			BondDenom:     sdk.DefaultBondDenom,
+		},
+		LastTotalPower: sdk.ZeroInt(),
+	}
+
+	migrated := v039staking.Migrate(oldGenState)
+	require.Equal(t, uint16(100), migrated.Params.HistoricalEntries)
+	require.Equal(t, v039staking.DefaultMinCommissionRate, migrated.Params.MinCommissionRate)
+
+	// the migrated genesis state is a valid staking genesis state
+	var genState staking.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(migrated), &genState)
+	require.NoError(t, staking.ValidateGenesis(genState))
+	require.Equal(t, time.Hour, genState.Params.UnbondingTime)
+	require.Equal(t, uint16(10), genState.Params.MaxValidators)
+	require.Equal(t, uint16(7), genState.Params.MaxEntries)
+	require.Equal(t, sdk.DefaultBondDenom, genState.Params.BondDenom)
+	require.Equal(t, staking.DefaultHistoricalEntries, genState.Params.HistoricalEntries)
+	require.True(t, genState.Params.MinCommissionRate.IsZero())
+}
@@ -0,0 +1,42 @@
+// DONTCOVER
+// nolint
+package v039
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v034staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_34"
+	v038staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_38"
+)
+
+const (
+	ModuleName = "staking"
+
+	// DefaultHistoricalEntries matches the default of the v0.39 staking params
+	DefaultHistoricalEntries uint16 = 100
+)
+
+var DefaultMinCommissionRate = sdk.ZeroDec()
+
+type (
+	Params struct {
+		UnbondingTime     time.Duration `json:"unbonding_time" yaml:"unbonding_time"`
+		MaxValidators     uint16        `json:"max_validators" yaml:"max_validators"`
+		MaxEntries        uint16        `json:"max_entries" yaml:"max_entries"`
+		HistoricalEntries uint16        `json:"historical_entries" yaml:"historical_entries"`
+		BondDenom         string        `json:"bond_denom" yaml:"bond_denom"`
+		MinCommissionRate sdk.Dec       `json:"min_commission_rate" yaml:"min_commission_rate"`
+	}
+
+	GenesisState struct {
+		Params               Params                            `json:"params" yaml:"params"`
+		LastTotalPower       sdk.Int                           `json:"last_total_power" yaml:"last_total_power"`
+		LastValidatorPowers  []v034staking.LastValidatorPower  `json:"last_validator_powers" yaml:"last_validator_powers"`
+		Validators           v038staking.Validators            `json:"validators" yaml:"validators"`
+		Delegations          v034staking.Delegations           `json:"delegations" yaml:"delegations"`
+		UnbondingDelegations []v034staking.UnbondingDelegation `json:"unbonding_delegations" yaml:"unbonding_delegations"`
+		Redelegations        []v034staking.Redelegation        `json:"redelegations" yaml:"redelegations"`
+		Exported             bool                              `json:"exported" yaml:"exported"`
+	}
+)
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -13,44 +14,59 @@ import (
 
 // DecodeStore unmarshals the KVPair's Value to the corresponding staking type
 func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	appCodec := codec.NewHybridCodec(cdc)
+
 	switch {
 	case bytes.Equal(kvA.Key[:1], types.LastTotalPowerKey):
-		var powerA, powerB sdk.Int
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &powerA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &powerB)
+		var powerA, powerB sdk.IntProto
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &powerA)
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &powerB)
 		return fmt.Sprintf("%v\n%v", powerA, powerB)
 
 	case bytes.Equal(kvA.Key[:1], types.ValidatorsKey):
-		var validatorA, validatorB types.Validator
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &validatorA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &validatorB)
+		validatorA := types.MustUnmarshalValidator(appCodec, kvA.Value)
+		validatorB := types.MustUnmarshalValidator(appCodec, kvB.Value)
 		return fmt.Sprintf("%v\n%v", validatorA, validatorB)
 
 	case bytes.Equal(kvA.Key[:1], types.LastValidatorPowerKey),
 		bytes.Equal(kvA.Key[:1], types.ValidatorsByConsAddrKey),
-		bytes.Equal(kvA.Key[:1], types.ValidatorsByPowerIndexKey):
+		bytes.Equal(kvA.Key[:1], types.ValidatorsByPowerIndexKey),
+		bytes.Equal(kvA.Key[:1], types.TokenizeShareValidatorKey):
 		return fmt.Sprintf("%v\n%v", sdk.ValAddress(kvA.Value), sdk.ValAddress(kvB.Value))
 
 	case bytes.Equal(kvA.Key[:1], types.DelegationKey):
 		var delegationA, delegationB types.Delegation
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &delegationA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &delegationB)
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &delegationA)
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &delegationB)
 		return fmt.Sprintf("%v\n%v", delegationA, delegationB)
 
 	case bytes.Equal(kvA.Key[:1], types.UnbondingDelegationKey),
 		bytes.Equal(kvA.Key[:1], types.UnbondingDelegationByValIndexKey):
 		var ubdA, ubdB types.UnbondingDelegation
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &ubdA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &ubdB)
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &ubdA)
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &ubdB)
 		return fmt.Sprintf("%v\n%v", ubdA, ubdB)
 
 	case bytes.Equal(kvA.Key[:1], types.RedelegationKey),
 		bytes.Equal(kvA.Key[:1], types.RedelegationByValSrcIndexKey):
 		var redA, redB types.Redelegation
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &redA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &redB)
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &redA)
+		appCodec.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &redB)
 		return fmt.Sprintf("%v\n%v", redA, redB)
 
+	case bytes.Equal(kvA.Key[:1], types.HistoricalInfoKey):
+		histA := types.MustUnmarshalHistoricalInfo(appCodec, kvA.Value)
+		histB := types.MustUnmarshalHistoricalInfo(appCodec, kvB.Value)
+		return fmt.Sprintf("%v\n%v", histA, histB)
+
+	case bytes.Equal(kvA.Key[:1], types.TokenizeShareDenomKey),
+		bytes.Equal(kvA.Key[:1], types.RotatedConsPubKeyKey),
+		bytes.Equal(kvA.Key[:1], types.PendingConsPubKeyRotationKey):
+		return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+	case bytes.Equal(kvA.Key[:1], types.LastTokenizeShareIDKey):
+		return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
 	default:
 		panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 	}
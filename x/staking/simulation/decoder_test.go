@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	cmn "github.com/tendermint/tendermint/libs/common"
 
@@ -31,6 +32,7 @@ func makeTestCodec() (cdc *codec.Codec) {
 
 func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
+	appCodec := codec.NewHybridCodec(cdc)
 
 	bondTime := time.Now().UTC()
 
@@ -38,14 +40,19 @@ func TestDecodeStore(t *testing.T) {
 	del := types.NewDelegation(delAddr1, valAddr1, sdk.OneDec())
 	ubd := types.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, sdk.OneInt())
 	red := types.NewRedelegation(delAddr1, valAddr1, valAddr1, 12, bondTime, sdk.OneInt(), sdk.OneDec())
+	hist := types.NewHistoricalInfo(abci.Header{ChainID: "test", Height: 10, Time: bondTime}, types.Validators{val})
 
 	kvPairs := cmn.KVPairs{
-		cmn.KVPair{Key: types.LastTotalPowerKey, Value: cdc.MustMarshalBinaryLengthPrefixed(sdk.OneInt())},
-		cmn.KVPair{Key: types.GetValidatorKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(val)},
+		cmn.KVPair{Key: types.LastTotalPowerKey, Value: appCodec.MustMarshalBinaryLengthPrefixed(&sdk.IntProto{Int: sdk.OneInt()})},
+		cmn.KVPair{Key: types.GetValidatorKey(valAddr1), Value: types.MustMarshalValidator(appCodec, val)},
 		cmn.KVPair{Key: types.LastValidatorPowerKey, Value: valAddr1.Bytes()},
-		cmn.KVPair{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(del)},
-		cmn.KVPair{Key: types.GetUBDKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(ubd)},
-		cmn.KVPair{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(red)},
+		cmn.KVPair{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: appCodec.MustMarshalBinaryLengthPrefixed(&del)},
+		cmn.KVPair{Key: types.GetUBDKey(delAddr1, valAddr1), Value: appCodec.MustMarshalBinaryLengthPrefixed(&ubd)},
+		cmn.KVPair{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: appCodec.MustMarshalBinaryLengthPrefixed(&red)},
+		cmn.KVPair{Key: types.GetHistoricalInfoKey(10), Value: types.MustMarshalHistoricalInfo(appCodec, hist)},
+		cmn.KVPair{Key: types.GetTokenizeShareDenomKey(valAddr1), Value: []byte(types.TokenizeShareDenom(1))},
+		cmn.KVPair{Key: types.GetTokenizeShareValidatorKey(types.TokenizeShareDenom(1)), Value: valAddr1.Bytes()},
+		cmn.KVPair{Key: types.LastTokenizeShareIDKey, Value: sdk.Uint64ToBigEndian(1)},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -59,6 +66,10 @@ func TestDecodeStore(t *testing.T) {
 		{"Delegation", fmt.Sprintf("%v\n%v", del, del)},
 		{"UnbondingDelegation", fmt.Sprintf("%v\n%v", ubd, ubd)},
 		{"Redelegation", fmt.Sprintf("%v\n%v", red, red)},
+		{"HistoricalInfo", fmt.Sprintf("%v\n%v", hist, hist)},
+		{"TokenizeShareDenom", "share1\nshare1"},
+		{"TokenizeShareValidator", fmt.Sprintf("%v\n%v", valAddr1, valAddr1)},
+		{"LastTokenizeShareID", "1\n1"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -17,8 +17,10 @@ import (
 
 // Simulation parameter constants
 const (
-	UnbondingTime = "unbonding_time"
-	MaxValidators = "max_validators"
+	UnbondingTime     = "unbonding_time"
+	MaxValidators     = "max_validators"
+	HistoricalEntries = "historical_entries"
+	MinCommissionRate = "min_commission_rate"
 )
 
 // GenUnbondingTime randomized UnbondingTime
@@ -31,6 +33,16 @@ func GenMaxValidators(r *rand.Rand) (maxValidators uint16) {
 	return uint16(r.Intn(250) + 1)
 }
 
+// GenHistoricalEntries randomized HistoricalEntries
+func GenHistoricalEntries(r *rand.Rand) uint16 {
+	return uint16(r.Intn(1000) + 1)
+}
+
+// GenMinCommissionRate randomized MinCommissionRate
+func GenMinCommissionRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(6)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
@@ -46,11 +58,23 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { maxValidators = GenMaxValidators(r) },
 	)
 
+	var histEntries uint16
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, HistoricalEntries, &histEntries, simState.Rand,
+		func(r *rand.Rand) { histEntries = GenHistoricalEntries(r) },
+	)
+
+	var minCommissionRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinCommissionRate, &minCommissionRate, simState.Rand,
+		func(r *rand.Rand) { minCommissionRate = GenMinCommissionRate(r) },
+	)
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
 
-	params := types.NewParams(simState.UnbondTime, maxValidators, 7, sdk.DefaultBondDenom)
+	params := types.NewParams(simState.UnbondTime, maxValidators, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate)
 
 	// validators & delegations
 	var (
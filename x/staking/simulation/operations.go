@@ -5,14 +5,18 @@ import (
 	"fmt"
 	"math/rand"
 
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 // Simulation operation weights constants
@@ -22,6 +26,11 @@ const (
 	OpWeightMsgDelegate        = "op_weight_msg_delegate"
 	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+	OpWeightMsgCancelUnbonding = "op_weight_msg_cancel_unbonding_delegation"
+	OpWeightMsgTokenizeShares  = "op_weight_msg_tokenize_shares"
+	OpWeightMsgRedeemTokens    = "op_weight_msg_redeem_tokens"
+	OpWeightMsgChangeOperator  = "op_weight_msg_change_validator_operator"
+	OpWeightMsgRotateConsKey   = "op_weight_msg_rotate_cons_pubkey"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -36,6 +45,11 @@ func WeightedOperations(
 		weightMsgDelegate        int
 		weightMsgUndelegate      int
 		weightMsgBeginRedelegate int
+		weightMsgCancelUnbonding int
+		weightMsgTokenizeShares  int
+		weightMsgRedeemTokens    int
+		weightMsgChangeOperator  int
+		weightMsgRotateConsKey   int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -68,6 +82,36 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelUnbonding, &weightMsgCancelUnbonding, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelUnbonding = simappparams.DefaultWeightMsgCancelUnbondingDelegation
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgTokenizeShares, &weightMsgTokenizeShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgTokenizeShares = simappparams.DefaultWeightMsgTokenizeShares
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRedeemTokens, &weightMsgRedeemTokens, nil,
+		func(_ *rand.Rand) {
+			weightMsgRedeemTokens = simappparams.DefaultWeightMsgRedeemTokensForShares
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChangeOperator, &weightMsgChangeOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgChangeOperator = simappparams.DefaultWeightMsgChangeValidatorOperator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRotateConsKey, &weightMsgRotateConsKey, nil,
+		func(_ *rand.Rand) {
+			weightMsgRotateConsKey = simappparams.DefaultWeightMsgRotateConsPubKey
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -89,6 +133,26 @@ func WeightedOperations(
 			weightMsgBeginRedelegate,
 			SimulateMsgBeginRedelegate(ak, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbonding,
+			SimulateMsgCancelUnbondingDelegation(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgTokenizeShares,
+			SimulateMsgTokenizeShares(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRedeemTokens,
+			SimulateMsgRedeemTokensForShares(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgChangeOperator,
+			SimulateMsgChangeValidatorOperator(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRotateConsKey,
+			SimulateMsgRotateConsPubKey(ak, k),
+		),
 	}
 }
 
@@ -108,6 +172,13 @@ func SimulateMsgCreateValidator(ak types.AccountKeeper, k keeper.Keeper) simulat
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
+		// ensure the consensus pubkey isn't used by a validator which changed
+		// its operator, nor was rotated away from
+		consAddr := sdk.GetConsAddress(simAccount.PubKey)
+		if _, found := k.GetValidatorByConsAddr(ctx, consAddr); found || k.HasRotatedConsPubKey(ctx, consAddr) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
 		denom := k.GetParams(ctx).BondDenom
 		amount := ak.GetAccount(ctx, simAccount.Address).GetCoins().AmountOf(denom)
 		if !amount.IsPositive() {
@@ -148,6 +219,11 @@ func SimulateMsgCreateValidator(ak types.AccountKeeper, k keeper.Keeper) simulat
 			simulation.RandomDecAmount(r, maxCommission),
 		)
 
+		if commission.Rate.LT(k.MinCommissionRate(ctx)) {
+			// skip as the commission is below the min commission rate
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
 		msg := types.NewMsgCreateValidator(address, simAccount.PubKey,
 			selfDelegation, description, commission, sdk.OneInt())
 
@@ -193,6 +269,10 @@ func SimulateMsgEditValidator(ak types.AccountKeeper, k keeper.Keeper) simulatio
 			// skip as the commission is invalid
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
+		if newCommissionRate.LT(k.MinCommissionRate(ctx)) {
+			// skip as the commission is below the min commission rate
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
 
 		simAccount, found := simulation.FindAccount(accs, sdk.AccAddress(val.GetOperator()))
 		if !found {
@@ -319,6 +399,11 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, k keeper.Keeper) simulation.O
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
+		// the tokenize share pool delegation is only modified by redeeming share tokens
+		if delAddr.Equals(supply.NewModuleAddress(types.TokenizeSharePoolName)) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
 		if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
@@ -378,6 +463,78 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, k keeper.Keeper) simulation.O
 	}
 }
 
+// SimulateMsgCancelUnbondingDelegation generates a MsgCancelUnbondingDelegation
+// for a random pending unbonding delegation entry
+// nolint: funlen
+func SimulateMsgCancelUnbondingDelegation(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// the validator cannot accept delegations once all its tokens are slashed
+		if validator.InvalidExRate() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		ubds := k.GetUnbondingDelegationsFromValidator(ctx, validator.OperatorAddress)
+		if len(ubds) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// get random unbonding delegation entry that has not matured yet
+		ubd := ubds[r.Intn(len(ubds))]
+		entry := ubd.Entries[r.Intn(len(ubd.Entries))]
+		if entry.IsMature(ctx.BlockHeader().Time) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			ubd.DelegatorAddress, validator.OperatorAddress, entry.CreationHeight,
+		)
+
+		// need to retrieve the simulation account associated with the unbonding delegation to retrieve PrivKey
+		var simAccount simulation.Account
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(ubd.DelegatorAddress) {
+				simAccount = simAcc
+				break
+			}
+		}
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", ubd.DelegatorAddress)
+		}
+
+		account := ak.GetAccount(ctx, ubd.DelegatorAddress)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // SimulateMsgBeginRedelegate generates a MsgBeginRedelegate with random values
 // nolint: funlen
 func SimulateMsgBeginRedelegate(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
@@ -398,6 +555,11 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, k keeper.Keeper) simulat
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
+		// the tokenize share pool delegation is only modified by redeeming share tokens
+		if delAddr.Equals(supply.NewModuleAddress(types.TokenizeSharePoolName)) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
 		if k.HasReceivingRedelegation(ctx, delAddr, srcAddr) {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil // skip
 		}
@@ -482,3 +644,281 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, k keeper.Keeper) simulat
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgTokenizeShares generates a MsgTokenizeShares with random values
+// nolint: funlen
+func SimulateMsgTokenizeShares(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		valAddr := validator.GetOperator()
+
+		delegations := k.GetValidatorDelegations(ctx, valAddr)
+
+		// get random delegator from validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if delAddr.Equals(valAddr) ||
+			delAddr.Equals(supply.NewModuleAddress(types.TokenizeSharePoolName)) ||
+			k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		if _, ok := account.(vestexported.VestingAccount); ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		tokenizeAmt, err := simulation.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		// check if the shares truncate to zero
+		shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, tokenizeAmt)
+		if err != nil || !shares.TruncateInt().IsPositive() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil // skip
+		}
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		var simAccount simulation.Account
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(k.BondDenom(ctx), tokenizeAmt))
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRedeemTokensForShares generates a MsgRedeemTokensForShares with random values
+// nolint: funlen
+func SimulateMsgRedeemTokensForShares(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+		if _, ok := account.(vestexported.VestingAccount); ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		spendable := account.SpendableCoins(ctx.BlockTime())
+
+		var shareTokens sdk.Coins
+		for _, coin := range spendable {
+			valAddr, found := k.GetTokenizeShareValidator(ctx, coin.Denom)
+			if !found {
+				continue
+			}
+			if _, found := k.GetValidator(ctx, valAddr); found {
+				shareTokens = append(shareTokens, coin)
+			}
+		}
+
+		if len(shareTokens) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		shareToken := shareTokens[r.Intn(len(shareTokens))]
+		redeemAmt, err := simulation.RandPositiveInt(r, shareToken.Amount)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		redeemCoin := sdk.NewCoin(shareToken.Denom, redeemAmt)
+
+		coins, hasNeg := spendable.SafeSub(sdk.NewCoins(redeemCoin))
+		if hasNeg {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		fees, err := simulation.RandomFees(r, ctx, coins)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgRedeemTokensForShares(simAccount.Address, redeemCoin)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgChangeValidatorOperator generates a MsgChangeValidatorOperator with random values
+// nolint: funlen
+func SimulateMsgChangeValidatorOperator(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, found := simulation.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("validator %s not found", validator.GetOperator())
+		}
+
+		// the new operator cannot already operate a validator nor have a
+		// delegation or redelegation with the validator, and neither operator
+		// account can be a vesting account
+		newOperator, _ := simulation.RandomAcc(r, accs)
+		for _, addr := range []sdk.AccAddress{simAccount.Address, newOperator.Address} {
+			if _, ok := ak.GetAccount(ctx, addr).(vestexported.VestingAccount); ok {
+				return simulation.NoOpMsg(types.ModuleName), nil, nil
+			}
+		}
+		newValAddr := sdk.ValAddress(newOperator.Address)
+		if _, found := k.GetValidator(ctx, newValAddr); found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		if _, found := k.GetDelegation(ctx, newOperator.Address, validator.GetOperator()); found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		if len(k.GetAllRedelegations(ctx, newOperator.Address, validator.GetOperator(), nil)) > 0 ||
+			len(k.GetAllRedelegations(ctx, newOperator.Address, nil, validator.GetOperator())) > 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgChangeValidatorOperator(validator.GetOperator(), newOperator.Address)
+
+		// both the current and the new operator sign the msg
+		newOperatorAccount := ak.GetAccount(ctx, newOperator.Address)
+		if newOperatorAccount == nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber(), newOperatorAccount.GetAccountNumber()},
+			[]uint64{account.GetSequence(), newOperatorAccount.GetSequence()},
+			simAccount.PrivKey,
+			newOperator.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRotateConsPubKey generates a MsgRotateConsPubKey with random values
+// nolint: funlen
+func SimulateMsgRotateConsPubKey(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, found := simulation.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("validator %s not found", validator.GetOperator())
+		}
+
+		// generate a fresh consensus key of the same type as the simulation accounts
+		privkeySeed := make([]byte, 15)
+		r.Read(privkeySeed)
+		pubKey := secp256k1.GenPrivKeySecp256k1(privkeySeed).PubKey()
+
+		consAddr := sdk.GetConsAddress(pubKey)
+		if _, found := k.GetValidatorByConsAddr(ctx, consAddr); found || k.HasRotatedConsPubKey(ctx, consAddr) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgRotateConsPubKey(validator.GetOperator(), pubKey)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
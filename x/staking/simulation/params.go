@@ -11,8 +11,10 @@ import (
 )
 
 const (
-	keyMaxValidators = "MaxValidators"
-	keyUnbondingTime = "UnbondingTime"
+	keyMaxValidators     = "MaxValidators"
+	keyUnbondingTime     = "UnbondingTime"
+	keyHistoricalEntries = "HistoricalEntries"
+	keyMinCommissionRate = "MinCommissionRate"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -29,5 +31,15 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("\"%d\"", GenUnbondingTime(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyHistoricalEntries,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenHistoricalEntries(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMinCommissionRate,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMinCommissionRate(r))
+			},
+		),
 	}
 }
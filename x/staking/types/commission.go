@@ -7,22 +7,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-type (
-	// Commission defines a commission parameters for a given validator.
-	Commission struct {
-		CommissionRates `json:"commission_rates" yaml:"commission_rates"`
-		UpdateTime      time.Time `json:"update_time" yaml:"update_time"` // the last time the commission rate was changed
-	}
-
-	// CommissionRates defines the initial commission rates to be used for creating a
-	// validator.
-	CommissionRates struct {
-		Rate          sdk.Dec `json:"rate" yaml:"rate"`                       // the commission rate charged to delegators, as a fraction
-		MaxRate       sdk.Dec `json:"max_rate" yaml:"max_rate"`               // maximum commission rate which validator can ever charge, as a fraction
-		MaxChangeRate sdk.Dec `json:"max_change_rate" yaml:"max_change_rate"` // maximum daily increase of the validator commission, as a fraction
-	}
-)
-
 // NewCommissionRates returns an initialized validator commission rates.
 func NewCommissionRates(rate, maxRate, maxChangeRate sdk.Dec) CommissionRates {
 	return CommissionRates{
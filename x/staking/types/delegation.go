@@ -12,35 +12,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
 
-// DVPair is struct that just has a delegator-validator pair with no other data.
-// It is intended to be used as a marshalable pointer. For example, a DVPair can be used to construct the
-// key to getting an UnbondingDelegation from state.
-type DVPair struct {
-	DelegatorAddress sdk.AccAddress
-	ValidatorAddress sdk.ValAddress
-}
-
-// DVVTriplet is struct that just has a delegator-validator-validator triplet with no other data.
-// It is intended to be used as a marshalable pointer. For example, a DVVTriplet can be used to construct the
-// key to getting a Redelegation from state.
-type DVVTriplet struct {
-	DelegatorAddress    sdk.AccAddress
-	ValidatorSrcAddress sdk.ValAddress
-	ValidatorDstAddress sdk.ValAddress
-}
-
 // Implements Delegation interface
 var _ exported.DelegationI = Delegation{}
 
-// Delegation represents the bond with tokens held by an account. It is
-// owned by one delegator, and is associated with the voting power of one
-// validator.
-type Delegation struct {
-	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
-	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
-	Shares           sdk.Dec        `json:"shares" yaml:"shares"`
-}
-
 // NewDelegation creates a new delegation object
 func NewDelegation(delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
 	shares sdk.Dec) Delegation {
@@ -53,13 +27,13 @@ func NewDelegation(delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
 }
 
 // MustMarshalDelegation returns the delegation bytes. Panics if fails
-func MustMarshalDelegation(cdc *codec.Codec, delegation Delegation) []byte {
-	return cdc.MustMarshalBinaryLengthPrefixed(delegation)
+func MustMarshalDelegation(cdc codec.Marshaler, delegation Delegation) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&delegation)
 }
 
 // MustUnmarshalDelegation return the unmarshaled delegation from bytes.
 // Panics if fails.
-func MustUnmarshalDelegation(cdc *codec.Codec, value []byte) Delegation {
+func MustUnmarshalDelegation(cdc codec.Marshaler, value []byte) Delegation {
 	delegation, err := UnmarshalDelegation(cdc, value)
 	if err != nil {
 		panic(err)
@@ -68,7 +42,7 @@ func MustUnmarshalDelegation(cdc *codec.Codec, value []byte) Delegation {
 }
 
 // return the delegation
-func UnmarshalDelegation(cdc *codec.Codec, value []byte) (delegation Delegation, err error) {
+func UnmarshalDelegation(cdc codec.Marshaler, value []byte) (delegation Delegation, err error) {
 	err = cdc.UnmarshalBinaryLengthPrefixed(value, &delegation)
 	return delegation, err
 }
@@ -104,27 +78,6 @@ func (d Delegations) String() (out string) {
 	return strings.TrimSpace(out)
 }
 
-// UnbondingDelegation stores all of a single delegator's unbonding bonds
-// for a single validator in an time-ordered list
-type UnbondingDelegation struct {
-	DelegatorAddress sdk.AccAddress             `json:"delegator_address" yaml:"delegator_address"` // delegator
-	ValidatorAddress sdk.ValAddress             `json:"validator_address" yaml:"validator_address"` // validator unbonding from operator addr
-	Entries          []UnbondingDelegationEntry `json:"entries" yaml:"entries"`                     // unbonding delegation entries
-}
-
-// UnbondingDelegationEntry - entry to an UnbondingDelegation
-type UnbondingDelegationEntry struct {
-	CreationHeight int64     `json:"creation_height" yaml:"creation_height"` // height which the unbonding took place
-	CompletionTime time.Time `json:"completion_time" yaml:"completion_time"` // time at which the unbonding delegation will complete
-	InitialBalance sdk.Int   `json:"initial_balance" yaml:"initial_balance"` // atoms initially scheduled to receive at completion
-	Balance        sdk.Int   `json:"balance" yaml:"balance"`                 // atoms to receive at completion
-}
-
-// IsMature - is the current entry mature
-func (e UnbondingDelegationEntry) IsMature(currentTime time.Time) bool {
-	return !e.CompletionTime.After(currentTime)
-}
-
 // NewUnbondingDelegation - create a new unbonding delegation object
 func NewUnbondingDelegation(delegatorAddr sdk.AccAddress,
 	validatorAddr sdk.ValAddress, creationHeight int64, minTime time.Time,
@@ -150,6 +103,11 @@ func NewUnbondingDelegationEntry(creationHeight int64, completionTime time.Time,
 	}
 }
 
+// IsMature - is the current entry mature
+func (e UnbondingDelegationEntry) IsMature(currentTime time.Time) bool {
+	return !e.CompletionTime.After(currentTime)
+}
+
 // AddEntry - append entry to the unbonding delegation
 func (d *UnbondingDelegation) AddEntry(creationHeight int64,
 	minTime time.Time, balance sdk.Int) {
@@ -164,12 +122,12 @@ func (d *UnbondingDelegation) RemoveEntry(i int64) {
 }
 
 // return the unbonding delegation
-func MustMarshalUBD(cdc *codec.Codec, ubd UnbondingDelegation) []byte {
-	return cdc.MustMarshalBinaryLengthPrefixed(ubd)
+func MustMarshalUBD(cdc codec.Marshaler, ubd UnbondingDelegation) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&ubd)
 }
 
 // unmarshal a unbonding delegation from a store value
-func MustUnmarshalUBD(cdc *codec.Codec, value []byte) UnbondingDelegation {
+func MustUnmarshalUBD(cdc codec.Marshaler, value []byte) UnbondingDelegation {
 	ubd, err := UnmarshalUBD(cdc, value)
 	if err != nil {
 		panic(err)
@@ -178,7 +136,7 @@ func MustUnmarshalUBD(cdc *codec.Codec, value []byte) UnbondingDelegation {
 }
 
 // unmarshal a unbonding delegation from a store value
-func UnmarshalUBD(cdc *codec.Codec, value []byte) (ubd UnbondingDelegation, err error) {
+func UnmarshalUBD(cdc codec.Marshaler, value []byte) (ubd UnbondingDelegation, err error) {
 	err = cdc.UnmarshalBinaryLengthPrefixed(value, &ubd)
 	return ubd, err
 }
@@ -217,24 +175,6 @@ func (ubds UnbondingDelegations) String() (out string) {
 	return strings.TrimSpace(out)
 }
 
-// Redelegation contains the list of a particular delegator's
-// redelegating bonds from a particular source validator to a
-// particular destination validator
-type Redelegation struct {
-	DelegatorAddress    sdk.AccAddress      `json:"delegator_address" yaml:"delegator_address"`         // delegator
-	ValidatorSrcAddress sdk.ValAddress      `json:"validator_src_address" yaml:"validator_src_address"` // validator redelegation source operator addr
-	ValidatorDstAddress sdk.ValAddress      `json:"validator_dst_address" yaml:"validator_dst_address"` // validator redelegation destination operator addr
-	Entries             []RedelegationEntry `json:"entries" yaml:"entries"`                             // redelegation entries
-}
-
-// RedelegationEntry - entry to a Redelegation
-type RedelegationEntry struct {
-	CreationHeight int64     `json:"creation_height" yaml:"creation_height"` // height at which the redelegation took place
-	CompletionTime time.Time `json:"completion_time" yaml:"completion_time"` // time at which the redelegation will complete
-	InitialBalance sdk.Int   `json:"initial_balance" yaml:"initial_balance"` // initial balance when redelegation started
-	SharesDst      sdk.Dec   `json:"shares_dst" yaml:"shares_dst"`           // amount of destination-validator shares created by redelegation
-}
-
 // NewRedelegation - create a new redelegation object
 func NewRedelegation(delegatorAddr sdk.AccAddress, validatorSrcAddr,
 	validatorDstAddr sdk.ValAddress, creationHeight int64,
@@ -285,12 +225,12 @@ func (d *Redelegation) RemoveEntry(i int64) {
 }
 
 // MustMarshalRED returns the Redelegation bytes. Panics if fails.
-func MustMarshalRED(cdc *codec.Codec, red Redelegation) []byte {
-	return cdc.MustMarshalBinaryLengthPrefixed(red)
+func MustMarshalRED(cdc codec.Marshaler, red Redelegation) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&red)
 }
 
 // MustUnmarshalRED unmarshals a redelegation from a store value. Panics if fails.
-func MustUnmarshalRED(cdc *codec.Codec, value []byte) Redelegation {
+func MustUnmarshalRED(cdc codec.Marshaler, value []byte) Redelegation {
 	red, err := UnmarshalRED(cdc, value)
 	if err != nil {
 		panic(err)
@@ -299,7 +239,7 @@ func MustUnmarshalRED(cdc *codec.Codec, value []byte) Redelegation {
 }
 
 // UnmarshalRED unmarshals a redelegation from a store value
-func UnmarshalRED(cdc *codec.Codec, value []byte) (red Redelegation, err error) {
+func UnmarshalRED(cdc codec.Marshaler, value []byte) (red Redelegation, err error) {
 	err = cdc.UnmarshalBinaryLengthPrefixed(value, &red)
 	return red, err
 }
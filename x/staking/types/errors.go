@@ -75,6 +75,10 @@ func ErrCommissionGTMaxRate(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "commission cannot be more than the max rate")
 }
 
+func ErrCommissionLTMinRate(codespace sdk.CodespaceType, minRate sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, fmt.Sprintf("commission cannot be less than the min commission rate %s", minRate))
+}
+
 func ErrCommissionUpdateTime(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "commission cannot be changed more than once in 24h")
 }
@@ -161,6 +165,19 @@ func ErrNoUnbondingDelegation(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation, "no unbonding delegation found")
 }
 
+func ErrNoUnbondingDelegationEntry(codespace sdk.CodespaceType, creationHeight int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		fmt.Sprintf("no unbonding delegation entry found with creation height %d", creationHeight))
+}
+
+func ErrInvalidCreationHeight(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "creation height cannot be negative")
+}
+
+func ErrUnbondingDelegationEntryMature(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "unbonding delegation entry has already matured")
+}
+
 func ErrMaxUnbondingDelegationEntries(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation,
 		"too many unbonding delegation entries in this delegator/validator duo, please wait for some entries to mature")
@@ -212,3 +229,42 @@ func ErrNeitherShareMsgsGiven(codespace sdk.CodespaceType) sdk.Error {
 func ErrMissingSignature(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "missing signature")
 }
+
+func ErrTokenizeSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "validator operators cannot tokenize their self-delegation")
+}
+
+func ErrTokenizeVestingDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "vesting accounts cannot tokenize their delegations")
+}
+
+func ErrTokenizeRedelegationInProgress(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"redelegation to this validator in progress, redelegation must complete before tokenizing shares")
+}
+
+func ErrTinyTokenizeShares(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "too few tokens to tokenize, truncates to zero shares")
+}
+
+func ErrNoTokenizeShareRecord(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "no tokenize share record found for that denom")
+}
+
+func ErrSameValidatorOperator(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "new operator address must be different from the current one")
+}
+
+func ErrNewOperatorHasDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"new operator account cannot have a delegation or redelegation with the validator")
+}
+
+func ErrChangeOperatorVestingAccount(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"the current and new operator accounts of a validator cannot be vesting accounts")
+}
+
+func ErrNoHistoricalInfo(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "no historical info found for that height")
+}
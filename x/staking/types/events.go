@@ -2,13 +2,18 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding    = "complete_unbonding"
-	EventTypeCompleteRedelegation = "complete_redelegation"
-	EventTypeCreateValidator      = "create_validator"
-	EventTypeEditValidator        = "edit_validator"
-	EventTypeDelegate             = "delegate"
-	EventTypeUnbond               = "unbond"
-	EventTypeRedelegate           = "redelegate"
+	EventTypeCompleteUnbonding       = "complete_unbonding"
+	EventTypeCompleteRedelegation    = "complete_redelegation"
+	EventTypeCreateValidator         = "create_validator"
+	EventTypeEditValidator           = "edit_validator"
+	EventTypeDelegate                = "delegate"
+	EventTypeUnbond                  = "unbond"
+	EventTypeRedelegate              = "redelegate"
+	EventTypeTokenizeShares          = "tokenize_shares"
+	EventTypeRedeemShares            = "redeem_tokens_for_shares"
+	EventTypeCancelUnbonding         = "cancel_unbonding_delegation"
+	EventTypeChangeValidatorOperator = "change_validator_operator"
+	EventTypeRotateConsPubKey        = "rotate_cons_pubkey"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -17,5 +22,9 @@ const (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyShareTokens       = "share_tokens"
+	AttributeKeyCreationHeight    = "creation_height"
+	AttributeKeyNewValidator      = "new_validator"
+	AttributeKeyConsPubKey        = "cons_pubkey"
 	AttributeValueCategory        = ModuleName
 )
@@ -14,6 +14,9 @@ type GenesisState struct {
 	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations" yaml:"unbonding_delegations"`
 	Redelegations        []Redelegation        `json:"redelegations" yaml:"redelegations"`
 	Exported             bool                  `json:"exported" yaml:"exported"`
+	LastTokenizeShareID  uint64                `json:"last_tokenize_share_id,omitempty" yaml:"last_tokenize_share_id,omitempty"`
+	TokenizeShareRecords []TokenizeShareRecord `json:"tokenize_share_records,omitempty" yaml:"tokenize_share_records,omitempty"`
+	ConsPubKeyRotations  []ConsPubKeyRotation  `json:"cons_pubkey_rotations,omitempty" yaml:"cons_pubkey_rotations,omitempty"`
 }
 
 // LastValidatorPower required for validator set update logic
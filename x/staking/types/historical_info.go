@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// NewHistoricalInfo creates a new HistoricalInfo instance from a header and a
+// validator set. The validator set is sorted by operator address before being
+// included in the historical info.
+func NewHistoricalInfo(header abci.Header, valSet Validators) HistoricalInfo {
+	valSet.Sort()
+	return HistoricalInfo{
+		Header: header,
+		Valset: valSet,
+	}
+}
+
+// MustMarshalHistoricalInfo returns the historical info bytes. Panics if fails.
+func MustMarshalHistoricalInfo(cdc codec.Marshaler, hi HistoricalInfo) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&hi)
+}
+
+// MustUnmarshalHistoricalInfo unmarshals a historical info from a store value.
+// Panics if fails.
+func MustUnmarshalHistoricalInfo(cdc codec.Marshaler, value []byte) HistoricalInfo {
+	hi, err := UnmarshalHistoricalInfo(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return hi
+}
+
+// UnmarshalHistoricalInfo unmarshals a historical info from a store value.
+func UnmarshalHistoricalInfo(cdc codec.Marshaler, value []byte) (hi HistoricalInfo, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &hi)
+	return hi, err
+}
+
+// String returns a human readable string representation of a historical info.
+func (hi HistoricalInfo) String() string {
+	return fmt.Sprintf(`Historical Info:
+  Height:     %d
+  Time:       %s
+  Validators:
+%s`, hi.Header.Height, hi.Header.Time, Validators(hi.Valset))
+}
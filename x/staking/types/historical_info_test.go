@@ -0,0 +1,50 @@
+package types
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var header = abci.Header{
+	ChainID: "hello",
+	Height:  5,
+}
+
+func createValidators() []Validator {
+	return []Validator{
+		NewValidator(valAddr1, pk1, Description{}),
+		NewValidator(valAddr2, pk2, Description{}),
+		NewValidator(valAddr3, pk3, Description{}),
+	}
+}
+
+func TestHistoricalInfo(t *testing.T) {
+	cdc := codec.NewHybridCodec(codec.New())
+	validators := createValidators()
+	rand.Shuffle(len(validators), func(i, j int) {
+		validators[i], validators[j] = validators[j], validators[i]
+	})
+
+	hi := NewHistoricalInfo(header, validators)
+	require.True(t, sort.IsSorted(Validators(hi.Valset)), "Validators are not sorted")
+
+	var value []byte
+	require.NotPanics(t, func() {
+		value = MustMarshalHistoricalInfo(cdc, hi)
+	})
+	require.NotNil(t, value, "Marshalled HistoricalInfo is nil")
+
+	recv, err := UnmarshalHistoricalInfo(cdc, value)
+	require.Nil(t, err, "Unmarshalling HistoricalInfo failed")
+	require.Equal(t, hi.Header, recv.Header)
+	for i := range hi.Valset {
+		require.True(t, hi.Valset[i].TestEquivalent(recv.Valset[i]))
+	}
+	require.True(t, sort.IsSorted(Validators(recv.Valset)), "Validators are not sorted")
+}
@@ -62,3 +62,13 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 		h[i].BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+func (h MultiStakingHooks) AfterValidatorOperatorChanged(ctx sdk.Context, oldValAddr, newValAddr sdk.ValAddress) {
+	for i := range h {
+		h[i].AfterValidatorOperatorChanged(ctx, oldValAddr, newValAddr)
+	}
+}
+func (h MultiStakingHooks) AfterConsPubKeyRotated(ctx sdk.Context, valAddr sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	for i := range h {
+		h[i].AfterConsPubKeyRotated(ctx, valAddr, oldConsAddr, newConsAddr)
+	}
+}
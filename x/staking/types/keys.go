@@ -45,6 +45,15 @@ var (
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+
+	HistoricalInfoKey = []byte{0x50} // prefix for the historical info of each height
+
+	TokenizeShareDenomKey     = []byte{0x51} // prefix for each key to the tokenize share denom of a validator
+	TokenizeShareValidatorKey = []byte{0x52} // prefix for each key to the validator of a tokenize share denom
+	LastTokenizeShareIDKey    = []byte{0x53} // key for the last assigned tokenize share denom id
+
+	RotatedConsPubKeyKey         = []byte{0x61} // prefix for each key to a consensus pubkey a validator rotated away from
+	PendingConsPubKeyRotationKey = []byte{0x62} // prefix for each key to the consensus pubkey a validator rotated away from during the current block
 )
 
 // gets the key for the validator with address
@@ -278,3 +287,37 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 		GetREDsToValDstIndexKey(valDstAddr),
 		delAddr.Bytes()...)
 }
+
+//______________
+
+// gets the key for the tokenize share denom of a validator
+// VALUE: share denom ([]byte)
+func GetTokenizeShareDenomKey(valAddr sdk.ValAddress) []byte {
+	return append(TokenizeShareDenomKey, valAddr.Bytes()...)
+}
+
+// gets the key for the validator backing a tokenize share denom
+// VALUE: validator operator address ([]byte)
+func GetTokenizeShareValidatorKey(denom string) []byte {
+	return append(TokenizeShareValidatorKey, []byte(denom)...)
+}
+
+// gets the key for a consensus pubkey a validator rotated away from
+// VALUE: bech32 consensus pubkey
+func GetRotatedConsPubKeyKey(addr sdk.ConsAddress) []byte {
+	return append(RotatedConsPubKeyKey, addr.Bytes()...)
+}
+
+// gets the key for the consensus pubkey a validator rotated away from during
+// the current block
+// VALUE: bech32 consensus pubkey
+func GetPendingConsPubKeyRotationKey(operatorAddr sdk.ValAddress) []byte {
+	return append(PendingConsPubKeyRotationKey, operatorAddr.Bytes()...)
+}
+
+// gets the key for the historical info of a height, the big endian height
+// keeps the historical infos ordered from the oldest one
+// VALUE: staking/HistoricalInfo
+func GetHistoricalInfoKey(height int64) []byte {
+	return append(HistoricalInfoKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
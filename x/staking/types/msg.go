@@ -17,6 +17,11 @@ var (
 	_ sdk.Msg = &MsgDelegate{}
 	_ sdk.Msg = &MsgUndelegate{}
 	_ sdk.Msg = &MsgBeginRedelegate{}
+	_ sdk.Msg = &MsgTokenizeShares{}
+	_ sdk.Msg = &MsgRedeemTokensForShares{}
+	_ sdk.Msg = &MsgCancelUnbondingDelegation{}
+	_ sdk.Msg = &MsgChangeValidatorOperator{}
+	_ sdk.Msg = &MsgRotateConsPubKey{}
 )
 
 //______________________________________________________________________
@@ -395,3 +400,281 @@ func (msg MsgUndelegate) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// MsgTokenizeShares - struct for converting a delegation into transferable
+// share tokens
+type MsgTokenizeShares struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) MsgTokenizeShares {
+	return MsgTokenizeShares{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) Type() string { return "tokenize_shares" }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if !msg.Amount.Amount.IsPositive() {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgRedeemTokensForShares - struct for converting share tokens back into a
+// delegation
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr sdk.AccAddress, amount sdk.Coin) MsgRedeemTokensForShares {
+	return MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) Type() string { return "redeem_tokens_for_shares" }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	if _, err := ParseTokenizeShareDenom(msg.Amount.Denom); err != nil {
+		return ErrBadDenom(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgCancelUnbondingDelegation - struct for cancelling an unbonding delegation
+// entry and delegating its remaining balance back to the validator
+type MsgCancelUnbondingDelegation struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	CreationHeight   int64          `json:"creation_height" yaml:"creation_height"`
+}
+
+// NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation instance.
+func NewMsgCancelUnbondingDelegation(
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64,
+) MsgCancelUnbondingDelegation {
+	return MsgCancelUnbondingDelegation{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		CreationHeight:   creationHeight,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) Type() string { return "cancel_unbonding_delegation" }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.CreationHeight < 0 {
+		return ErrInvalidCreationHeight(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgChangeValidatorOperator - struct for moving a validator to the operator
+// address of a new account
+type MsgChangeValidatorOperator struct {
+	ValidatorAddress   sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	NewOperatorAddress sdk.AccAddress `json:"new_operator_address" yaml:"new_operator_address"`
+}
+
+// NewMsgChangeValidatorOperator creates a new MsgChangeValidatorOperator instance.
+func NewMsgChangeValidatorOperator(valAddr sdk.ValAddress, newOperator sdk.AccAddress) MsgChangeValidatorOperator {
+	return MsgChangeValidatorOperator{
+		ValidatorAddress:   valAddr,
+		NewOperatorAddress: newOperator,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgChangeValidatorOperator) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgChangeValidatorOperator) Type() string { return "change_validator_operator" }
+
+// GetSigners implements the sdk.Msg interface. The new operator must sign as
+// well, so that a validator cannot be moved to an account without its consent.
+func (msg MsgChangeValidatorOperator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress), msg.NewOperatorAddress}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgChangeValidatorOperator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgChangeValidatorOperator) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.NewOperatorAddress.Empty() {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "nil new operator address")
+	}
+	if sdk.AccAddress(msg.ValidatorAddress).Equals(msg.NewOperatorAddress) {
+		return ErrSameValidatorOperator(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgRotateConsPubKey - struct for replacing the consensus pubkey of a validator
+type MsgRotateConsPubKey struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	PubKey           crypto.PubKey  `json:"pubkey" yaml:"pubkey"`
+}
+
+type msgRotateConsPubKeyJSON struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	PubKey           string         `json:"pubkey" yaml:"pubkey"`
+}
+
+// NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
+func NewMsgRotateConsPubKey(valAddr sdk.ValAddress, pubKey crypto.PubKey) MsgRotateConsPubKey {
+	return MsgRotateConsPubKey{
+		ValidatorAddress: valAddr,
+		PubKey:           pubKey,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Type() string { return "rotate_cons_pubkey" }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
+}
+
+// MarshalJSON implements the json.Marshaler interface to provide custom JSON
+// serialization of the MsgRotateConsPubKey type.
+func (msg MsgRotateConsPubKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(msgRotateConsPubKeyJSON{
+		ValidatorAddress: msg.ValidatorAddress,
+		PubKey:           sdk.MustBech32ifyConsPub(msg.PubKey),
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to provide custom
+// JSON deserialization of the MsgRotateConsPubKey type.
+func (msg *MsgRotateConsPubKey) UnmarshalJSON(bz []byte) error {
+	var msgRotateJSON msgRotateConsPubKeyJSON
+	if err := json.Unmarshal(bz, &msgRotateJSON); err != nil {
+		return err
+	}
+
+	msg.ValidatorAddress = msgRotateJSON.ValidatorAddress
+	var err error
+	msg.PubKey, err = sdk.GetConsPubKeyBech32(msgRotateJSON.PubKey)
+	return err
+}
+
+// MarshalYAML implements a custom marshal yaml function due to consensus pubkey.
+func (msg MsgRotateConsPubKey) MarshalYAML() (interface{}, error) {
+	bs, err := yaml.Marshal(struct {
+		ValidatorAddress sdk.ValAddress
+		PubKey           string
+	}{
+		ValidatorAddress: msg.ValidatorAddress,
+		PubKey:           sdk.MustBech32ifyConsPub(msg.PubKey),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bs), nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.PubKey == nil {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "nil consensus pubkey")
+	}
+	return nil
+}
@@ -158,6 +158,144 @@ func TestMsgUndelegate(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgCancelUnbondingDelegation
+func TestMsgCancelUnbondingDelegation(t *testing.T) {
+	tests := []struct {
+		name           string
+		delegatorAddr  sdk.AccAddress
+		validatorAddr  sdk.ValAddress
+		creationHeight int64
+		expectPass     bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, 10, true},
+		{"genesis height", sdk.AccAddress(valAddr1), valAddr2, 0, true},
+		{"negative height", sdk.AccAddress(valAddr1), valAddr2, -1, false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, 10, false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, 10, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgCancelUnbondingDelegation(tc.delegatorAddr, tc.validatorAddr, tc.creationHeight)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgChangeValidatorOperator
+func TestMsgChangeValidatorOperator(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorAddr sdk.ValAddress
+		newOperator   sdk.AccAddress
+		expectPass    bool
+	}{
+		{"regular", valAddr1, sdk.AccAddress(valAddr2), true},
+		{"empty validator", emptyAddr, sdk.AccAddress(valAddr2), false},
+		{"empty new operator", valAddr1, sdk.AccAddress(emptyAddr), false},
+		{"same operator", valAddr1, sdk.AccAddress(valAddr1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgChangeValidatorOperator(tc.validatorAddr, tc.newOperator)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+
+	// both the current and the new operator sign the msg
+	msg := NewMsgChangeValidatorOperator(valAddr1, sdk.AccAddress(valAddr2))
+	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(valAddr1), sdk.AccAddress(valAddr2)}, msg.GetSigners())
+}
+
+// test ValidateBasic for MsgRotateConsPubKey
+func TestMsgRotateConsPubKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorAddr sdk.ValAddress
+		pubkey        crypto.PubKey
+		expectPass    bool
+	}{
+		{"regular", valAddr1, pk2, true},
+		{"empty validator", emptyAddr, pk2, false},
+		{"empty pubkey", valAddr1, emptyPubkey, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRotateConsPubKey(tc.validatorAddr, tc.pubkey)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgRotateConsPubKeyMarshalJSON(t *testing.T) {
+	msg := NewMsgRotateConsPubKey(valAddr1, pk2)
+	bz, err := ModuleCdc.MarshalJSON(msg)
+	require.NoError(t, err)
+
+	var got MsgRotateConsPubKey
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &got))
+	require.Equal(t, msg, got)
+}
+
+// test ValidateBasic for MsgTokenizeShares
+func TestMsgTokenizeShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTokenizeShares(tc.delegatorAddr, tc.validatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgRedeemTokensForShares
+func TestMsgRedeemTokensForShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(TokenizeShareDenom(1), 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(TokenizeShareDenom(1), 0), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), sdk.NewInt64Coin(TokenizeShareDenom(1), 1), false},
+		{"bond denom", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"zero share id", sdk.AccAddress(valAddr1), sdk.NewInt64Coin("share0", 1), false},
+		{"padded share id", sdk.AccAddress(valAddr1), sdk.NewInt64Coin("share01", 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedeemTokensForShares(tc.delegatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 //test to validate if NewMsgCreateValidator implements yaml marshaller
 func TestMsgMarshalYAML(t *testing.T) {
 	commission1 := NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
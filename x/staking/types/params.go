@@ -24,35 +24,48 @@ const (
 
 	// Default maximum entries in a UBD/RED pair
 	DefaultMaxEntries uint16 = 7
+
+	// Default number of recent historical infos kept
+	DefaultHistoricalEntries uint16 = 100
 )
 
 // nolint - Keys for parameter access
 var (
-	KeyUnbondingTime = []byte("UnbondingTime")
-	KeyMaxValidators = []byte("MaxValidators")
-	KeyMaxEntries    = []byte("KeyMaxEntries")
-	KeyBondDenom     = []byte("BondDenom")
+	KeyUnbondingTime     = []byte("UnbondingTime")
+	KeyMaxValidators     = []byte("MaxValidators")
+	KeyMaxEntries        = []byte("KeyMaxEntries")
+	KeyHistoricalEntries = []byte("HistoricalEntries")
+	KeyBondDenom         = []byte("BondDenom")
+	KeyMinCommissionRate = []byte("MinCommissionRate")
 )
 
+// DefaultMinCommissionRate is the default minimum commission rate of the
+// validators, zero leaves the commission unrestricted.
+var DefaultMinCommissionRate = sdk.ZeroDec()
+
 var _ params.ParamSet = (*Params)(nil)
 
 // Params defines the high level settings for staking
 type Params struct {
-	UnbondingTime time.Duration `json:"unbonding_time" yaml:"unbonding_time"` // time duration of unbonding
-	MaxValidators uint16        `json:"max_validators" yaml:"max_validators"` // maximum number of validators (max uint16 = 65535)
-	MaxEntries    uint16        `json:"max_entries" yaml:"max_entries"`       // max entries for either unbonding delegation or redelegation (per pair/trio)
-	BondDenom     string        `json:"bond_denom" yaml:"bond_denom"`         // bondable coin denomination
+	UnbondingTime     time.Duration `json:"unbonding_time" yaml:"unbonding_time"`           // time duration of unbonding
+	MaxValidators     uint16        `json:"max_validators" yaml:"max_validators"`           // maximum number of validators (max uint16 = 65535)
+	MaxEntries        uint16        `json:"max_entries" yaml:"max_entries"`                 // max entries for either unbonding delegation or redelegation (per pair/trio)
+	HistoricalEntries uint16        `json:"historical_entries" yaml:"historical_entries"`   // number of recent historical infos kept, zero disables them
+	BondDenom         string        `json:"bond_denom" yaml:"bond_denom"`                   // bondable coin denomination
+	MinCommissionRate sdk.Dec       `json:"min_commission_rate" yaml:"min_commission_rate"` // minimum commission rate charged by every validator
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint16,
-	bondDenom string) Params {
+func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint16,
+	bondDenom string, minCommissionRate sdk.Dec) Params {
 
 	return Params{
-		UnbondingTime: unbondingTime,
-		MaxValidators: maxValidators,
-		MaxEntries:    maxEntries,
-		BondDenom:     bondDenom,
+		UnbondingTime:     unbondingTime,
+		MaxValidators:     maxValidators,
+		MaxEntries:        maxEntries,
+		HistoricalEntries: historicalEntries,
+		BondDenom:         bondDenom,
+		MinCommissionRate: minCommissionRate,
 	}
 }
 
@@ -62,7 +75,9 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyUnbondingTime, &p.UnbondingTime, validateUnbondingTime),
 		params.NewParamSetPair(KeyMaxValidators, &p.MaxValidators, validateMaxValidators),
 		params.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
+		params.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		params.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		params.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
 	}
 }
 
@@ -76,17 +91,19 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom)
+	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, DefaultHistoricalEntries, sdk.DefaultBondDenom, DefaultMinCommissionRate)
 }
 
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-  Unbonding Time:    %s
-  Max Validators:    %d
-  Max Entries:       %d
-  Bonded Coin Denom: %s`, p.UnbondingTime,
-		p.MaxValidators, p.MaxEntries, p.BondDenom)
+  Unbonding Time:      %s
+  Max Validators:      %d
+  Max Entries:         %d
+  Historical Entries:  %d
+  Bonded Coin Denom:   %s
+  Min Commission Rate: %s`, p.UnbondingTime,
+		p.MaxValidators, p.MaxEntries, p.HistoricalEntries, p.BondDenom, p.MinCommissionRate)
 }
 
 // unmarshal the current staking params value from store key or panic
@@ -118,9 +135,15 @@ func (p Params) Validate() error {
 	if err := validateMaxEntries(p.MaxEntries); err != nil {
 		return err
 	}
+	if err := validateHistoricalEntries(p.HistoricalEntries); err != nil {
+		return err
+	}
 	if err := validateBondDenom(p.BondDenom); err != nil {
 		return err
 	}
+	if err := validateMinCommissionRate(p.MinCommissionRate); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -164,6 +187,15 @@ func validateMaxEntries(i interface{}) error {
 	return nil
 }
 
+func validateHistoricalEntries(i interface{}) error {
+	_, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateBondDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -179,3 +211,22 @@ func validateBondDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("min commission rate cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min commission rate cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min commission rate too large: %s", v)
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -19,3 +21,20 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestValidateParamsMinCommissionRate(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.MinCommissionRate = sdk.OneDec()
+	require.NoError(t, params.Validate())
+
+	params.MinCommissionRate = sdk.NewDecWithPrec(-1, 2)
+	require.Error(t, params.Validate())
+
+	params.MinCommissionRate = sdk.NewDecWithPrec(101, 2)
+	require.Error(t, params.Validate())
+
+	params.MinCommissionRate = sdk.Dec{}
+	require.Error(t, params.Validate())
+}
@@ -11,9 +11,12 @@ import (
 // - NotBondedPool -> "not_bonded_tokens_pool"
 //
 // - BondedPool -> "bonded_tokens_pool"
+//
+// - TokenizeSharePool -> "tokenize_share_pool"
 const (
-	NotBondedPoolName = "not_bonded_tokens_pool"
-	BondedPoolName    = "bonded_tokens_pool"
+	NotBondedPoolName     = "not_bonded_tokens_pool"
+	BondedPoolName        = "bonded_tokens_pool"
+	TokenizeSharePoolName = "tokenize_share_pool"
 )
 
 // Pool - tracking bonded and not-bonded token supply of the bond denomination
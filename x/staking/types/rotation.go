@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConsPubKeyRotation records a consensus pubkey a validator rotated away from.
+// A rotated pubkey keeps resolving to its validator, so that votes and
+// evidence signed with it can still be attributed to the validator, and it can
+// never be used by another validator.
+type ConsPubKeyRotation struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	ConsPubKey       string         `json:"cons_pubkey" yaml:"cons_pubkey"`
+}
+
+// NewConsPubKeyRotation creates a new ConsPubKeyRotation instance
+func NewConsPubKeyRotation(valAddr sdk.ValAddress, pubKey crypto.PubKey) ConsPubKeyRotation {
+	return ConsPubKeyRotation{
+		ValidatorAddress: valAddr,
+		ConsPubKey:       sdk.MustBech32ifyConsPub(pubKey),
+	}
+}
+
+// GetConsPubKey returns the rotated consensus pubkey
+func (r ConsPubKeyRotation) GetConsPubKey() crypto.PubKey {
+	return sdk.MustGetConsPubKeyBech32(r.ConsPubKey)
+}
+
+// String implements the Stringer interface for a ConsPubKeyRotation object.
+func (r ConsPubKeyRotation) String() string {
+	return fmt.Sprintf(`Consensus PubKey Rotation:
+  Validator:  %s
+  ConsPubKey: %s`, r.ValidatorAddress, r.ConsPubKey)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenizeShareDenomPrefix is the prefix of every tokenized share denom. The
+// prefix is followed by the decimal id assigned to the validator the first
+// time one of its delegations is tokenized, e.g. "share1".
+const TokenizeShareDenomPrefix = "share"
+
+// TokenizeShareRecord maps a validator to the denom of its tokenized shares.
+// One unit of the denom is backed by exactly one delegation share held by the
+// tokenize share pool.
+type TokenizeShareRecord struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Denom            string         `json:"denom" yaml:"denom"`
+}
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance
+func NewTokenizeShareRecord(valAddr sdk.ValAddress, denom string) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		ValidatorAddress: valAddr,
+		Denom:            denom,
+	}
+}
+
+// String implements the Stringer interface for a TokenizeShareRecord object.
+func (r TokenizeShareRecord) String() string {
+	return fmt.Sprintf(`Tokenize Share Record:
+  Validator: %s
+  Denom:     %s`, r.ValidatorAddress, r.Denom)
+}
+
+// TokenizeShareDenom returns the tokenized share denom for the given id
+func TokenizeShareDenom(id uint64) string {
+	return fmt.Sprintf("%s%d", TokenizeShareDenomPrefix, id)
+}
+
+// ParseTokenizeShareDenom returns the id encoded in a tokenized share denom
+func ParseTokenizeShareDenom(denom string) (uint64, error) {
+	if !strings.HasPrefix(denom, TokenizeShareDenomPrefix) {
+		return 0, fmt.Errorf("invalid tokenize share denom: %s", denom)
+	}
+
+	id, err := strconv.ParseUint(strings.TrimPrefix(denom, TokenizeShareDenomPrefix), 10, 64)
+	if err != nil || id == 0 || TokenizeShareDenom(id) != denom {
+		return 0, fmt.Errorf("invalid tokenize share denom: %s", denom)
+	}
+
+	return id, nil
+}
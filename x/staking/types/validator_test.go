@@ -59,7 +59,7 @@ func TestABCIValidatorUpdate(t *testing.T) {
 	validator := NewValidator(valAddr1, pk1, Description{})
 
 	abciVal := validator.ABCIValidatorUpdate()
-	require.Equal(t, tmtypes.TM2PB.PubKey(validator.ConsPubKey), abciVal.PubKey)
+	require.Equal(t, tmtypes.TM2PB.PubKey(validator.GetConsPubKey()), abciVal.PubKey)
 	require.Equal(t, validator.BondedTokens().Int64(), abciVal.Power)
 }
 
@@ -67,14 +67,14 @@ func TestABCIValidatorUpdateZero(t *testing.T) {
 	validator := NewValidator(valAddr1, pk1, Description{})
 
 	abciVal := validator.ABCIValidatorUpdateZero()
-	require.Equal(t, tmtypes.TM2PB.PubKey(validator.ConsPubKey), abciVal.PubKey)
+	require.Equal(t, tmtypes.TM2PB.PubKey(validator.GetConsPubKey()), abciVal.PubKey)
 	require.Equal(t, int64(0), abciVal.Power)
 }
 
 func TestShareTokens(t *testing.T) {
 	validator := Validator{
 		OperatorAddress: valAddr1,
-		ConsPubKey:      pk1,
+		ConsPubKey:      sdk.MustBech32ifyConsPub(pk1),
 		Status:          sdk.Bonded,
 		Tokens:          sdk.NewInt(100),
 		DelegatorShares: sdk.NewDec(100),
@@ -92,7 +92,7 @@ func TestRemoveTokens(t *testing.T) {
 
 	validator := Validator{
 		OperatorAddress: valAddr,
-		ConsPubKey:      valPubKey,
+		ConsPubKey:      sdk.MustBech32ifyConsPub(valPubKey),
 		Status:          sdk.Bonded,
 		Tokens:          sdk.NewInt(100),
 		DelegatorShares: sdk.NewDec(100),
@@ -148,7 +148,7 @@ func TestAddTokensValidatorUnbonded(t *testing.T) {
 func TestRemoveDelShares(t *testing.T) {
 	valA := Validator{
 		OperatorAddress: sdk.ValAddress(pk1.Address().Bytes()),
-		ConsPubKey:      pk1,
+		ConsPubKey:      sdk.MustBech32ifyConsPub(pk1),
 		Status:          sdk.Bonded,
 		Tokens:          sdk.NewInt(100),
 		DelegatorShares: sdk.NewDec(100),
@@ -165,7 +165,7 @@ func TestRemoveDelShares(t *testing.T) {
 	delShares := sdk.NewDec(115)
 	validator := Validator{
 		OperatorAddress: sdk.ValAddress(pk1.Address().Bytes()),
-		ConsPubKey:      pk1,
+		ConsPubKey:      sdk.MustBech32ifyConsPub(pk1),
 		Status:          sdk.Bonded,
 		Tokens:          poolTokens,
 		DelegatorShares: delShares,
@@ -214,7 +214,7 @@ func TestPossibleOverflow(t *testing.T) {
 	delShares := sdk.NewDec(391432570689183511).Quo(sdk.NewDec(40113011844664))
 	validator := Validator{
 		OperatorAddress: sdk.ValAddress(pk1.Address().Bytes()),
-		ConsPubKey:      pk1,
+		ConsPubKey:      sdk.MustBech32ifyConsPub(pk1),
 		Status:          sdk.Bonded,
 		Tokens:          sdk.NewInt(2159),
 		DelegatorShares: delShares,
@@ -274,7 +274,7 @@ func TestValidatorSetInitialCommission(t *testing.T) {
 
 func TestValidatorMarshalYAML(t *testing.T) {
 	validator := NewValidator(valAddr1, pk1, Description{})
-	bechifiedPub, err := sdk.Bech32ifyConsPub(validator.ConsPubKey)
+	bechifiedPub, err := sdk.Bech32ifyConsPub(pk1)
 	require.NoError(t, err)
 	bs, err := yaml.Marshal(validator)
 	require.NoError(t, err)
@@ -0,0 +1,16 @@
+package supply
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker records the total supply of each denomination.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	for _, coin := range k.GetSupply(ctx).GetTotal() {
+		amount, _ := new(big.Float).SetInt(coin.Amount.BigInt()).Float64()
+		telemetry.Default().Supply.With(telemetry.LabelDenom, coin.Denom).Set(amount)
+	}
+}
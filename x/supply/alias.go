@@ -36,6 +36,9 @@ var (
 	DefaultGenesisState   = types.DefaultGenesisState
 	NewSupply             = types.NewSupply
 	DefaultSupply         = types.DefaultSupply
+	NewQueryServer        = keeper.NewQueryServer
+	RegisterQueryService  = types.RegisterQueryService
+	NewQueryClient        = types.NewQueryClient
 
 	// variable aliases
 	DefaultCodespace = keeper.DefaultCodespace
@@ -43,8 +46,14 @@ var (
 )
 
 type (
-	Keeper        = keeper.Keeper
-	ModuleAccount = types.ModuleAccount
-	GenesisState  = types.GenesisState
-	Supply        = types.Supply
+	Keeper                   = keeper.Keeper
+	ModuleAccount            = types.ModuleAccount
+	GenesisState             = types.GenesisState
+	Supply                   = types.Supply
+	QueryServer              = types.QueryServer
+	QueryClient              = types.QueryClient
+	QueryTotalSupplyRequest  = types.QueryTotalSupplyRequest
+	QueryTotalSupplyResponse = types.QueryTotalSupplyResponse
+	QuerySupplyOfRequest     = types.QuerySupplyOfRequest
+	QuerySupplyOfResponse    = types.QuerySupplyOfResponse
 )
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keep "github.com/cosmos/cosmos-sdk/x/supply/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
+)
+
+func TestGRPCQueryTotalSupply(t *testing.T) {
+	app, ctx := createTestApp(false)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keep.NewQueryServer(app.SupplyKeeper)
+
+	_, err := queryServer.TotalSupply(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := queryServer.TotalSupply(goCtx, &types.QueryTotalSupplyRequest{})
+	require.NoError(t, err)
+	require.True(t, sdk.Coins(res.Supply).IsZero())
+
+	supplyCoins := sdk.NewCoins(sdk.NewInt64Coin("atom", 2000), sdk.NewInt64Coin("photon", 50))
+	app.SupplyKeeper.SetSupply(ctx, types.NewSupply(supplyCoins))
+
+	res, err = queryServer.TotalSupply(goCtx, &types.QueryTotalSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, supplyCoins, sdk.Coins(res.Supply))
+}
+
+func TestGRPCQuerySupplyOf(t *testing.T) {
+	app, ctx := createTestApp(false)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keep.NewQueryServer(app.SupplyKeeper)
+
+	supplyCoins := sdk.NewCoins(sdk.NewInt64Coin("atom", 2000), sdk.NewInt64Coin("photon", 50))
+	app.SupplyKeeper.SetSupply(ctx, types.NewSupply(supplyCoins))
+
+	testCases := []struct {
+		msg       string
+		req       *types.QuerySupplyOfRequest
+		expCode   codes.Code
+		expAmount sdk.Coin
+	}{
+		{"nil request", nil, codes.InvalidArgument, sdk.Coin{}},
+		{"empty denom", &types.QuerySupplyOfRequest{}, codes.InvalidArgument, sdk.Coin{}},
+		{"invalid denom", &types.QuerySupplyOfRequest{Denom: "1atom"}, codes.InvalidArgument, sdk.Coin{}},
+		{"unknown denom", &types.QuerySupplyOfRequest{Denom: "btc"}, codes.OK, sdk.NewInt64Coin("btc", 0)},
+		{"existing denom", &types.QuerySupplyOfRequest{Denom: "atom"}, codes.OK, sdk.NewInt64Coin("atom", 2000)},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.SupplyOf(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Equal(t, tc.expAmount, res.Amount, tc.msg)
+		}
+	}
+}
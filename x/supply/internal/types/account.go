@@ -28,14 +28,6 @@ func init() {
 	authtypes.RegisterAccountTypeCodec(&ModuleAccount{}, "cosmos-sdk/ModuleAccount")
 }
 
-// ModuleAccount defines an account for modules that holds coins on a pool
-type ModuleAccount struct {
-	*authtypes.BaseAccount
-
-	Name        string   `json:"name" yaml:"name"`               // name of the module
-	Permissions []string `json:"permissions" yaml:"permissions"` // permissions of module account
-}
-
 // NewModuleAddress creates an AccAddress from the hash of the module's name
 func NewModuleAddress(name string) sdk.AccAddress {
 	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
@@ -0,0 +1,425 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: x/supply/internal/types/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/x/auth/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ModuleAccount defines an account for modules that holds coins on a pool.
+type ModuleAccount struct {
+	*types.BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account,omitempty" yaml:"base_account"`
+	Name               string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name" yaml:"name"`
+	Permissions        []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions" yaml:"permissions"`
+}
+
+func (m *ModuleAccount) Reset()      { *m = ModuleAccount{} }
+func (*ModuleAccount) ProtoMessage() {}
+func (*ModuleAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f8db597ed42ca91, []int{0}
+}
+func (m *ModuleAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccount.Merge(m, src)
+}
+func (m *ModuleAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleAccount proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ModuleAccount)(nil), "cosmos_sdk.x.supply.v1.ModuleAccount")
+}
+
+func init() {
+	proto.RegisterFile("x/supply/internal/types/types.proto", fileDescriptor_6f8db597ed42ca91)
+}
+
+var fileDescriptor_6f8db597ed42ca91 = []byte{
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4b, 0xc3, 0x30,
+	0x1c, 0xc5, 0x1b, 0x27, 0xc2, 0x5a, 0xbd, 0x54, 0xd1, 0x32, 0x21, 0x99, 0x15, 0x61, 0x20, 0x26,
+	0x4c, 0x3d, 0x79, 0xb3, 0x17, 0x4f, 0x5e, 0x76, 0xf0, 0xe0, 0x65, 0xa4, 0x5d, 0xd8, 0xca, 0xda,
+	0xa6, 0x2c, 0xe9, 0xd8, 0xbe, 0x85, 0x1f, 0x6b, 0xc7, 0x1d, 0x77, 0x0a, 0xae, 0xbd, 0xc8, 0x8e,
+	0xfd, 0x04, 0xd2, 0x46, 0xb1, 0x22, 0x5e, 0x92, 0xbc, 0xc7, 0xef, 0xbd, 0x90, 0xfc, 0xcd, 0xcb,
+	0x05, 0x11, 0x59, 0x9a, 0x46, 0x4b, 0x12, 0x26, 0x92, 0xcd, 0x12, 0x1a, 0x11, 0xb9, 0x4c, 0x99,
+	0xd0, 0x2b, 0x4e, 0x67, 0x5c, 0x72, 0xfb, 0x34, 0xe0, 0x22, 0xe6, 0x62, 0x28, 0x46, 0x53, 0xbc,
+	0xc0, 0x9a, 0xc7, 0xf3, 0x7e, 0xe7, 0x64, 0xcc, 0xc7, 0xbc, 0x46, 0x48, 0x75, 0xd2, 0x74, 0xc7,
+	0x59, 0x10, 0x9a, 0xc9, 0xc9, 0xdf, 0x1e, 0xf7, 0x03, 0x98, 0x47, 0xcf, 0x7c, 0x94, 0x45, 0xec,
+	0x31, 0x08, 0x78, 0x96, 0x48, 0x9b, 0x9a, 0x87, 0x3e, 0x15, 0x6c, 0x48, 0xb5, 0x76, 0x40, 0x17,
+	0xf4, 0xac, 0xdb, 0x0b, 0xfc, 0xeb, 0xc2, 0xaa, 0x0d, 0xcf, 0xfb, 0xd8, 0xa3, 0xe2, 0x3b, 0xe8,
+	0x9d, 0xaf, 0x15, 0x02, 0xa5, 0x42, 0xc7, 0x4b, 0x1a, 0x47, 0x0f, 0x6e, 0xb3, 0xc4, 0x1d, 0x58,
+	0xfe, 0x0f, 0x69, 0x5f, 0x9b, 0xfb, 0x09, 0x8d, 0x99, 0xb3, 0xd7, 0x05, 0xbd, 0xb6, 0x77, 0xb6,
+	0x53, 0xa8, 0xd6, 0xa5, 0x42, 0x96, 0xce, 0x56, 0xca, 0x1d, 0xd4, 0xa6, 0xfd, 0x64, 0x5a, 0x29,
+	0x9b, 0xc5, 0xa1, 0x10, 0x21, 0x4f, 0x84, 0xd3, 0xea, 0xb6, 0x7a, 0x6d, 0xef, 0x6a, 0xa7, 0x50,
+	0xd3, 0x2e, 0x15, 0xb2, 0x75, 0xb4, 0x61, 0xba, 0x83, 0x26, 0xe2, 0xbd, 0xac, 0xb6, 0xd0, 0xd8,
+	0x6c, 0xa1, 0xb1, 0xca, 0x21, 0x58, 0xe7, 0x10, 0xbc, 0xe7, 0x10, 0xbc, 0x15, 0xd0, 0x58, 0x17,
+	0xd0, 0xd8, 0x14, 0xd0, 0x78, 0xbd, 0x1f, 0x87, 0x72, 0x92, 0xf9, 0x38, 0xe0, 0x31, 0xd1, 0xcf,
+	0xfd, 0xda, 0x6e, 0xc4, 0x68, 0x4a, 0xfe, 0x19, 0x8b, 0x7f, 0x50, 0xff, 0xe4, 0xdd, 0xe7, 0x00,
+	0xc6, 0x93, 0x5d, 0x3b, 0xb8, 0x01, 0x00, 0x00,
+}
+
+func (m *ModuleAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ModuleAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
@@ -33,6 +33,9 @@ var (
 	NewQueryAppliedParams            = types.NewQueryAppliedParams
 	NewKeeper                        = keeper.NewKeeper
 	NewQuerier                       = keeper.NewQuerier
+	NewQueryServer                   = keeper.NewQueryServer
+	RegisterQueryService             = types.RegisterQueryService
+	NewQueryClient                   = types.NewQueryClient
 )
 
 type (
@@ -42,4 +45,10 @@ type (
 	CancelSoftwareUpgradeProposal = types.CancelSoftwareUpgradeProposal
 	QueryAppliedParams            = types.QueryAppliedParams
 	Keeper                        = keeper.Keeper
+	QueryServer                   = types.QueryServer
+	QueryClient                   = types.QueryClient
+	QueryCurrentPlanRequest       = types.QueryCurrentPlanRequest
+	QueryCurrentPlanResponse      = types.QueryCurrentPlanResponse
+	QueryAppliedPlanRequest       = types.QueryAppliedPlanRequest
+	QueryAppliedPlanResponse      = types.QueryAppliedPlanResponse
 )
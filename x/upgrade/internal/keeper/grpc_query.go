@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/types"
+)
+
+// queryServer implements the upgrade gRPC query service on top of a Keeper.
+type queryServer struct {
+	keeper Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the upgrade gRPC query service
+// backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{keeper: k}
+}
+
+// CurrentPlan implements the Query/CurrentPlan gRPC method.
+func (q queryServer) CurrentPlan(goCtx context.Context, req *types.QueryCurrentPlanRequest) (*types.QueryCurrentPlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	plan, found := q.keeper.GetUpgradePlan(ctx)
+	if !found {
+		return &types.QueryCurrentPlanResponse{}, nil
+	}
+
+	return &types.QueryCurrentPlanResponse{Plan: &plan}, nil
+}
+
+// AppliedPlan implements the Query/AppliedPlan gRPC method.
+func (q queryServer) AppliedPlan(goCtx context.Context, req *types.QueryAppliedPlanRequest) (*types.QueryAppliedPlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "plan name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryAppliedPlanResponse{Height: q.keeper.getDoneHeight(ctx, req.Name)}, nil
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keep "github.com/cosmos/cosmos-sdk/x/upgrade/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/types"
+)
+
+func createTestApp() (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 10, Time: time.Now()})
+
+	return app, ctx
+}
+
+func TestGRPCQueryCurrentPlan(t *testing.T) {
+	app, ctx := createTestApp()
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keep.NewQueryServer(app.UpgradeKeeper)
+
+	_, err := queryServer.CurrentPlan(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// no plan scheduled
+	res, err := queryServer.CurrentPlan(goCtx, &types.QueryCurrentPlanRequest{})
+	require.NoError(t, err)
+	require.Nil(t, res.Plan)
+
+	plan := types.Plan{Name: "test", Height: 20}
+	require.Nil(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	res, err = queryServer.CurrentPlan(goCtx, &types.QueryCurrentPlanRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &plan, res.Plan)
+}
+
+func TestGRPCQueryAppliedPlan(t *testing.T) {
+	app, ctx := createTestApp()
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keep.NewQueryServer(app.UpgradeKeeper)
+
+	plan := types.Plan{Name: "test", Height: 10}
+	app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan types.Plan) {})
+	app.UpgradeKeeper.ApplyUpgrade(ctx, plan)
+
+	testCases := []struct {
+		msg       string
+		req       *types.QueryAppliedPlanRequest
+		expCode   codes.Code
+		expHeight int64
+	}{
+		{"nil request", nil, codes.InvalidArgument, 0},
+		{"empty name", &types.QueryAppliedPlanRequest{}, codes.InvalidArgument, 0},
+		{"plan not applied", &types.QueryAppliedPlanRequest{Name: "other"}, codes.OK, 0},
+		{"applied plan", &types.QueryAppliedPlanRequest{Name: plan.Name}, codes.OK, 10},
+	}
+
+	for _, tc := range testCases {
+		res, err := queryServer.AppliedPlan(goCtx, tc.req)
+		require.Equal(t, tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			require.Equal(t, tc.expHeight, res.Height, tc.msg)
+		}
+	}
+}
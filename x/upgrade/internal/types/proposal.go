@@ -14,11 +14,6 @@ const (
 )
 
 // Software Upgrade Proposals
-type SoftwareUpgradeProposal struct {
-	Title       string `json:"title" yaml:"title"`
-	Description string `json:"description" yaml:"description"`
-	Plan        Plan   `json:"plan" yaml:"plan"`
-}
 
 func NewSoftwareUpgradeProposal(title, description string, plan Plan) gov.Content {
 	return SoftwareUpgradeProposal{title, description, plan}
@@ -54,10 +49,6 @@ func (sup SoftwareUpgradeProposal) String() string {
 }
 
 // Cancel Software Upgrade Proposals
-type CancelSoftwareUpgradeProposal struct {
-	Title       string `json:"title" yaml:"title"`
-	Description string `json:"description" yaml:"description"`
-}
 
 func NewCancelSoftwareUpgradeProposal(title, description string) gov.Content {
 	return CancelSoftwareUpgradeProposal{title, description}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // query endpoints supported by the upgrade Querier
 const (
 	QueryCurrent = "current"
@@ -16,3 +20,9 @@ type QueryAppliedParams struct {
 func NewQueryAppliedParams(name string) QueryAppliedParams {
 	return QueryAppliedParams{Name: name}
 }
+
+// RegisterQueryService registers the upgrade gRPC query service with the given
+// server.
+func RegisterQueryService(server sdk.GRPCServer, srv QueryServer) {
+	server.RegisterService(&_Query_serviceDesc, srv)
+}